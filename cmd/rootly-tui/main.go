@@ -6,10 +6,15 @@ import (
 	"os"
 
 	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/colorprofile"
 
 	"github.com/rootlyhq/rootly-tui/internal/api"
 	"github.com/rootlyhq/rootly-tui/internal/app"
+	"github.com/rootlyhq/rootly-tui/internal/config"
 	"github.com/rootlyhq/rootly-tui/internal/debug"
+	"github.com/rootlyhq/rootly-tui/internal/doctor"
+	"github.com/rootlyhq/rootly-tui/internal/export"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
 )
 
 var (
@@ -24,15 +29,50 @@ func main() {
 	showVersionShort := flag.Bool("v", false, "Show version information (shorthand)")
 	debugMode := flag.Bool("debug", false, "Enable debug logging")
 	logFile := flag.String("log", "", "Write debug logs to file (implies --debug)")
+	open := flag.String("open", "", "Select an incident (e.g. INC-123) and show its detail on startup")
+	dryRun := flag.Bool("dry-run", false, "Log write actions (reopen, acknowledge, ...) instead of sending them")
+	focus := flag.String("focus", "", "Open a full-screen, auto-refreshing view of one incident (e.g. INC-123); q exits")
+	tab := flag.String("tab", "", "Tab to start on: incidents, alerts or services (default: the last one used)")
+	noColor := flag.Bool("no-color", false, "Disable colors (also set by the NO_COLOR environment variable)")
+	doctorMode := flag.Bool("doctor", false, "Check the terminal, clipboard, config and cache, print a report and exit")
+	jsonKind := flag.String("json", "", "Print incidents or alerts as JSON and exit, instead of starting the UI")
+	allPages := flag.Bool("all", false, "With --json, print every page instead of the first")
+	profile := flag.String("profile", "", "Config profile (account) to use instead of the current one; an unknown name starts setup for it")
 
 	flag.Parse()
 
+	startTab, ok := app.ParseTab(*tab)
+	if *tab != "" && !ok {
+		fmt.Fprintf(os.Stderr, "Invalid --tab %q: must be incidents, alerts or services\n", *tab)
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *jsonKind != "" && !export.ValidKind(*jsonKind) {
+		fmt.Fprintf(os.Stderr, "Invalid --json %q: must be incidents or alerts\n", *jsonKind)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// Select the profile before anything reads the config
+	if *profile != "" {
+		config.SetProfile(*profile)
+	}
+
 	// Check for version flag
 	if *showVersion || *showVersionShort {
 		fmt.Printf("rootly-tui %s (commit: %s, built: %s)\n", version, commit, date)
 		os.Exit(0)
 	}
 
+	// Print the diagnostic report instead of starting the UI
+	if *doctorMode {
+		fmt.Printf("rootly-tui %s doctor\n\n", version)
+		if doctor.Report(os.Stdout, doctor.Checks()) {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	// Always log startup to buffer
 	debug.Logger.Info("Starting rootly-tui",
 		"version", version,
@@ -55,8 +95,37 @@ func main() {
 	// Set API client version for User-Agent header
 	api.Version = version
 
+	// Print the data for scripts instead of starting the UI
+	if *jsonKind != "" {
+		if err := export.Run(os.Stdout, *jsonKind, *allPages); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Drop colors before any style is built; https://no-color.org
+	var programOpts []tea.ProgramOption
+	if *noColor || os.Getenv("NO_COLOR") != "" {
+		styles.DisableColor()
+		programOpts = append(programOpts, tea.WithColorProfile(colorprofile.Ascii))
+		debug.Logger.Debug("Colors disabled")
+	}
+
 	model := app.New(version)
-	p := tea.NewProgram(model)
+	if *dryRun {
+		model.SetDryRun(true)
+	}
+	if *tab != "" {
+		model.SetStartTab(startTab)
+	}
+	if *open != "" {
+		model.SetOpen(*open)
+	}
+	if *focus != "" {
+		model.SetFocus(*focus)
+	}
+	p := tea.NewProgram(model, programOpts...)
 
 	// Run the program
 	finalModel, err := p.Run()
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AlertGroup is a set of alerts attached to the same incident, or affecting
+// the same service. Alerts not attached to any incident (or without a service)
+// share a group with an empty IncidentID (or Service).
+type AlertGroup struct {
+	IncidentID    string
+	IncidentSeqID string
+	IncidentTitle string
+	Service       string // Set when grouped by service
+	Alerts        []int  // Indexes into the grouped slice, in list order
+}
+
+// IncidentRef returns the reference used to open the group's incident
+// (INC-123, or the incident ID when it has no sequential ID)
+func (g AlertGroup) IncidentRef() string {
+	if g.IncidentSeqID != "" {
+		return g.IncidentSeqID
+	}
+	return g.IncidentID
+}
+
+// GroupAlertsByIncident buckets alerts by the incident they are attached to.
+// Groups appear in the order their first alert does; unattached alerts come last.
+func GroupAlertsByIncident(alerts []Alert) []AlertGroup {
+	var groups []AlertGroup
+	index := make(map[string]int)
+	var unattached []int
+	for i := range alerts {
+		a := &alerts[i]
+		if a.IncidentID == "" {
+			unattached = append(unattached, i)
+			continue
+		}
+		g, ok := index[a.IncidentID]
+		if !ok {
+			g = len(groups)
+			index[a.IncidentID] = g
+			groups = append(groups, AlertGroup{
+				IncidentID:    a.IncidentID,
+				IncidentSeqID: a.IncidentSeqID,
+				IncidentTitle: a.IncidentTitle,
+			})
+		}
+		groups[g].Alerts = append(groups[g].Alerts, i)
+	}
+	if len(unattached) > 0 {
+		groups = append(groups, AlertGroup{Alerts: unattached})
+	}
+	return groups
+}
+
+// GroupAlertsByService buckets alerts by their first service. Groups appear in
+// the order their first alert does; alerts without a service come last.
+func GroupAlertsByService(alerts []Alert) []AlertGroup {
+	var groups []AlertGroup
+	index := make(map[string]int)
+	var ungrouped []int
+	for i := range alerts {
+		if len(alerts[i].Services) == 0 || alerts[i].Services[0] == "" {
+			ungrouped = append(ungrouped, i)
+			continue
+		}
+		service := alerts[i].Services[0]
+		g, ok := index[service]
+		if !ok {
+			g = len(groups)
+			index[service] = g
+			groups = append(groups, AlertGroup{Service: service})
+		}
+		groups[g].Alerts = append(groups[g].Alerts, i)
+	}
+	if len(ungrouped) > 0 {
+		groups = append(groups, AlertGroup{Alerts: ungrouped})
+	}
+	return groups
+}
+
+// setIncident records inc as the incident the alert is attached to
+func (a *Alert) setIncident(inc AlertIncident) {
+	a.IncidentID = inc.ID
+	a.IncidentSeqID = inc.SequentialID
+	a.IncidentTitle = inc.Title
+}
+
+// formatSequentialID renders an incident's sequential number as INC-123
+func formatSequentialID(n *int) string {
+	if n == nil {
+		return ""
+	}
+	return fmt.Sprintf("INC-%d", *n)
+}
+
+// alertIncidentRefs extracts each alert's first attached incident from a list
+// response body, keyed by alert ID. The SDK's alert type does not model the
+// incidents attribute, so it is decoded separately.
+func alertIncidentRefs(body []byte) map[string]AlertIncident {
+	var result struct {
+		Data []struct {
+			ID         string `json:"id"`
+			Attributes struct {
+				Incidents []struct {
+					ID         string `json:"id"`
+					Attributes struct {
+						SequentialID *int   `json:"sequential_id"`
+						Title        string `json:"title"`
+						Status       string `json:"status"`
+					} `json:"attributes"`
+				} `json:"incidents"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil
+	}
+	refs := make(map[string]AlertIncident)
+	for _, d := range result.Data {
+		if len(d.Attributes.Incidents) == 0 || d.Attributes.Incidents[0].ID == "" {
+			continue
+		}
+		inc := d.Attributes.Incidents[0]
+		refs[d.ID] = AlertIncident{
+			ID:           inc.ID,
+			SequentialID: formatSequentialID(inc.Attributes.SequentialID),
+			Title:        inc.Attributes.Title,
+			Status:       inc.Attributes.Status,
+		}
+	}
+	return refs
+}
@@ -0,0 +1,84 @@
+package api
+
+import "testing"
+
+func TestGroupAlertsByIncident(t *testing.T) {
+	alerts := []Alert{
+		{ID: "a1", IncidentID: "inc_2", IncidentSeqID: "INC-2"},
+		{ID: "a2"},
+		{ID: "a3", IncidentID: "inc_1", IncidentSeqID: "INC-1"},
+		{ID: "a4", IncidentID: "inc_2", IncidentSeqID: "INC-2"},
+		{ID: "a5"},
+	}
+
+	groups := GroupAlertsByIncident(alerts)
+	if len(groups) != 3 {
+		t.Fatalf("expected 3 groups, got %d", len(groups))
+	}
+
+	want := []struct {
+		ref    string
+		alerts []int
+	}{
+		{"INC-2", []int{0, 3}},
+		{"INC-1", []int{2}},
+		{"", []int{1, 4}},
+	}
+	for i, w := range want {
+		g := groups[i]
+		if g.IncidentRef() != w.ref {
+			t.Errorf("group %d: expected incident %q, got %q", i, w.ref, g.IncidentRef())
+		}
+		if len(g.Alerts) != len(w.alerts) {
+			t.Errorf("group %d: expected alerts %v, got %v", i, w.alerts, g.Alerts)
+			continue
+		}
+		for j := range w.alerts {
+			if g.Alerts[j] != w.alerts[j] {
+				t.Errorf("group %d: expected alerts %v, got %v", i, w.alerts, g.Alerts)
+				break
+			}
+		}
+	}
+}
+
+func TestGroupAlertsByIncidentAllUnattached(t *testing.T) {
+	groups := GroupAlertsByIncident([]Alert{{ID: "a1"}, {ID: "a2"}})
+	if len(groups) != 1 || groups[0].IncidentID != "" || len(groups[0].Alerts) != 2 {
+		t.Errorf("expected a single unattached group, got %+v", groups)
+	}
+	if GroupAlertsByIncident(nil) != nil {
+		t.Error("expected no groups for no alerts")
+	}
+}
+
+func TestGroupAlertsByService(t *testing.T) {
+	alerts := []Alert{
+		{ID: "a1", Services: []string{"api", "db"}},
+		{ID: "a2"},
+		{ID: "a3", Services: []string{"db"}},
+		{ID: "a4", Services: []string{"api"}},
+	}
+
+	groups := GroupAlertsByService(alerts)
+	if len(groups) != 3 {
+		t.Fatalf("expected 3 groups, got %+v", groups)
+	}
+	want := []struct {
+		service string
+		alerts  []int
+	}{
+		{"api", []int{0, 3}},
+		{"db", []int{2}},
+		{"", []int{1}},
+	}
+	for i, w := range want {
+		g := groups[i]
+		if g.Service != w.service || len(g.Alerts) != len(w.alerts) || g.Alerts[0] != w.alerts[0] {
+			t.Errorf("group %d: expected %q with alerts %v, got %q with %v", i, w.service, w.alerts, g.Service, g.Alerts)
+		}
+		if g.IncidentRef() != "" {
+			t.Errorf("group %d: expected no incident, got %q", i, g.IncidentRef())
+		}
+	}
+}
@@ -121,4 +121,7 @@ const (
 	CacheKeyPrefixAlerts         = "alerts"
 	CacheKeyPrefixIncidentDetail = "incident_detail"
 	CacheKeyPrefixAlertDetail    = "alert_detail"
+	CacheKeyPrefixTeams          = "teams"
+	CacheKeyPrefixServices       = "services"
+	CacheKeyPrefixIncidentEvents = "incident_events"
 )
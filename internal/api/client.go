@@ -1,12 +1,19 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	rootly "github.com/rootlyhq/rootly-go"
@@ -16,8 +23,14 @@ import (
 	"github.com/rootlyhq/rootly-tui/internal/oauth"
 )
 
-// DefaultCacheTTL is the default cache duration
-const DefaultCacheTTL = 5 * time.Minute
+// DefaultPageSize is the number of incidents or alerts fetched per list request
+const DefaultPageSize = config.DefaultPageSize
+
+// MaxPageSize caps list requests (e.g. when the page size is fitted to the terminal)
+const MaxPageSize = config.MaxPageSize
+
+// MaxConcurrentWrites bounds how many write requests (e.g. a bulk acknowledge) run at once
+const MaxConcurrentWrites = 4
 
 // Version is set by the main package to include in User-Agent
 var Version = "dev"
@@ -28,7 +41,31 @@ type Client struct {
 	apiKey     string
 	cache      *PersistentCache
 	useOAuth   bool
-	httpClient *http.Client
+	httpClient *http.Client // retries transient failures; also carries OAuth tokens
+	rateLimits *rateLimitTracker
+
+	// Detail response bodies by incident or alert ID, retained only in
+	// debug mode; responseOrder lists the IDs oldest first
+	responseMu     sync.Mutex
+	responseBodies map[string][]byte
+	responseOrder  []string
+
+	// Authenticated user, fetched once by CurrentUser
+	currentUserMu sync.Mutex
+	currentUser   *User
+
+	// Items per list request (0 = DefaultPageSize); read from command goroutines
+	pageSize atomic.Int32
+
+	// Related resources fetched with incident and alert details (nil = defaults)
+	incidentIncludes []string
+	alertIncludes    []string
+
+	// Concurrency limiter for write requests (capacity MaxConcurrentWrites)
+	writeSlots chan struct{}
+
+	// Dry-run mode: write methods log the request instead of sending it
+	dryRun atomic.Bool
 }
 
 type Incident struct {
@@ -63,6 +100,7 @@ type Incident struct {
 	IncidentTypes    []string
 	Functionalities  []string
 	Roles            []IncidentRole
+	ActionItems      []IncidentTask
 	CommanderName    string
 	CommunicatorName string
 	CreatedByName    string
@@ -134,7 +172,15 @@ type Alert struct {
 	NotifiedUsers      []AlertUser     // Users who were notified
 	RelatedIncidents   []AlertIncident // Related incidents
 	DeduplicationKey   string
+	EscalationPolicy   string                 // Escalation policy name, if any
+	EscalationLevel    int                    // Current escalation level (0 when unknown)
+	OnCall             []string               // Users currently on call for the escalation policy
 	Data               map[string]interface{} // Raw alert payload from source
+
+	// Incident the alert is attached to (the first, if several); empty when unattached
+	IncidentID    string
+	IncidentSeqID string
+	IncidentTitle string
 }
 
 // AlertUser represents a user who was notified about an alert
@@ -151,6 +197,13 @@ type AlertIncident struct {
 	Status       string
 }
 
+// Team represents a Rootly team (group)
+type Team struct {
+	ID   string
+	Name string
+	Slug string
+}
+
 // PaginationInfo contains pagination state
 type PaginationInfo struct {
 	CurrentPage int
@@ -164,12 +217,14 @@ type PaginationInfo struct {
 type IncidentsResult struct {
 	Incidents  []Incident
 	Pagination PaginationInfo
+	CachedAge  time.Duration `json:"-"` // How old the page is when served from the cache (0 when fetched)
 }
 
 // AlertsResult contains alerts and pagination info
 type AlertsResult struct {
 	Alerts     []Alert
 	Pagination PaginationInfo
+	CachedAge  time.Duration `json:"-"` // How old the page is when served from the cache (0 when fetched)
 }
 
 // incidentResponseData represents the structure of incident data from the API response
@@ -222,6 +277,14 @@ type incidentResponseData struct {
 				} `json:"attributes"`
 			} `json:"data"`
 		} `json:"groups"`
+		User *struct {
+			Data *struct {
+				Attributes struct {
+					Name  string `json:"name"`
+					Email string `json:"email"`
+				} `json:"attributes"`
+			} `json:"data"`
+		} `json:"user"`
 	} `json:"attributes"`
 }
 
@@ -279,6 +342,10 @@ func parseIncidentData(d incidentResponseData) Incident {
 			incident.Teams = append(incident.Teams, g.Attributes.Name)
 		}
 	}
+	if d.Attributes.User != nil && d.Attributes.User.Data != nil {
+		incident.CreatedByName = d.Attributes.User.Data.Attributes.Name
+		incident.CreatedByEmail = d.Attributes.User.Data.Attributes.Email
+	}
 
 	return incident
 }
@@ -297,6 +364,12 @@ func NewClient(cfg *config.Config) (*Client, error) {
 	// Determine if we should use OAuth (OAuth wins over API key)
 	useOAuth := false
 	var oauthHTTPClient *http.Client
+	base, err := newBaseTransport(cfg.ProxyURL)
+	if err != nil {
+		return nil, err
+	}
+	rateLimits := &rateLimitTracker{}
+	transport := newRetryTransport(&rateLimitTransport{base: base, tracker: rateLimits}, cfg.RetryLimit(), rateLimits.retrying)
 	if cfg.UseOAuth {
 		td := oauth.TokenDataFromConfig(cfg)
 		if td.HasValidTokens() {
@@ -305,7 +378,7 @@ func NewClient(cfg *config.Config) (*Client, error) {
 				useOAuth = true
 				authBaseURL := oauth.DeriveAuthBaseURL(cfg.Endpoint)
 				oauthCfg := oauth.NewConfig(authBaseURL, reg.ClientID, reg.Scopes)
-				oauthHTTPClient = oauth.NewHTTPClientWithTokens(oauthCfg, td, http.DefaultTransport, "rootly-tui/"+Version)
+				oauthHTTPClient = oauth.NewHTTPClientWithTokens(oauthCfg, td, transport, "rootly-tui/"+Version)
 				debug.Logger.Debug("Using OAuth2 authentication")
 			} else {
 				debug.Logger.Warn("OAuth tokens exist but no client registration cached, skipping OAuth")
@@ -313,6 +386,13 @@ func NewClient(cfg *config.Config) (*Client, error) {
 		}
 	}
 
+	httpClient := &http.Client{Transport: transport}
+	if useOAuth && oauthHTTPClient != nil {
+		httpClient = oauthHTTPClient
+	}
+	// A backstop for callers without a deadline of their own
+	httpClient.Timeout = cfg.RequestTimeout()
+
 	var opts []rootly.ClientOption
 	if useOAuth && oauthHTTPClient != nil {
 		opts = append(opts, rootly.WithHTTPClient(oauthHTTPClient), rootly.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
@@ -325,7 +405,7 @@ func NewClient(cfg *config.Config) (*Client, error) {
 			return nil
 		}))
 	} else {
-		opts = append(opts, rootly.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		opts = append(opts, rootly.WithHTTPClient(httpClient), rootly.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
 			req.Header.Set("Authorization", "Bearer "+cfg.APIKey)
 			req.Header.Set("Content-Type", "application/vnd.api+json")
 			req.Header.Set("User-Agent", "rootly-tui/"+Version)
@@ -343,27 +423,55 @@ func NewClient(cfg *config.Config) (*Client, error) {
 		return nil, fmt.Errorf("failed to create rootly client: %w", err)
 	}
 
-	cache, err := NewPersistentCache(DefaultCacheTTL)
-	if err != nil {
-		debug.Logger.Warn("Failed to create persistent cache, using in-memory", "error", err)
-		return &Client{
+	var cache *PersistentCache
+	ttl := cfg.CacheTTL()
+	if ttl > 0 {
+		cache, err = NewProfileCache(ttl, cfg.Profile)
+		if err != nil {
+			debug.Logger.Warn("Failed to create persistent cache, using in-memory", "error", err)
+		}
+	} else {
+		debug.Logger.Info("Caching disabled by cache_ttl_seconds")
+	}
+	if cache == nil {
+		c := &Client{
 			client:     client,
 			endpoint:   endpoint,
 			apiKey:     cfg.APIKey,
 			cache:      nil,
 			useOAuth:   useOAuth,
-			httpClient: oauthHTTPClient,
-		}, nil
+			httpClient: httpClient,
+			rateLimits: rateLimits,
+			writeSlots: make(chan struct{}, MaxConcurrentWrites),
+
+			incidentIncludes: parseIncludes(cfg.IncidentIncludes, DefaultIncidentIncludes, "incident"),
+			alertIncludes:    parseIncludes(cfg.AlertIncludes, DefaultAlertIncludes, "alert"),
+		}
+		c.SetDryRun(cfg.DryRun)
+		c.SetPageSize(cfg.ListPageSize())
+		return c, nil
+	}
+
+	if cfg.CacheMaxBytes > 0 {
+		cache.SetMaxBytes(cfg.CacheMaxBytes)
 	}
 
-	return &Client{
+	c := &Client{
 		client:     client,
 		endpoint:   endpoint,
 		apiKey:     cfg.APIKey,
 		cache:      cache,
 		useOAuth:   useOAuth,
-		httpClient: oauthHTTPClient,
-	}, nil
+		httpClient: httpClient,
+		rateLimits: rateLimits,
+		writeSlots: make(chan struct{}, MaxConcurrentWrites),
+
+		incidentIncludes: parseIncludes(cfg.IncidentIncludes, DefaultIncidentIncludes, "incident"),
+		alertIncludes:    parseIncludes(cfg.AlertIncludes, DefaultAlertIncludes, "alert"),
+	}
+	c.SetDryRun(cfg.DryRun)
+	c.SetPageSize(cfg.ListPageSize())
+	return c, nil
 }
 
 // ensureScheme adds http:// for localhost/127.0.0.1, https:// for everything else.
@@ -387,6 +495,47 @@ func normalizeEndpoint(endpoint string) string {
 	return ensureScheme(endpoint)
 }
 
+// ValidateEndpoint reports whether endpoint is a hostname (with an optional
+// port and path) or an http(s) URL, adding a scheme the same way NewClient
+// does. Empty is valid and means the default endpoint.
+func ValidateEndpoint(endpoint string) error {
+	endpoint = strings.TrimSpace(endpoint)
+	if endpoint == "" {
+		return nil
+	}
+	if strings.ContainsAny(endpoint, " \t") {
+		return fmt.Errorf("endpoint %q contains spaces", endpoint)
+	}
+	if i := strings.Index(endpoint, "://"); i >= 0 {
+		if scheme := strings.ToLower(endpoint[:i]); scheme != "http" && scheme != "https" {
+			return fmt.Errorf("endpoint %q must use http or https", endpoint)
+		}
+		endpoint = strings.ToLower(endpoint[:i]) + endpoint[i:]
+	}
+	u, err := url.Parse(ensureScheme(endpoint))
+	if err != nil {
+		return fmt.Errorf("endpoint %q is not a valid URL", endpoint)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return fmt.Errorf("endpoint %q has no hostname", endpoint)
+	}
+	if net.ParseIP(host) != nil {
+		return nil
+	}
+	for _, label := range strings.Split(host, ".") {
+		if label == "" || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return fmt.Errorf("endpoint %q is not a valid hostname", endpoint)
+		}
+		for _, r := range label {
+			if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("endpoint %q is not a valid hostname", endpoint)
+			}
+		}
+	}
+	return nil
+}
+
 // deriveAPIEndpoint returns the API endpoint for a given host.
 // For local dev servers without a path, appends /api since the API lives at /api.
 // For production (api.rootly.com), returns as-is.
@@ -401,12 +550,10 @@ func deriveAPIEndpoint(endpoint string) string {
 	return ep
 }
 
-// doRequest executes an HTTP request using the appropriate client (OAuth or default).
+// doRequest executes an HTTP request with the client's retrying, time-limited
+// HTTP client (which also carries OAuth tokens).
 func (c *Client) doRequest(req *http.Request) (*http.Response, error) {
-	if c.useOAuth && c.httpClient != nil {
-		return c.httpClient.Do(req)
-	}
-	return http.DefaultClient.Do(req)
+	return c.httpClient.Do(req)
 }
 
 // setAuthHeaders sets auth headers on a raw HTTP request.
@@ -422,6 +569,84 @@ func (c *Client) setAuthHeaders(req *http.Request) {
 	}
 }
 
+// statusError is an unexpected API response status, with the error detail
+// from its body when there is one
+type statusError struct {
+	Status int
+	Detail string
+}
+
+func (e *statusError) Error() string {
+	if e.Detail != "" {
+		return fmt.Sprintf("API returned status %d: %s", e.Status, e.Detail)
+	}
+	return fmt.Sprintf("API returned status %d", e.Status)
+}
+
+// getJSON performs a GET against the API and decodes the JSON response into out.
+// permission names the API key scope reported when the request is forbidden.
+func (c *Client) getJSON(ctx context.Context, path, permission string, out any) error {
+	baseURL := c.endpoint
+	if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
+		baseURL = "https://" + baseURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", baseURL+path, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	c.setAuthHeaders(req)
+
+	httpResp, err := c.doRequest(req)
+	if err != nil {
+		debug.Logger.Error("Request failed", "path", path, "error", err)
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	debug.Logger.Debug("API response", "path", path, "status", httpResp.StatusCode, "bodyLength", len(body))
+
+	if httpResp.StatusCode == 403 {
+		debug.Logger.Error("API forbidden", "path", path, "status", httpResp.StatusCode)
+		return fmt.Errorf("access denied: API key lacks '%s' permission", permission)
+	}
+	if httpResp.StatusCode != 200 {
+		debug.Logger.Error("API error", "path", path, "status", httpResp.StatusCode, "body", debug.PrettyJSON(body))
+		return &statusError{Status: httpResp.StatusCode, Detail: apiErrorDetail(body)}
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		debug.Logger.Error("Failed to parse response", "path", path, "error", err, "body", debug.PrettyJSON(body))
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
+
+// SetPageSize sets how many items list requests fetch, clamped to MaxPageSize.
+// A value <= 0 restores DefaultPageSize.
+func (c *Client) SetPageSize(size int) {
+	if size > MaxPageSize {
+		size = MaxPageSize
+	}
+	if size < 0 {
+		size = 0
+	}
+	c.pageSize.Store(int32(size))
+}
+
+// PageSize returns how many items list requests fetch
+func (c *Client) PageSize() int {
+	if size := int(c.pageSize.Load()); size > 0 {
+		return size
+	}
+	return DefaultPageSize
+}
+
 // ClearCache clears all cached data
 func (c *Client) ClearCache() {
 	if c.cache != nil {
@@ -453,23 +678,29 @@ func (c *Client) ValidateAPIKey(ctx context.Context) error {
 	return nil
 }
 
-func (c *Client) ListIncidents(ctx context.Context, page int, sort string) (*IncidentsResult, error) {
-	pageSize := 25
+// ListIncidents fetches a page of incidents in the given API sort, limited to
+// one team's incidents when team is set
+func (c *Client) ListIncidents(ctx context.Context, page int, sort, team string) (*IncidentsResult, error) {
+	pageSize := c.PageSize()
 
-	// Build cache key with parameters including sort
+	// Build cache key with parameters including sort and team
 	cacheKeyBuilder := NewCacheKey(CacheKeyPrefixIncidents).
 		With("page", page).
 		With("pageSize", pageSize)
 	if sort != "" {
 		cacheKeyBuilder = cacheKeyBuilder.With("sort", sort)
 	}
+	if team != "" {
+		cacheKeyBuilder = cacheKeyBuilder.With("team", team)
+	}
 	cacheKey := cacheKeyBuilder.Build()
 
 	// Check cache first
 	if c.cache != nil {
 		var cached IncidentsResult
-		if c.cache.GetTyped(cacheKey, &cached) {
-			debug.Logger.Debug("Cache hit for incidents", "key", cacheKey)
+		if age, ok := c.cache.GetTyped(cacheKey, &cached); ok {
+			debug.Logger.Debug("Cache hit for incidents", "key", cacheKey, "age", age)
+			cached.CachedAge = age
 			return &cached, nil
 		}
 	}
@@ -480,14 +711,17 @@ func (c *Client) ListIncidents(ctx context.Context, page int, sort string) (*Inc
 		baseURL = "https://" + baseURL
 	}
 
-	url := fmt.Sprintf("%s/v1/incidents?page[number]=%d&page[size]=%d", baseURL, page, pageSize)
+	reqURL := fmt.Sprintf("%s/v1/incidents?page[number]=%d&page[size]=%d", baseURL, page, pageSize)
 	if sort != "" {
-		url += fmt.Sprintf("&sort=%s", sort)
+		reqURL += fmt.Sprintf("&sort=%s", sort)
+	}
+	if team != "" {
+		reqURL += "&filter[team_names]=" + url.QueryEscape(team)
 	}
 
-	debug.Logger.Debug("Fetching incidents", "page", page, "pageSize", pageSize, "sort", sort, "cache", "miss", "key", cacheKey)
+	debug.Logger.Debug("Fetching incidents", "page", page, "pageSize", pageSize, "sort", sort, "team", team, "cache", "miss", "key", cacheKey)
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, http.NoBody)
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, http.NoBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -588,7 +822,7 @@ func (c *Client) ListIncidents(ctx context.Context, page int, sort string) (*Inc
 }
 
 func (c *Client) ListAlerts(ctx context.Context, page int) (*AlertsResult, error) {
-	pageSize := 25
+	pageSize := c.PageSize()
 
 	// Build cache key with parameters
 	cacheKey := NewCacheKey(CacheKeyPrefixAlerts).
@@ -599,8 +833,9 @@ func (c *Client) ListAlerts(ctx context.Context, page int) (*AlertsResult, error
 	// Check cache first
 	if c.cache != nil {
 		var cached AlertsResult
-		if c.cache.GetTyped(cacheKey, &cached) {
-			debug.Logger.Debug("Cache hit for alerts", "key", cacheKey)
+		if age, ok := c.cache.GetTyped(cacheKey, &cached); ok {
+			debug.Logger.Debug("Cache hit for alerts", "key", cacheKey, "age", age)
+			cached.CachedAge = age
 			return &cached, nil
 		}
 	}
@@ -641,6 +876,8 @@ func (c *Client) ListAlerts(ctx context.Context, page int) (*AlertsResult, error
 	result := resp.ApplicationVndAPIJSON200
 	debug.Logger.Debug("Parsed alerts", "count", len(result.Data))
 
+	incidentRefs := alertIncidentRefs(resp.Body)
+
 	alerts := make([]Alert, 0, len(result.Data))
 	for _, d := range result.Data {
 		alert := Alert{
@@ -694,6 +931,10 @@ func (c *Client) ListAlerts(ctx context.Context, page int) (*AlertsResult, error
 			alert.Data = data
 		}
 
+		if inc, ok := incidentRefs[d.ID]; ok {
+			alert.setIncident(inc)
+		}
+
 		alerts = append(alerts, alert)
 	}
 
@@ -738,6 +979,85 @@ func (c *Client) ListAlerts(ctx context.Context, page int) (*AlertsResult, error
 	return alertsResult, nil
 }
 
+// ListTeams returns the teams configured in the organization, sorted by name.
+// The list rarely changes, so it is cached like other list responses.
+func (c *Client) ListTeams(ctx context.Context) ([]Team, error) {
+	pageSize := 100
+	cacheKey := NewCacheKey(CacheKeyPrefixTeams).
+		With("pageSize", pageSize).
+		Build()
+
+	// Check cache first
+	if c.cache != nil {
+		var cached []Team
+		if _, ok := c.cache.GetTyped(cacheKey, &cached); ok {
+			debug.Logger.Debug("Cache hit for teams", "key", cacheKey)
+			return cached, nil
+		}
+	}
+
+	sortParam := "name"
+	params := &rootly.ListTeamsParams{
+		PageSize: &pageSize,
+		Sort:     &sortParam,
+	}
+
+	debug.Logger.Debug("Fetching teams", "pageSize", pageSize, "cache", "miss", "key", cacheKey)
+
+	resp, err := c.client.ListTeamsWithResponse(ctx, params)
+	if err != nil {
+		debug.Logger.Error("Failed to list teams", "error", err)
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	debug.Logger.Debug("Teams response",
+		"status", resp.StatusCode(),
+		"bodyLength", len(resp.Body),
+	)
+
+	if resp.StatusCode() == 403 {
+		debug.Logger.Error("API forbidden", "status", resp.StatusCode())
+		return nil, fmt.Errorf("access denied: API key lacks 'read teams' permission")
+	}
+	if resp.StatusCode() != 200 {
+		debug.Logger.Error("API error", "status", resp.StatusCode(), "body", debug.PrettyJSON(resp.Body))
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode())
+	}
+
+	if resp.ApplicationVndAPIJSON200 == nil {
+		debug.Logger.Error("Failed to parse teams response", "body", debug.PrettyJSON(resp.Body))
+		return nil, fmt.Errorf("failed to parse response")
+	}
+
+	teams := make([]Team, 0, len(resp.ApplicationVndAPIJSON200.Data))
+	for _, d := range resp.ApplicationVndAPIJSON200.Data {
+		team := Team{
+			ID:   d.ID,
+			Name: strings.TrimSpace(d.Attributes.Name),
+		}
+		if d.Attributes.Slug != nil {
+			team.Slug = *d.Attributes.Slug
+		}
+		if team.Name == "" {
+			continue
+		}
+		teams = append(teams, team)
+	}
+	sort.Slice(teams, func(i, j int) bool {
+		return strings.ToLower(teams[i].Name) < strings.ToLower(teams[j].Name)
+	})
+
+	debug.Logger.Debug("Parsed teams", "count", len(teams))
+
+	// Store in cache
+	if c.cache != nil {
+		c.cache.Set(cacheKey, teams)
+		debug.Logger.Debug("Cached teams", "count", len(teams), "key", cacheKey)
+	}
+
+	return teams, nil
+}
+
 // alertLabelValueToString converts the SDK's union type to a string
 func alertLabelValueToString(v rootly.Alert_Labels_Value) string {
 	if s, err := v.AsAlertLabelsValue0(); err == nil {
@@ -765,21 +1085,30 @@ func parseTimePtr(s *string) *time.Time {
 
 // GetIncident fetches detailed incident data by ID
 // updatedAt is used for cache invalidation - cache key includes it so changes invalidate the cache
+func (c *Client) GetIncident(ctx context.Context, id string, updatedAt time.Time) (*Incident, error) {
+	incident, _, err := c.GetIncidentDetail(ctx, id, updatedAt)
+	return incident, err
+}
+
+// GetIncidentDetail is GetIncident, also reporting whether the incident was
+// served from the cache rather than fetched
 //
 //nolint:gocyclo // complexity from parsing deeply nested API response with many optional fields
-func (c *Client) GetIncident(ctx context.Context, id string, updatedAt time.Time) (*Incident, error) {
+func (c *Client) GetIncidentDetail(ctx context.Context, id string, updatedAt time.Time) (*Incident, bool, error) {
 	// Build cache key with updated_at for smart invalidation
+	include := c.incidentIncludeParam()
 	cacheKey := NewCacheKey(CacheKeyPrefixIncidentDetail).
 		With("id", id).
 		With("updated_at", updatedAt.UTC().Format(time.RFC3339)).
+		With("include", include).
 		Build()
 
 	// Check cache first
 	if c.cache != nil {
 		var cached Incident
-		if c.cache.GetTyped(cacheKey, &cached) {
+		if _, ok := c.cache.GetTyped(cacheKey, &cached); ok {
 			debug.Logger.Debug("Cache hit for incident detail", "key", cacheKey)
-			return &cached, nil
+			return &cached, true, nil
 		}
 	}
 
@@ -790,25 +1119,27 @@ func (c *Client) GetIncident(ctx context.Context, id string, updatedAt time.Time
 	if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
 		baseURL = "https://" + baseURL
 	}
-	url := fmt.Sprintf("%s/v1/incidents/%s?include=roles,causes,incident_types,functionalities,services,environments,groups,user", baseURL, id)
+	url := fmt.Sprintf("%s/v1/incidents/%s?include=%s", baseURL, id, include)
 	req, err := http.NewRequestWithContext(ctx, "GET", url, http.NoBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
 	}
 	c.setAuthHeaders(req)
 
 	httpResp, err := c.doRequest(req)
 	if err != nil {
 		debug.Logger.Error("Failed to fetch incident", "error", err)
-		return nil, fmt.Errorf("failed to fetch incident: %w", err)
+		return nil, false, fmt.Errorf("failed to fetch incident: %w", err)
 	}
 	defer func() { _ = httpResp.Body.Close() }()
 
 	body, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, false, fmt.Errorf("failed to read response: %w", err)
 	}
 
+	c.rememberResponse(id, body)
+
 	debug.Logger.Debug("Incident detail response",
 		"status", httpResp.StatusCode,
 		"bodyLength", len(body),
@@ -817,11 +1148,11 @@ func (c *Client) GetIncident(ctx context.Context, id string, updatedAt time.Time
 
 	if httpResp.StatusCode == 403 {
 		debug.Logger.Error("API forbidden", "status", httpResp.StatusCode)
-		return nil, fmt.Errorf("access denied: API key lacks 'read incidents' permission")
+		return nil, false, fmt.Errorf("access denied: API key lacks 'read incidents' permission")
 	}
 	if httpResp.StatusCode != 200 {
 		debug.Logger.Error("API error", "status", httpResp.StatusCode, "body", debug.PrettyJSON(body))
-		return nil, fmt.Errorf("API returned status %d", httpResp.StatusCode)
+		return nil, false, fmt.Errorf("API returned status %d", httpResp.StatusCode)
 	}
 
 	var result struct {
@@ -988,7 +1319,7 @@ func (c *Client) GetIncident(ctx context.Context, id string, updatedAt time.Time
 			"error", err,
 			"body", debug.PrettyJSON(body),
 		)
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+		return nil, false, fmt.Errorf("failed to parse response: %w", err)
 	}
 
 	d := result.Data
@@ -1196,6 +1527,13 @@ func (c *Client) GetIncident(ctx context.Context, id string, updatedAt time.Time
 		}
 	}
 
+	// Action items come from a separate endpoint; the detail is still useful without them
+	if tasks, err := c.ListIncidentTasks(ctx, id); err != nil {
+		debug.Logger.Warn("Failed to fetch incident action items", "id", id, "error", err)
+	} else {
+		incident.ActionItems = tasks
+	}
+
 	// Store in cache
 	if c.cache != nil {
 		c.cache.Set(cacheKey, incident)
@@ -1203,26 +1541,35 @@ func (c *Client) GetIncident(ctx context.Context, id string, updatedAt time.Time
 	}
 
 	debug.Logger.Debug("Parsed incident detail", "id", incident.ID, "title", incident.Title)
-	return incident, nil
+	return incident, false, nil
 }
 
 // GetAlert fetches detailed alert data by ID
 // updatedAt is used for cache invalidation - cache key includes it so changes invalidate the cache
+func (c *Client) GetAlert(ctx context.Context, id string, updatedAt time.Time) (*Alert, error) {
+	alert, _, err := c.GetAlertDetail(ctx, id, updatedAt)
+	return alert, err
+}
+
+// GetAlertDetail is GetAlert, also reporting whether the alert was served from
+// the cache rather than fetched
 //
 //nolint:gocyclo // Parsing API response requires many field assignments
-func (c *Client) GetAlert(ctx context.Context, id string, updatedAt time.Time) (*Alert, error) {
+func (c *Client) GetAlertDetail(ctx context.Context, id string, updatedAt time.Time) (*Alert, bool, error) {
 	// Build cache key with updated_at for smart invalidation
+	include := c.alertIncludeParam()
 	cacheKey := NewCacheKey(CacheKeyPrefixAlertDetail).
 		With("id", id).
 		With("updated_at", updatedAt.UTC().Format(time.RFC3339)).
+		With("include", include).
 		Build()
 
 	// Check cache first
 	if c.cache != nil {
 		var cached Alert
-		if c.cache.GetTyped(cacheKey, &cached) {
+		if _, ok := c.cache.GetTyped(cacheKey, &cached); ok {
 			debug.Logger.Debug("Cache hit for alert detail", "key", cacheKey)
-			return &cached, nil
+			return &cached, true, nil
 		}
 	}
 
@@ -1233,25 +1580,27 @@ func (c *Client) GetAlert(ctx context.Context, id string, updatedAt time.Time) (
 	if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
 		baseURL = "https://" + baseURL
 	}
-	url := fmt.Sprintf("%s/v1/alerts/%s?include=services,environments,groups,responders,alert_urgency", baseURL, id)
+	url := fmt.Sprintf("%s/v1/alerts/%s?include=%s", baseURL, id, include)
 	req, err := http.NewRequestWithContext(ctx, "GET", url, http.NoBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
 	}
 	c.setAuthHeaders(req)
 
 	httpResp, err := c.doRequest(req)
 	if err != nil {
 		debug.Logger.Error("Failed to fetch alert", "error", err)
-		return nil, fmt.Errorf("failed to fetch alert: %w", err)
+		return nil, false, fmt.Errorf("failed to fetch alert: %w", err)
 	}
 	defer func() { _ = httpResp.Body.Close() }()
 
 	body, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, false, fmt.Errorf("failed to read response: %w", err)
 	}
 
+	c.rememberResponse(id, body)
+
 	debug.Logger.Debug("Alert detail response",
 		"status", httpResp.StatusCode,
 		"bodyLength", len(body),
@@ -1260,11 +1609,11 @@ func (c *Client) GetAlert(ctx context.Context, id string, updatedAt time.Time) (
 
 	if httpResp.StatusCode == 403 {
 		debug.Logger.Error("API forbidden", "status", httpResp.StatusCode)
-		return nil, fmt.Errorf("access denied: API key lacks 'read alerts' permission")
+		return nil, false, fmt.Errorf("access denied: API key lacks 'read alerts' permission")
 	}
 	if httpResp.StatusCode != 200 {
 		debug.Logger.Error("API error", "status", httpResp.StatusCode, "body", debug.PrettyJSON(body))
-		return nil, fmt.Errorf("API returned status %d", httpResp.StatusCode)
+		return nil, false, fmt.Errorf("API returned status %d", httpResp.StatusCode)
 	}
 
 	var result struct {
@@ -1314,6 +1663,18 @@ func (c *Client) GetAlert(ctx context.Context, id string, updatedAt time.Time) (
 						} `json:"attributes"`
 					} `json:"data"`
 				} `json:"alert_urgency"`
+				EscalationPolicy *struct {
+					Data *struct {
+						Attributes struct {
+							Name        string `json:"name"`
+							OnCallUsers []struct {
+								Name  string `json:"name"`
+								Email string `json:"email"`
+							} `json:"on_call_users"`
+						} `json:"attributes"`
+					} `json:"data"`
+				} `json:"escalation_policy"`
+				EscalationLevel *int `json:"escalation_level"`
 				// Additional fields
 				URL                *string `json:"url"`
 				ExternalID         *string `json:"external_id"`
@@ -1343,7 +1704,7 @@ func (c *Client) GetAlert(ctx context.Context, id string, updatedAt time.Time) (
 			"error", err,
 			"body", debug.PrettyJSON(body),
 		)
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+		return nil, false, fmt.Errorf("failed to parse response: %w", err)
 	}
 
 	d := result.Data
@@ -1401,6 +1762,22 @@ func (c *Client) GetAlert(ctx context.Context, id string, updatedAt time.Time) (
 		alert.Urgency = d.Attributes.AlertUrgency.Data.Attributes.Name
 	}
 
+	if d.Attributes.EscalationPolicy != nil && d.Attributes.EscalationPolicy.Data != nil {
+		alert.EscalationPolicy = d.Attributes.EscalationPolicy.Data.Attributes.Name
+		for _, u := range d.Attributes.EscalationPolicy.Data.Attributes.OnCallUsers {
+			name := strings.TrimSpace(u.Name)
+			if name == "" {
+				name = strings.TrimSpace(u.Email)
+			}
+			if name != "" {
+				alert.OnCall = append(alert.OnCall, name)
+			}
+		}
+	}
+	if d.Attributes.EscalationLevel != nil {
+		alert.EscalationLevel = *d.Attributes.EscalationLevel
+	}
+
 	// Parse additional fields
 	if d.Attributes.URL != nil {
 		alert.URL = *d.Attributes.URL
@@ -1432,17 +1809,16 @@ func (c *Client) GetAlert(ctx context.Context, id string, updatedAt time.Time) (
 
 	// Parse related incidents
 	for _, inc := range d.Attributes.Incidents {
-		seqID := ""
-		if inc.Attributes.SequentialID != nil {
-			seqID = fmt.Sprintf("INC-%d", *inc.Attributes.SequentialID)
-		}
 		alert.RelatedIncidents = append(alert.RelatedIncidents, AlertIncident{
 			ID:           inc.ID,
-			SequentialID: seqID,
+			SequentialID: formatSequentialID(inc.Attributes.SequentialID),
 			Title:        inc.Attributes.Title,
 			Status:       inc.Attributes.Status,
 		})
 	}
+	if len(alert.RelatedIncidents) > 0 {
+		alert.setIncident(alert.RelatedIncidents[0])
+	}
 
 	// Store in cache
 	if c.cache != nil {
@@ -1451,7 +1827,320 @@ func (c *Client) GetAlert(ctx context.Context, id string, updatedAt time.Time) (
 	}
 
 	debug.Logger.Debug("Parsed alert detail", "id", alert.ID, "summary", alert.Summary)
-	return alert, nil
+	return alert, false, nil
+}
+
+// Bounds on the detail response bodies kept for debugging
+const (
+	maxResponseSize        = 1 << 20
+	maxRememberedResponses = 50
+)
+
+// rememberResponse keeps a copy of the detail response body for id when debug
+// mode is on. An oversize body drops the one kept before, so it can't be
+// mistaken for the current detail.
+func (c *Client) rememberResponse(id string, body []byte) {
+	if !debug.Enabled {
+		return
+	}
+	c.responseMu.Lock()
+	defer c.responseMu.Unlock()
+
+	if i := slices.Index(c.responseOrder, id); i >= 0 {
+		c.responseOrder = slices.Delete(c.responseOrder, i, i+1)
+	}
+	if len(body) > maxResponseSize {
+		delete(c.responseBodies, id)
+		return
+	}
+	if c.responseBodies == nil {
+		c.responseBodies = make(map[string][]byte)
+	}
+	c.responseBodies[id] = append([]byte(nil), body...)
+	c.responseOrder = append(c.responseOrder, id)
+	if len(c.responseOrder) > maxRememberedResponses {
+		delete(c.responseBodies, c.responseOrder[0])
+		c.responseOrder = c.responseOrder[1:]
+	}
+}
+
+// ResponseBody returns the raw body of the last detail response fetched for
+// an incident or alert (debug mode only; details served from the cache have none)
+func (c *Client) ResponseBody(id string) []byte {
+	c.responseMu.Lock()
+	defer c.responseMu.Unlock()
+	return c.responseBodies[id]
+}
+
+// Incident status values used by write actions
+const (
+	IncidentStatusStarted      = "started"
+	IncidentStatusAcknowledged = "acknowledged"
+	IncidentStatusResolved     = "resolved"
+	IncidentStatusClosed       = "closed"
+)
+
+// Alert status values used by write actions
+const (
+	AlertStatusTriggered    = "triggered"
+	AlertStatusAcknowledged = "acknowledged"
+	AlertStatusResolved     = "resolved"
+)
+
+// AcknowledgeIncident moves an incident to the acknowledged state
+func (c *Client) AcknowledgeIncident(ctx context.Context, id string) (*Incident, error) {
+	return c.UpdateIncidentStatus(ctx, id, IncidentStatusAcknowledged)
+}
+
+// ReopenIncident moves a resolved or closed incident back to the started state
+func (c *Client) ReopenIncident(ctx context.Context, id string) (*Incident, error) {
+	return c.UpdateIncidentStatus(ctx, id, IncidentStatusStarted)
+}
+
+// UpdateIncidentStatus PATCHes the incident status and invalidates its cached data
+func (c *Client) UpdateIncidentStatus(ctx context.Context, id, status string) (*Incident, error) {
+	baseURL := c.endpoint
+	if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
+		baseURL = "https://" + baseURL
+	}
+	url := fmt.Sprintf("%s/v1/incidents/%s", baseURL, id)
+
+	payload := map[string]any{
+		"data": map[string]any{
+			"type": "incidents",
+			"attributes": map[string]any{
+				"status": status,
+			},
+		},
+	}
+	reqBody, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	if c.skipWrite("PATCH", url, reqBody) {
+		return &Incident{ID: id, Status: status}, nil
+	}
+
+	release, err := c.acquireWrite(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	debug.Logger.Debug("Updating incident status", "id", id, "status", status)
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	c.setAuthHeaders(req)
+
+	httpResp, err := c.doRequest(req)
+	if err != nil {
+		debug.Logger.Error("Failed to update incident", "error", err)
+		return nil, fmt.Errorf("failed to update incident: %w", err)
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	debug.Logger.Debug("Update incident response",
+		"status", httpResp.StatusCode,
+		"bodyLength", len(body),
+	)
+
+	if httpResp.StatusCode == 403 {
+		debug.Logger.Error("API forbidden", "status", httpResp.StatusCode)
+		if detail := apiErrorDetail(body); detail != "" {
+			return nil, fmt.Errorf("access denied: %s", detail)
+		}
+		return nil, fmt.Errorf("access denied: API key lacks 'update incidents' permission")
+	}
+	if httpResp.StatusCode != 200 {
+		debug.Logger.Error("API error", "status", httpResp.StatusCode, "body", debug.PrettyJSON(body))
+		if detail := apiErrorDetail(body); detail != "" {
+			return nil, fmt.Errorf("API returned status %d: %s", httpResp.StatusCode, detail)
+		}
+		return nil, fmt.Errorf("API returned status %d", httpResp.StatusCode)
+	}
+
+	var result struct {
+		Data incidentResponseData `json:"data"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		debug.Logger.Error("Failed to parse update incident response", "error", err)
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	c.invalidateIncident(id)
+
+	incident := parseIncidentData(result.Data)
+	return &incident, nil
+}
+
+// AcknowledgeAlert marks a triggered alert as acknowledged
+func (c *Client) AcknowledgeAlert(ctx context.Context, id string) error {
+	return c.postAlertAction(ctx, id, "acknowledge")
+}
+
+// ResolveAlert marks a triggered or acknowledged alert as resolved
+func (c *Client) ResolveAlert(ctx context.Context, id string) error {
+	return c.postAlertAction(ctx, id, "resolve")
+}
+
+// postAlertAction POSTs to /v1/alerts/{id}/{action} and drops the cached detail on success
+func (c *Client) postAlertAction(ctx context.Context, id, action string) error {
+	baseURL := c.endpoint
+	if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
+		baseURL = "https://" + baseURL
+	}
+	url := fmt.Sprintf("%s/v1/alerts/%s/%s", baseURL, id, action)
+
+	if c.skipWrite("POST", url, nil) {
+		return nil
+	}
+
+	release, err := c.acquireWrite(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	debug.Logger.Debug("Alert action", "id", id, "action", action)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	c.setAuthHeaders(req)
+
+	httpResp, err := c.doRequest(req)
+	if err != nil {
+		debug.Logger.Error("Failed alert action", "id", id, "action", action, "error", err)
+		return fmt.Errorf("failed to %s alert: %w", action, err)
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	debug.Logger.Debug("Alert action response",
+		"action", action,
+		"status", httpResp.StatusCode,
+		"bodyLength", len(body),
+	)
+
+	if httpResp.StatusCode == 403 {
+		debug.Logger.Error("API forbidden", "status", httpResp.StatusCode)
+		return fmt.Errorf("access denied: API key lacks 'update alerts' permission")
+	}
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 {
+		debug.Logger.Error("API error", "status", httpResp.StatusCode, "body", debug.PrettyJSON(body))
+		if detail := apiErrorDetail(body); detail != "" {
+			return fmt.Errorf("API returned status %d: %s", httpResp.StatusCode, detail)
+		}
+		return fmt.Errorf("API returned status %d", httpResp.StatusCode)
+	}
+
+	c.invalidateAlert(id)
+	return nil
+}
+
+// acquireWrite waits for a free write slot; the returned func releases it
+func (c *Client) acquireWrite(ctx context.Context) (func(), error) {
+	if c.writeSlots == nil {
+		return func() {}, nil
+	}
+	select {
+	case c.writeSlots <- struct{}{}:
+		return func() { <-c.writeSlots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// invalidateAlert drops cached detail and list pages that may contain the alert
+func (c *Client) invalidateAlert(id string) {
+	if c.cache == nil {
+		return
+	}
+	c.cache.DeletePrefix(NewCacheKey(CacheKeyPrefixAlertDetail).With("id", id).Build() + ":")
+	c.cache.DeletePrefix(CacheKeyPrefixAlerts + ":")
+}
+
+// invalidateIncident drops cached detail, events and list pages that may contain the incident
+func (c *Client) invalidateIncident(id string) {
+	if c.cache == nil {
+		return
+	}
+	c.cache.DeletePrefix(NewCacheKey(CacheKeyPrefixIncidentDetail).With("id", id).Build() + ":")
+	c.cache.DeletePrefix(NewCacheKey(CacheKeyPrefixIncidentEvents).With("id", id).Build() + ":")
+	c.cache.DeletePrefix(CacheKeyPrefixIncidents + ":")
+}
+
+// apiErrorDetail extracts a human-readable message from a JSON:API error response
+func apiErrorDetail(body []byte) string {
+	var errResp struct {
+		Errors []struct {
+			Title  string `json:"title"`
+			Detail string `json:"detail"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &errResp); err != nil || len(errResp.Errors) == 0 {
+		return ""
+	}
+	if errResp.Errors[0].Detail != "" {
+		return errResp.Errors[0].Detail
+	}
+	return errResp.Errors[0].Title
+}
+
+// NoAlertSummary is shown for alerts with no summary, description or short ID
+const NoAlertSummary = "(no summary)"
+
+// DisplayTitle returns the alert's summary on a single line, falling back to the
+// first line of its description, then its short ID, then NoAlertSummary
+func (a Alert) DisplayTitle() string {
+	title := strings.Join(strings.Fields(a.Summary), " ")
+	if title == "" {
+		for _, line := range strings.Split(a.Description, "\n") {
+			if title = strings.Join(strings.Fields(line), " "); title != "" {
+				break
+			}
+		}
+	}
+	if title == "" {
+		title = a.ShortID
+	}
+	if title == "" {
+		title = NoAlertSummary
+	}
+	return title
+}
+
+// NeedsAck reports whether the incident is open (open or triggered) and nobody
+// has acknowledged it yet
+func (i *Incident) NeedsAck() bool {
+	switch strings.ToLower(strings.TrimSpace(i.Status)) {
+	case "open", "triggered":
+		return i.AcknowledgedAt == nil || i.AcknowledgedAt.IsZero()
+	}
+	return false
+}
+
+// IsResolved returns true if the incident is resolved or closed
+func (i *Incident) IsResolved() bool {
+	switch strings.ToLower(i.Status) {
+	case IncidentStatusResolved, IncidentStatusClosed:
+		return true
+	}
+	return false
 }
 
 // Duration calculation methods for Incident
@@ -7,11 +7,15 @@ import (
 	"net/http/httptest"
 	"os"
 	"runtime"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/rootlyhq/rootly-tui/internal/config"
+	"github.com/rootlyhq/rootly-tui/internal/debug"
 )
 
 // setupTestEnv sets up a temporary home directory for test isolation.
@@ -255,7 +259,7 @@ func TestListIncidents(t *testing.T) {
 	}
 	defer client.Close()
 
-	result, err := client.ListIncidents(context.Background(), 1, "")
+	result, err := client.ListIncidents(context.Background(), 1, "", "")
 	if err != nil {
 		t.Fatalf("ListIncidents() error = %v", err)
 	}
@@ -313,6 +317,9 @@ func TestListAlerts(t *testing.T) {
 						"source":     "datadog",
 						"created_at": "2025-01-01T10:00:00Z",
 						"updated_at": "2025-01-01T10:00:00Z",
+						"incidents": []map[string]interface{}{
+							{"id": "inc_042", "attributes": map[string]interface{}{"sequential_id": 42, "title": "API outage"}},
+						},
 					},
 				},
 				{
@@ -374,6 +381,14 @@ func TestListAlerts(t *testing.T) {
 		t.Errorf("expected source 'datadog', got '%s'", result.Alerts[0].Source)
 	}
 
+	if result.Alerts[0].IncidentID != "inc_042" || result.Alerts[0].IncidentSeqID != "INC-42" {
+		t.Errorf("expected first alert attached to INC-42, got %q/%q", result.Alerts[0].IncidentID, result.Alerts[0].IncidentSeqID)
+	}
+
+	if result.Alerts[1].IncidentID != "" {
+		t.Errorf("expected second alert unattached, got %q", result.Alerts[1].IncidentID)
+	}
+
 	if result.Alerts[1].Description != "Memory usage is high" {
 		t.Errorf("expected description 'Memory usage is high', got '%s'", result.Alerts[1].Description)
 	}
@@ -415,7 +430,7 @@ func TestListIncidentsError(t *testing.T) {
 	}
 	defer client.Close()
 
-	_, err = client.ListIncidents(context.Background(), 1, "")
+	_, err = client.ListIncidents(context.Background(), 1, "", "")
 	if err == nil {
 		t.Error("expected error for 500 response")
 	}
@@ -574,13 +589,13 @@ func TestListIncidentsWithCache(t *testing.T) {
 	}
 
 	// First call
-	_, err = client.ListIncidents(context.Background(), 1, "")
+	_, err = client.ListIncidents(context.Background(), 1, "", "")
 	if err != nil {
 		t.Fatalf("first ListIncidents() error = %v", err)
 	}
 
 	// Second call should hit cache
-	_, err = client.ListIncidents(context.Background(), 1, "")
+	_, err = client.ListIncidents(context.Background(), 1, "", "")
 	if err != nil {
 		t.Fatalf("second ListIncidents() error = %v", err)
 	}
@@ -590,6 +605,376 @@ func TestListIncidentsWithCache(t *testing.T) {
 	}
 }
 
+func TestListIncidentsTeamFilter(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	var teams []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		teams = append(teams, r.URL.Query().Get("filter[team_names]"))
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{"data":[{"id":"inc_001","attributes":{"title":"Test Incident","status":"in_progress"}}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	// Each team is its own cache entry; repeating one is served from the cache
+	for _, team := range []string{"", "Site Reliability", "", "Site Reliability"} {
+		if _, err := client.ListIncidents(context.Background(), 1, "", team); err != nil {
+			t.Fatalf("ListIncidents(%q) error = %v", team, err)
+		}
+	}
+	want := []string{"", "Site Reliability"}
+	if client.cache == nil {
+		want = append(want, want...)
+	}
+	if strings.Join(teams, "|") != strings.Join(want, "|") {
+		t.Errorf("expected team filters %q, got %q", want, teams)
+	}
+}
+
+func TestListIncidentsCacheDisabled(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	callCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{"data":[{"id":"inc_001","attributes":{"title":"Test Incident","status":"in_progress"}}]}`))
+	}))
+	defer server.Close()
+
+	ttl := 0
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL, CacheTTLSeconds: &ttl})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if client.cache != nil {
+		t.Fatal("expected a 0 TTL to disable the cache")
+	}
+	for i := 0; i < 2; i++ {
+		result, err := client.ListIncidents(context.Background(), 1, "", "")
+		if err != nil {
+			t.Fatalf("ListIncidents() error = %v", err)
+		}
+		if result.CachedAge != 0 {
+			t.Errorf("expected an uncached result, got age %v", result.CachedAge)
+		}
+	}
+	if callCount != 2 {
+		t.Errorf("expected 2 API calls with caching disabled, got %d", callCount)
+	}
+}
+
+func TestListTeams(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	callCount := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		if r.URL.Path != "/v1/teams" {
+			t.Errorf("expected path /v1/teams, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.WriteHeader(http.StatusOK)
+		response := map[string]interface{}{
+			"data": []map[string]interface{}{
+				{
+					"id":   "team_002",
+					"type": "groups",
+					"attributes": map[string]interface{}{
+						"name": "SRE",
+						"slug": "sre",
+					},
+				},
+				{
+					"id":   "team_001",
+					"type": "groups",
+					"attributes": map[string]interface{}{
+						"name": "Platform",
+						"slug": "platform",
+					},
+				},
+			},
+			"links": map[string]interface{}{},
+			"meta":  map[string]interface{}{},
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		APIKey:   "test-key",
+		Endpoint: server.URL,
+	}
+
+	client, err := NewClient(cfg)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	teams, err := client.ListTeams(context.Background())
+	if err != nil {
+		t.Fatalf("ListTeams() error = %v", err)
+	}
+
+	if len(teams) != 2 {
+		t.Fatalf("expected 2 teams, got %d", len(teams))
+	}
+	// Sorted by name
+	if teams[0].Name != "Platform" || teams[1].Name != "SRE" {
+		t.Errorf("expected teams sorted by name, got %q, %q", teams[0].Name, teams[1].Name)
+	}
+	if teams[0].ID != "team_001" || teams[0].Slug != "platform" {
+		t.Errorf("unexpected team fields: %+v", teams[0])
+	}
+
+	// Second call should hit cache
+	if client.cache == nil {
+		t.Skip("persistent cache not available in test environment")
+	}
+	if _, err := client.ListTeams(context.Background()); err != nil {
+		t.Fatalf("second ListTeams() error = %v", err)
+	}
+	if callCount != 1 {
+		t.Errorf("expected 1 API call (cached), got %d", callCount)
+	}
+}
+
+func TestReopenIncident(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/incidents/inc_001" {
+			t.Errorf("expected path /v1/incidents/inc_001, got %s", r.URL.Path)
+		}
+
+		var body struct {
+			Data struct {
+				Type       string `json:"type"`
+				Attributes struct {
+					Status string `json:"status"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if body.Data.Type != "incidents" {
+			t.Errorf("expected type 'incidents', got %q", body.Data.Type)
+		}
+		if body.Data.Attributes.Status != "started" {
+			t.Errorf("expected status 'started', got %q", body.Data.Attributes.Status)
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.WriteHeader(http.StatusOK)
+		response := map[string]interface{}{
+			"data": map[string]interface{}{
+				"id": "inc_001",
+				"attributes": map[string]interface{}{
+					"sequential_id": 42,
+					"title":         "Reopened Incident",
+					"status":        "started",
+					"created_at":    "2025-01-01T10:00:00Z",
+				},
+			},
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		APIKey:   "test-key",
+		Endpoint: server.URL,
+	}
+
+	client, err := NewClient(cfg)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	incident, err := client.ReopenIncident(context.Background(), "inc_001")
+	if err != nil {
+		t.Fatalf("ReopenIncident() error = %v", err)
+	}
+	if incident.Status != "started" {
+		t.Errorf("expected status 'started', got %q", incident.Status)
+	}
+	if incident.SequentialID != "INC-42" {
+		t.Errorf("expected sequential ID 'INC-42', got %q", incident.SequentialID)
+	}
+}
+
+func TestReopenIncidentValidationError(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"errors":[{"title":"Unprocessable","detail":"Status transition not allowed"}]}`))
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		APIKey:   "test-key",
+		Endpoint: server.URL,
+	}
+
+	client, err := NewClient(cfg)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	_, err = client.ReopenIncident(context.Background(), "inc_001")
+	if err == nil {
+		t.Fatal("expected error for 422 response")
+	}
+	if !strings.Contains(err.Error(), "Status transition not allowed") {
+		t.Errorf("expected API error detail in message, got %q", err.Error())
+	}
+}
+
+func TestAcknowledgeIncident(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/v1/incidents/inc_001" {
+			t.Errorf("expected PATCH /v1/incidents/inc_001, got %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Data struct {
+				Attributes struct {
+					Status string `json:"status"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if body.Data.Attributes.Status != "acknowledged" {
+			t.Errorf("expected status 'acknowledged', got %q", body.Data.Attributes.Status)
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{"id":"inc_001","attributes":{"sequential_id":7,"status":"acknowledged","created_at":"2025-01-01T10:00:00Z"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	incident, err := client.AcknowledgeIncident(context.Background(), "inc_001")
+	if err != nil {
+		t.Fatalf("AcknowledgeIncident() error = %v", err)
+	}
+	if incident.Status != "acknowledged" || incident.SequentialID != "INC-7" {
+		t.Errorf("expected INC-7 acknowledged, got %q %q", incident.SequentialID, incident.Status)
+	}
+}
+
+func TestAcknowledgeIncidentForbidden(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":[{"title":"Forbidden","detail":"You are not a responder on this incident"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	_, err = client.AcknowledgeIncident(context.Background(), "inc_001")
+	if err == nil || !strings.Contains(err.Error(), "You are not a responder on this incident") {
+		t.Errorf("expected the API error detail for a 403, got %v", err)
+	}
+}
+
+func TestAlertDisplayTitle(t *testing.T) {
+	tests := []struct {
+		name  string
+		alert Alert
+		want  string
+	}{
+		{"summary", Alert{Summary: "CPU high\non web-1", Description: "ignored"}, "CPU high on web-1"},
+		{"description first line", Alert{Description: "\n  Disk almost full  \nsecond line", ShortID: "abc"}, "Disk almost full"},
+		{"short ID", Alert{Summary: "  ", ShortID: "abc"}, "abc"},
+		{"nothing", Alert{}, "(no summary)"},
+	}
+	for _, tt := range tests {
+		if got := tt.alert.DisplayTitle(); got != tt.want {
+			t.Errorf("%s: DisplayTitle() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIncidentNeedsAck(t *testing.T) {
+	acked := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		status string
+		ackAt  *time.Time
+		want   bool
+	}{
+		{"open", nil, true},
+		{"Triggered", nil, true},
+		{"open", &time.Time{}, true},
+		{"open", &acked, false},
+		{"triggered", &acked, false},
+		{"started", nil, false},
+		{"resolved", nil, false},
+		{"", nil, false},
+	}
+	for _, tt := range tests {
+		inc := Incident{Status: tt.status, AcknowledgedAt: tt.ackAt}
+		if got := inc.NeedsAck(); got != tt.want {
+			t.Errorf("NeedsAck(%q, acknowledged %v) = %v, want %v", tt.status, tt.ackAt, got, tt.want)
+		}
+	}
+}
+
+func TestIncidentIsResolved(t *testing.T) {
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{"resolved", true},
+		{"closed", true},
+		{"Resolved", true},
+		{"started", false},
+		{"mitigated", false},
+		{"cancelled", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		inc := Incident{Status: tt.status}
+		if got := inc.IsResolved(); got != tt.want {
+			t.Errorf("IsResolved(%q) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
 func TestListAlertsWithLabels(t *testing.T) {
 	defer setupTestEnv(t)()
 
@@ -726,7 +1111,7 @@ func TestListIncidentsWithTimestamps(t *testing.T) {
 	}
 	defer client.Close()
 
-	result, err := client.ListIncidents(context.Background(), 1, "")
+	result, err := client.ListIncidents(context.Background(), 1, "", "")
 	if err != nil {
 		t.Fatalf("ListIncidents() error = %v", err)
 	}
@@ -799,6 +1184,11 @@ func TestGetIncident(t *testing.T) {
 		if !strings.Contains(r.URL.Path, "/v1/incidents/inc_123") {
 			t.Errorf("unexpected path: %s", r.URL.Path)
 		}
+		// Action items are fetched separately
+		if strings.HasSuffix(r.URL.Path, "/action_items") {
+			_, _ = w.Write([]byte(`{"data":[]}`))
+			return
+		}
 		// Verify includes are requested
 		if !strings.Contains(r.URL.RawQuery, "include=") {
 			t.Error("expected include parameter in query")
@@ -1047,6 +1437,18 @@ func TestGetAlert(t *testing.T) {
 							},
 						},
 					},
+					"escalation_policy": map[string]interface{}{
+						"data": map[string]interface{}{
+							"attributes": map[string]interface{}{
+								"name": "Primary On-call",
+								"on_call_users": []map[string]interface{}{
+									{"name": "Jane Smith", "email": "jane@example.com"},
+									{"name": "", "email": "bob@example.com"},
+								},
+							},
+						},
+					},
+					"escalation_level": 2,
 				},
 			},
 		}
@@ -1109,11 +1511,230 @@ func TestGetAlert(t *testing.T) {
 	if len(alert.Groups) != 1 || alert.Groups[0] != "platform-team" {
 		t.Errorf("expected Groups=['platform-team'], got %v", alert.Groups)
 	}
+	if alert.EscalationPolicy != "Primary On-call" {
+		t.Errorf("expected EscalationPolicy='Primary On-call', got %s", alert.EscalationPolicy)
+	}
+	if alert.EscalationLevel != 2 {
+		t.Errorf("expected EscalationLevel=2, got %d", alert.EscalationLevel)
+	}
+	if len(alert.OnCall) != 2 || alert.OnCall[0] != "Jane Smith" || alert.OnCall[1] != "bob@example.com" {
+		t.Errorf("expected OnCall=['Jane Smith' 'bob@example.com'], got %v", alert.OnCall)
+	}
 	if alert.UpdatedAt.IsZero() {
 		t.Error("expected UpdatedAt to be set")
 	}
 }
 
+func TestDetailIncludesFromConfig(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	var includes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/action_items") {
+			_, _ = w.Write([]byte(`{"data":[]}`))
+			return
+		}
+		includes = append(includes, r.URL.Query().Get("include"))
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{"data":{"id":"x","attributes":{}}}`))
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		APIKey:           "test-key",
+		Endpoint:         server.URL,
+		IncidentIncludes: " roles, services ,roles",
+		AlertIncludes:    "services",
+	}
+	client, err := NewClient(cfg)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if _, err := client.GetIncident(context.Background(), "inc_1", time.Now()); err != nil {
+		t.Fatalf("GetIncident() error = %v", err)
+	}
+	if _, err := client.GetAlert(context.Background(), "alert_1", time.Now()); err != nil {
+		t.Fatalf("GetAlert() error = %v", err)
+	}
+	if len(includes) != 2 || includes[0] != "roles,services" || includes[1] != "services" {
+		t.Errorf("expected only the configured includes, got %q", includes)
+	}
+}
+
+func TestParseIncludes(t *testing.T) {
+	if got := parseIncludes("", DefaultAlertIncludes, "alert"); !slices.Equal(got, DefaultAlertIncludes) {
+		t.Errorf("expected defaults for an empty list, got %v", got)
+	}
+	// Unknown names are warned about but still requested
+	if got := parseIncludes("services,custom_fields", DefaultAlertIncludes, "alert"); !slices.Equal(got, []string{"services", "custom_fields"}) {
+		t.Errorf("unexpected includes: %v", got)
+	}
+}
+
+func TestValidateEndpoint(t *testing.T) {
+	for _, ep := range []string{"", "api.rootly.com", "https://api.rootly.com", "HTTP://localhost:22056", "localhost:22056/api", "127.0.0.1:8080", "https://[::1]:3000"} {
+		if err := ValidateEndpoint(ep); err != nil {
+			t.Errorf("ValidateEndpoint(%q) error = %v", ep, err)
+		}
+	}
+	for _, ep := range []string{"not a url", "ftp://api.rootly.com", "https://", "api.rootly.com:port", "api..rootly.com", "-api.rootly.com", "api_rootly!com"} {
+		if err := ValidateEndpoint(ep); err == nil {
+			t.Errorf("expected ValidateEndpoint(%q) to fail", ep)
+		}
+	}
+}
+
+func TestGetIncidentActionItems(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/incidents/inc_123", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{"data":{"id":"inc_123","type":"incidents","attributes":{"title":"Database outage","status":"resolved"}}}`))
+	})
+	taskRequests := 0
+	mux.HandleFunc("/v1/incidents/inc_123/action_items", func(w http.ResponseWriter, r *http.Request) {
+		taskRequests++
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":"1","attributes":{"summary":"Rotate database credentials","status":"done","assigned_to":{"full_name":"Jane Smith"}}},
+			{"id":"2","attributes":{"summary":"Add replication lag alert","status":"open"}},
+			{"id":"3","attributes":{"summary":"  ","status":"open"}}
+		]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	updatedAt := time.Now()
+	incident, err := client.GetIncident(context.Background(), "inc_123", updatedAt)
+	if err != nil {
+		t.Fatalf("GetIncident() error = %v", err)
+	}
+	if len(incident.ActionItems) != 2 {
+		t.Fatalf("expected 2 action items (blank titles skipped), got %d", len(incident.ActionItems))
+	}
+	first := incident.ActionItems[0]
+	if first.Title != "Rotate database credentials" || !first.IsDone() || first.AssigneeName != "Jane Smith" {
+		t.Errorf("unexpected first action item: %+v", first)
+	}
+	if incident.ActionItems[1].IsDone() {
+		t.Error("expected open action item not to be done")
+	}
+
+	// Action items are cached with the detail
+	cached, err := client.GetIncident(context.Background(), "inc_123", updatedAt)
+	if err != nil {
+		t.Fatalf("GetIncident() error = %v", err)
+	}
+	if taskRequests != 1 || len(cached.ActionItems) != 2 {
+		t.Errorf("expected cached action items without another request, got %d requests", taskRequests)
+	}
+}
+
+func TestGetDetailFromCache(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if strings.HasPrefix(r.URL.Path, "/v1/alerts/") {
+			_, _ = w.Write([]byte(`{"data":{"id":"alert_1","type":"alerts","attributes":{"summary":"CPU high","status":"triggered"}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"id":"inc_1","type":"incidents","attributes":{"title":"Database outage","status":"started"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	updatedAt := time.Now()
+	for i, want := range []bool{false, true} {
+		if _, fromCache, err := client.GetIncidentDetail(context.Background(), "inc_1", updatedAt); err != nil || fromCache != want {
+			t.Errorf("incident fetch %d: fromCache = %v, want %v (err %v)", i+1, fromCache, want, err)
+		}
+		if _, fromCache, err := client.GetAlertDetail(context.Background(), "alert_1", updatedAt); err != nil || fromCache != want {
+			t.Errorf("alert fetch %d: fromCache = %v, want %v (err %v)", i+1, fromCache, want, err)
+		}
+	}
+}
+
+func TestResponseBody(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	bodies := map[string]string{
+		"inc_a": `{"data":{"id":"inc_a","type":"incidents","attributes":{"title":"Database outage","status":"started"}}}`,
+		"inc_b": `{"data":{"id":"inc_b","type":"incidents","attributes":{"title":"Queue backlog","status":"started"}}}`,
+	}
+	oversize := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/v1/incidents/")
+		body := bodies[id]
+		if oversize {
+			// Valid JSON just over the retention limit
+			body = `{"data":{"id":"` + id + `","type":"incidents","attributes":{"title":"` + strings.Repeat("x", maxResponseSize) + `"}}}`
+		}
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	// Not retained outside debug mode
+	if _, err := client.GetIncident(context.Background(), "inc_a", time.Now()); err != nil {
+		t.Fatalf("GetIncident() error = %v", err)
+	}
+	if got := client.ResponseBody("inc_a"); got != nil {
+		t.Errorf("expected no retained body outside debug mode, got %s", got)
+	}
+
+	debug.Enabled = true
+	defer func() { debug.Enabled = false }()
+
+	// Viewing A, then prefetching B, keeps each body under its own ID
+	updatedAt := time.Now().Add(time.Hour)
+	for _, id := range []string{"inc_a", "inc_b"} {
+		if _, err := client.GetIncident(context.Background(), id, updatedAt); err != nil {
+			t.Fatalf("GetIncident(%s) error = %v", id, err)
+		}
+	}
+	for id, body := range bodies {
+		if got := client.ResponseBody(id); string(got) != body {
+			t.Errorf("expected the body fetched for %s, got %s", id, got)
+		}
+	}
+	if !json.Valid([]byte(debug.PrettyJSON(client.ResponseBody("inc_a")))) {
+		t.Error("expected pretty-printed body to be valid JSON")
+	}
+
+	// An oversize response drops the body kept before rather than leaving it
+	oversize = true
+	if _, err := client.GetIncident(context.Background(), "inc_a", updatedAt.Add(time.Hour)); err != nil {
+		t.Fatalf("GetIncident() error = %v", err)
+	}
+	if got := client.ResponseBody("inc_a"); got != nil {
+		t.Errorf("expected no body for an oversize response, got %d bytes", len(got))
+	}
+	if got := client.ResponseBody("inc_b"); string(got) != bodies["inc_b"] {
+		t.Errorf("expected the other body to be kept, got %s", got)
+	}
+}
+
 func TestGetIncidentError(t *testing.T) {
 	defer setupTestEnv(t)()
 
@@ -1185,7 +1806,7 @@ func TestListIncidentsInvalidJSON(t *testing.T) {
 	}
 	defer client.Close()
 
-	_, err = client.ListIncidents(context.Background(), 1, "")
+	_, err = client.ListIncidents(context.Background(), 1, "", "")
 	if err == nil {
 		t.Error("expected error for invalid JSON response")
 	}
@@ -1306,7 +1927,7 @@ func TestListIncidentsHTTPError(t *testing.T) {
 	}
 	defer client.Close()
 
-	_, err = client.ListIncidents(context.Background(), 1, "")
+	_, err = client.ListIncidents(context.Background(), 1, "", "")
 	if err == nil {
 		t.Error("expected error for unreachable host")
 	}
@@ -1407,7 +2028,7 @@ func TestListIncidentsWithPagination(t *testing.T) {
 	}
 	defer client.Close()
 
-	result, err := client.ListIncidents(context.Background(), 2, "")
+	result, err := client.ListIncidents(context.Background(), 2, "", "")
 	if err != nil {
 		t.Fatalf("ListIncidents() error = %v", err)
 	}
@@ -1492,7 +2113,7 @@ func TestIncidentsWithEmptyData(t *testing.T) {
 	}
 	defer client.Close()
 
-	result, err := client.ListIncidents(context.Background(), 1, "")
+	result, err := client.ListIncidents(context.Background(), 1, "", "")
 	if err != nil {
 		t.Fatalf("ListIncidents() error = %v", err)
 	}
@@ -1781,3 +2402,145 @@ func TestTruncateToTwoDecimals(t *testing.T) {
 func ptrTime(t time.Time) *time.Time {
 	return &t
 }
+
+func TestClientPageSize(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	var gotSize string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSize = r.URL.Query().Get("page[size]")
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{"data":[],"links":{},"meta":{"current_page":1}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if client.PageSize() != DefaultPageSize {
+		t.Errorf("expected default page size %d, got %d", DefaultPageSize, client.PageSize())
+	}
+
+	client.SetPageSize(40)
+	if _, err := client.ListIncidents(context.Background(), 1, "", ""); err != nil {
+		t.Fatalf("ListIncidents() error = %v", err)
+	}
+	if gotSize != "40" {
+		t.Errorf("expected page[size]=40, got %q", gotSize)
+	}
+
+	client.SetPageSize(1000)
+	if client.PageSize() != MaxPageSize {
+		t.Errorf("expected page size clamped to %d, got %d", MaxPageSize, client.PageSize())
+	}
+	client.SetPageSize(0)
+	if client.PageSize() != DefaultPageSize {
+		t.Errorf("expected default page size after reset, got %d", client.PageSize())
+	}
+}
+
+func TestWritesLimitConcurrency(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	var active, peak, requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		isAck := r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/acknowledge")
+		isStatus := r.Method == http.MethodPatch && r.URL.Path == "/v1/incidents/inc_001"
+		if !isAck && !isStatus {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		requests.Add(1)
+		n := active.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		active.Add(-1)
+		w.WriteHeader(http.StatusOK)
+		if isStatus {
+			_, _ = w.Write([]byte(`{"data":{"id":"inc_001","attributes":{"status":"resolved"}}}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	// Alert acknowledgements and incident status updates share the write slots
+	var wg sync.WaitGroup
+	for i := 0; i < 3*MaxConcurrentWrites; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if i%2 == 0 {
+				if err := client.AcknowledgeAlert(context.Background(), "alert_001"); err != nil {
+					t.Errorf("AcknowledgeAlert() error = %v", err)
+				}
+				return
+			}
+			if _, err := client.UpdateIncidentStatus(context.Background(), "inc_001", IncidentStatusResolved); err != nil {
+				t.Errorf("UpdateIncidentStatus() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := requests.Load(); got != 3*MaxConcurrentWrites {
+		t.Errorf("expected %d requests, got %d", 3*MaxConcurrentWrites, got)
+	}
+	if got := peak.Load(); got > MaxConcurrentWrites {
+		t.Errorf("expected at most %d concurrent requests, got %d", MaxConcurrentWrites, got)
+	}
+}
+
+func TestAlertActions(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	var gotMethod, gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath = r.Method, r.URL.Path
+		if r.URL.Path == "/v1/alerts/alert_403/resolve" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	tests := []struct {
+		name string
+		call func(context.Context, string) error
+		path string
+	}{
+		{"acknowledge", client.AcknowledgeAlert, "/v1/alerts/alert_001/acknowledge"},
+		{"resolve", client.ResolveAlert, "/v1/alerts/alert_001/resolve"},
+	}
+	for _, tt := range tests {
+		if err := tt.call(context.Background(), "alert_001"); err != nil {
+			t.Errorf("%s: error = %v", tt.name, err)
+		}
+		if gotMethod != http.MethodPost || gotPath != tt.path {
+			t.Errorf("%s: expected POST %s, got %s %s", tt.name, tt.path, gotMethod, gotPath)
+		}
+	}
+
+	err = client.ResolveAlert(context.Background(), "alert_403")
+	if err == nil || !strings.Contains(err.Error(), "update alerts") {
+		t.Errorf("expected a permission error for a 403, got %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+)
+
+// DiagnoseStatus identifies which connectivity step failed
+type DiagnoseStatus string
+
+const (
+	DiagnoseOK      DiagnoseStatus = "ok"
+	DiagnoseDNS     DiagnoseStatus = "dns"     // Host name could not be resolved
+	DiagnoseConnect DiagnoseStatus = "connect" // TCP connection failed
+	DiagnoseTLS     DiagnoseStatus = "tls"     // TLS handshake failed
+	DiagnoseAuth    DiagnoseStatus = "auth"    // API rejected the credentials (401)
+	DiagnoseHTTP    DiagnoseStatus = "http"    // Any other API error
+)
+
+// DiagnoseResult is the outcome of a connectivity check
+type DiagnoseResult struct {
+	Status DiagnoseStatus
+	Host   string
+	Err    error
+}
+
+// OK returns true if every step succeeded
+func (r DiagnoseResult) OK() bool {
+	return r.Status == DiagnoseOK
+}
+
+// Diagnose checks connectivity step by step (DNS, TCP, TLS, authentication)
+// so setup can tell the user exactly what is wrong.
+func (c *Client) Diagnose(ctx context.Context) DiagnoseResult {
+	rawURL := c.endpoint
+	if !strings.HasPrefix(rawURL, "http://") && !strings.HasPrefix(rawURL, "https://") {
+		rawURL = "https://" + rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return DiagnoseResult{Status: DiagnoseDNS, Host: c.endpoint, Err: fmt.Errorf("invalid endpoint %q", c.endpoint)}
+	}
+	host := u.Hostname()
+	port := u.Port()
+	if port == "" {
+		port = "443"
+		if u.Scheme == "http" {
+			port = "80"
+		}
+	}
+
+	debug.Logger.Debug("Diagnosing connection", "host", host, "port", port, "scheme", u.Scheme)
+
+	// DNS
+	if net.ParseIP(host) == nil {
+		if _, err := net.DefaultResolver.LookupHost(ctx, host); err != nil {
+			debug.Logger.Warn("DNS lookup failed", "host", host, "error", err)
+			return DiagnoseResult{Status: DiagnoseDNS, Host: host, Err: err}
+		}
+	}
+
+	// TCP
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		debug.Logger.Warn("TCP connect failed", "host", host, "port", port, "error", err)
+		return DiagnoseResult{Status: DiagnoseConnect, Host: host, Err: err}
+	}
+
+	// TLS
+	if u.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12})
+		err = tlsConn.HandshakeContext(ctx)
+		_ = tlsConn.Close()
+		if err != nil {
+			debug.Logger.Warn("TLS handshake failed", "host", host, "error", err)
+			return DiagnoseResult{Status: DiagnoseTLS, Host: host, Err: err}
+		}
+	} else {
+		_ = conn.Close()
+	}
+
+	// Authentication
+	resp, err := c.client.GetCurrentUserWithResponse(ctx)
+	if err != nil {
+		return DiagnoseResult{Status: DiagnoseHTTP, Host: host, Err: fmt.Errorf("API request failed: %w", err)}
+	}
+	switch resp.StatusCode() {
+	case 200:
+		return DiagnoseResult{Status: DiagnoseOK, Host: host}
+	case 401:
+		return DiagnoseResult{Status: DiagnoseAuth, Host: host, Err: fmt.Errorf("invalid API key")}
+	default:
+		return DiagnoseResult{Status: DiagnoseHTTP, Host: host, Err: fmt.Errorf("API returned status %d", resp.StatusCode())}
+	}
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+func diagnoseEndpoint(t *testing.T, endpoint string) DiagnoseResult {
+	t.Helper()
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: endpoint})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return client.Diagnose(ctx)
+}
+
+func TestDiagnoseAPIStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		expected DiagnoseStatus
+	}{
+		{"success", http.StatusOK, DiagnoseOK},
+		{"invalid key", http.StatusUnauthorized, DiagnoseAuth},
+		{"server error", http.StatusInternalServerError, DiagnoseHTTP},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer setupTestEnv(t)()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/vnd.api+json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"data":{"id":"1","type":"users","attributes":{}}}`))
+			}))
+			defer server.Close()
+
+			result := diagnoseEndpoint(t, server.URL)
+			if result.Status != tt.expected {
+				t.Errorf("expected status %s, got %s (err: %v)", tt.expected, result.Status, result.Err)
+			}
+			if result.OK() != (tt.expected == DiagnoseOK) {
+				t.Errorf("unexpected OK() = %v", result.OK())
+			}
+		})
+	}
+}
+
+func TestDiagnoseTLSFailure(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	// Self-signed certificate is not trusted, so the handshake fails
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	result := diagnoseEndpoint(t, server.URL)
+	if result.Status != DiagnoseTLS {
+		t.Errorf("expected status %s, got %s (err: %v)", DiagnoseTLS, result.Status, result.Err)
+	}
+}
+
+func TestDiagnoseConnectFailure(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	// Grab a free port and close it so nothing is listening
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	addr := ln.Addr().String()
+	_ = ln.Close()
+
+	result := diagnoseEndpoint(t, "http://"+addr)
+	if result.Status != DiagnoseConnect {
+		t.Errorf("expected status %s, got %s (err: %v)", DiagnoseConnect, result.Status, result.Err)
+	}
+}
+
+func TestDiagnoseDNSFailure(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	// The .invalid TLD is reserved and never resolves
+	result := diagnoseEndpoint(t, "rootly-tui-test.invalid")
+	if result.Status != DiagnoseDNS {
+		t.Errorf("expected status %s, got %s (err: %v)", DiagnoseDNS, result.Status, result.Err)
+	}
+	if result.Host != "rootly-tui-test.invalid" {
+		t.Errorf("expected host in result, got %q", result.Host)
+	}
+}
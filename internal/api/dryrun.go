@@ -0,0 +1,27 @@
+package api
+
+import "github.com/rootlyhq/rootly-tui/internal/debug"
+
+// SetDryRun makes write methods log the request they would send and return a
+// synthetic success instead of calling the API
+func (c *Client) SetDryRun(enabled bool) {
+	c.dryRun.Store(enabled)
+}
+
+// DryRun reports whether write methods are skipped
+func (c *Client) DryRun() bool {
+	return c.dryRun.Load()
+}
+
+// skipWrite logs a write request and reports whether dry-run mode skips sending it
+func (c *Client) skipWrite(method, url string, body []byte) bool {
+	if !c.DryRun() {
+		return false
+	}
+	debug.Logger.Info("Dry run: write request not sent",
+		"method", method,
+		"url", url,
+		"body", string(body),
+	)
+	return true
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+func TestDryRunSkipsWrites(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL, DryRun: true})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if !client.DryRun() {
+		t.Fatal("expected dry_run config to enable dry-run mode")
+	}
+
+	incident, err := client.UpdateIncidentStatus(context.Background(), "inc_001", IncidentStatusResolved)
+	if err != nil {
+		t.Fatalf("UpdateIncidentStatus() error = %v", err)
+	}
+	if incident == nil || incident.ID != "inc_001" || incident.Status != IncidentStatusResolved {
+		t.Errorf("expected a synthetic resolved incident, got %+v", incident)
+	}
+	if err := client.AcknowledgeAlert(context.Background(), "alert_001"); err != nil {
+		t.Errorf("AcknowledgeAlert() error = %v", err)
+	}
+
+	if requests != 0 {
+		t.Errorf("expected no HTTP requests in dry-run mode, got %d", requests)
+	}
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+)
+
+// IncidentEvent is an entry in an incident's timeline: a note, a status
+// change or another recorded event
+type IncidentEvent struct {
+	Kind       string // e.g. "event" or "trail"
+	Message    string
+	Actor      string // Who recorded it; empty for automated events
+	OccurredAt time.Time
+}
+
+// IncidentEventsResult contains incident events and pagination info
+type IncidentEventsResult struct {
+	Events     []IncidentEvent
+	Pagination PaginationInfo
+}
+
+// ListIncidentEvents fetches a page of an incident's timeline, newest first.
+// Pages are cached until they expire or the incident is changed through the
+// client (e.g. a note is added).
+func (c *Client) ListIncidentEvents(ctx context.Context, id string, page int) (*IncidentEventsResult, error) {
+	cacheKey := NewCacheKey(CacheKeyPrefixIncidentEvents).
+		With("id", id).
+		With("page", page).
+		Build()
+
+	if c.cache != nil {
+		var cached IncidentEventsResult
+		if _, ok := c.cache.GetTyped(cacheKey, &cached); ok {
+			debug.Logger.Debug("Cache hit for incident events", "key", cacheKey)
+			return &cached, nil
+		}
+	}
+
+	var resp struct {
+		Data []struct {
+			Attributes struct {
+				Kind            string  `json:"kind"`
+				Event           string  `json:"event"`
+				EventRaw        *string `json:"event_raw"`
+				UserDisplayName *string `json:"user_display_name"`
+				Source          string  `json:"source"`
+				OccurredAt      string  `json:"occurred_at"`
+				CreatedAt       string  `json:"created_at"`
+			} `json:"attributes"`
+		} `json:"data"`
+		Meta struct {
+			CurrentPage int  `json:"current_page"`
+			NextPage    *int `json:"next_page"`
+			PrevPage    *int `json:"prev_page"`
+			TotalPages  int  `json:"total_pages"`
+			TotalCount  int  `json:"total_count"`
+		} `json:"meta"`
+	}
+	path := fmt.Sprintf("/v1/incidents/%s/events?page[number]=%d&page[size]=%d&sort=-occurred_at", url.PathEscape(id), page, c.PageSize())
+	if err := c.getJSON(ctx, path, "read incident events", &resp); err != nil {
+		return nil, err
+	}
+
+	events := make([]IncidentEvent, 0, len(resp.Data))
+	for _, d := range resp.Data {
+		a := d.Attributes
+		event := IncidentEvent{Kind: a.Kind}
+		// event_raw is the plain text of the (possibly HTML) event
+		if a.EventRaw != nil && strings.TrimSpace(*a.EventRaw) != "" {
+			event.Message = strings.TrimSpace(*a.EventRaw)
+		} else {
+			event.Message = strings.TrimSpace(a.Event)
+		}
+		if event.Message == "" {
+			continue
+		}
+		if a.UserDisplayName != nil {
+			event.Actor = strings.TrimSpace(*a.UserDisplayName)
+		}
+		occurred := a.OccurredAt
+		if occurred == "" {
+			occurred = a.CreatedAt
+		}
+		if t, err := time.Parse(time.RFC3339, occurred); err == nil {
+			event.OccurredAt = t
+		}
+		events = append(events, event)
+	}
+
+	currentPage := page
+	if resp.Meta.CurrentPage > 0 {
+		currentPage = resp.Meta.CurrentPage
+	}
+	result := &IncidentEventsResult{
+		Events: events,
+		Pagination: PaginationInfo{
+			CurrentPage: currentPage,
+			TotalPages:  resp.Meta.TotalPages,
+			TotalCount:  resp.Meta.TotalCount,
+			HasNext:     resp.Meta.NextPage != nil && *resp.Meta.NextPage > 0,
+			HasPrev:     resp.Meta.PrevPage != nil && *resp.Meta.PrevPage > 0,
+		},
+	}
+
+	if c.cache != nil {
+		c.cache.Set(cacheKey, result)
+		debug.Logger.Debug("Cached incident events", "count", len(events), "key", cacheKey)
+	}
+	return result, nil
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+func TestListIncidentEvents(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path != "/v1/incidents/inc_001/events" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("sort"); got != "-occurred_at" {
+			t.Errorf("expected newest first, got sort %q", got)
+		}
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":"evt_2","attributes":{"kind":"event","event":"<p>Rolled back</p>","event_raw":"Rolled back","user_display_name":"Jane Doe","occurred_at":"2025-01-01T10:30:00Z"}},
+			{"id":"evt_1","attributes":{"kind":"trail","event":"Status changed to started","user_display_name":null,"created_at":"2025-01-01T10:00:00Z"}},
+			{"id":"evt_0","attributes":{"kind":"event","event":"  "}}
+		],"meta":{"current_page":1,"next_page":2,"total_pages":2,"total_count":30}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	result, err := client.ListIncidentEvents(context.Background(), "inc_001", 1)
+	if err != nil {
+		t.Fatalf("ListIncidentEvents() error = %v", err)
+	}
+	if len(result.Events) != 2 {
+		t.Fatalf("expected blank events to be skipped, got %+v", result.Events)
+	}
+	note := result.Events[0]
+	if note.Message != "Rolled back" || note.Actor != "Jane Doe" || !note.OccurredAt.Equal(time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected note %+v", note)
+	}
+	if trail := result.Events[1]; trail.Kind != "trail" || trail.Actor != "" || trail.OccurredAt.IsZero() {
+		t.Errorf("expected created_at as a fallback and no actor, got %+v", trail)
+	}
+	if !result.Pagination.HasNext || result.Pagination.TotalCount != 30 {
+		t.Errorf("unexpected pagination %+v", result.Pagination)
+	}
+
+	if _, err := client.ListIncidentEvents(context.Background(), "inc_001", 1); err != nil {
+		t.Fatalf("ListIncidentEvents() from cache error = %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected the second call to be cached, got %d requests", calls)
+	}
+	client.invalidateIncident("inc_001")
+	if _, err := client.ListIncidentEvents(context.Background(), "inc_001", 1); err != nil {
+		t.Fatalf("ListIncidentEvents() error = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected invalidating the incident to drop its events, got %d requests", calls)
+	}
+}
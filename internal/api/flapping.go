@@ -0,0 +1,64 @@
+package api
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Flapping detection: an alert is flapping when it (same summary and source)
+// fired at least FlapMinOccurrences times within FlapWindow
+const (
+	FlapMinOccurrences = 3
+	FlapWindow         = time.Hour
+)
+
+// FiredAt returns when the alert started firing, falling back to its creation time
+func (a *Alert) FiredAt() time.Time {
+	if a.StartedAt != nil && !a.StartedAt.IsZero() {
+		return *a.StartedAt
+	}
+	return a.CreatedAt
+}
+
+// DetectFlapping groups alerts by summary and source and returns, for each alert
+// in a flapping group, the most occurrences seen within FlapWindow
+func DetectFlapping(alerts []Alert) map[string]int {
+	groups := make(map[string][]*Alert)
+	for i := range alerts {
+		a := &alerts[i]
+		if a.FiredAt().IsZero() {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(a.Summary)) + "\x00" + strings.ToLower(a.Source)
+		groups[key] = append(groups[key], a)
+	}
+
+	flapping := make(map[string]int)
+	for _, group := range groups {
+		if len(group) < FlapMinOccurrences {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].FiredAt().Before(group[j].FiredAt())
+		})
+
+		// Slide a window over the firing times, keeping the densest burst
+		most, start := 0, 0
+		for end := range group {
+			for group[end].FiredAt().Sub(group[start].FiredAt()) > FlapWindow {
+				start++
+			}
+			if n := end - start + 1; n > most {
+				most = n
+			}
+		}
+		if most < FlapMinOccurrences {
+			continue
+		}
+		for _, a := range group {
+			flapping[a.ID] = most
+		}
+	}
+	return flapping
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDetectFlapping(t *testing.T) {
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		ts := base.Add(d)
+		return &ts
+	}
+
+	alerts := []Alert{
+		// Same check firing and resolving every 15 minutes
+		{ID: "f1", Summary: "Disk almost full", Source: "datadog", StartedAt: at(0), Status: "resolved"},
+		{ID: "f2", Summary: "disk almost full ", Source: "Datadog", StartedAt: at(15 * time.Minute), Status: "resolved"},
+		{ID: "f3", Summary: "Disk almost full", Source: "datadog", StartedAt: at(30 * time.Minute), Status: "triggered"},
+		// Same summary from another source is a different group
+		{ID: "o1", Summary: "Disk almost full", Source: "grafana", StartedAt: at(5 * time.Minute)},
+		// Recurs, but spread out over days
+		{ID: "s1", Summary: "Nightly backup failed", Source: "cron", StartedAt: at(0)},
+		{ID: "s2", Summary: "Nightly backup failed", Source: "cron", StartedAt: at(24 * time.Hour)},
+		{ID: "s3", Summary: "Nightly backup failed", Source: "cron", StartedAt: at(48 * time.Hour)},
+	}
+
+	got := DetectFlapping(alerts)
+
+	for _, id := range []string{"f1", "f2", "f3"} {
+		if got[id] != 3 {
+			t.Errorf("expected %s to be flapping with 3 occurrences, got %d", id, got[id])
+		}
+	}
+	for _, id := range []string{"o1", "s1", "s2", "s3"} {
+		if _, ok := got[id]; ok {
+			t.Errorf("expected %s to be stable", id)
+		}
+	}
+}
+
+func TestAlertFiredAtFallsBackToCreatedAt(t *testing.T) {
+	created := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	a := Alert{CreatedAt: created}
+	if !a.FiredAt().Equal(created) {
+		t.Errorf("expected CreatedAt fallback, got %v", a.FiredAt())
+	}
+}
@@ -0,0 +1,194 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// htmlMarkdown converts summaries to HTML; raw HTML in the source is escaped
+var htmlMarkdown = goldmark.New(goldmark.WithExtensions(extension.GFM))
+
+// htmlField is a labeled value in the snapshot's field table
+type htmlField struct {
+	Label string
+	Value string
+}
+
+// htmlLink is a labeled link in the snapshot's links section
+type htmlLink struct {
+	Label string
+	URL   template.URL
+}
+
+// htmlList is a titled bullet list in the snapshot
+type htmlList struct {
+	Label string
+	Items []string
+}
+
+// htmlTemplate lays out a self-contained incident snapshot with inline styles
+var htmlTemplate = template.Must(template.New("incident").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Heading}}</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; max-width: 860px; margin: 2rem auto; padding: 0 1rem; color: #111827; line-height: 1.5; }
+h1 { color: #6D28D9; margin-bottom: 0.25rem; }
+h2 { border-bottom: 1px solid #D1D5DB; padding-bottom: 0.25rem; margin-top: 2rem; }
+table.fields td { padding: 0.2rem 1rem 0.2rem 0; vertical-align: top; }
+table.fields td:first-child { color: #6B7280; white-space: nowrap; }
+.meta { color: #6B7280; font-size: 0.9rem; }
+pre, code { background: #F3F4F6; border-radius: 4px; }
+pre { padding: 0.75rem; overflow-x: auto; }
+</style>
+</head>
+<body>
+<h1>{{.Heading}}</h1>
+<p class="meta">Snapshot taken {{.Generated}}</p>
+{{- if .Fields}}
+<table class="fields">
+{{- range .Fields}}
+<tr><td>{{.Label}}</td><td>{{.Value}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .Summary}}
+<h2>Summary</h2>
+{{.Summary}}
+{{- end}}
+{{- range .Lists}}
+<h2>{{.Label}}</h2>
+<ul>
+{{- range .Items}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- if .Links}}
+<h2>Links</h2>
+<ul>
+{{- range .Links}}
+<li><a href="{{.URL}}">{{.Label}}</a></li>
+{{- end}}
+</ul>
+{{- end}}
+</body>
+</html>
+`))
+
+// ToHTML renders the incident as a self-contained HTML page, converting the
+// Markdown summary to HTML. generated is shown as the snapshot time.
+func (i *Incident) ToHTML(generated time.Time) (string, error) {
+	id := i.SequentialID
+	if id == "" {
+		id = i.ID
+	}
+
+	var fields []htmlField
+	addField := func(label, value string) {
+		if value != "" {
+			fields = append(fields, htmlField{label, value})
+		}
+	}
+	addTime := func(label string, t *time.Time) {
+		if t != nil && !t.IsZero() {
+			addField(label, t.Format(time.RFC1123))
+		}
+	}
+	addField("Status", i.Status)
+	addField("Severity", i.Severity)
+	addField("Kind", i.Kind)
+	if !i.CreatedAt.IsZero() {
+		addField("Created", i.CreatedAt.Format(time.RFC1123))
+	}
+	addTime("Started", i.StartedAt)
+	addTime("Mitigated", i.MitigatedAt)
+	addTime("Resolved", i.ResolvedAt)
+	addField("Created by", i.CreatedByName)
+	for _, role := range i.Roles {
+		addField(role.Name, role.UserName)
+	}
+
+	var summary template.HTML
+	if text := strings.TrimSpace(i.Summary); text != "" {
+		var buf bytes.Buffer
+		if err := htmlMarkdown.Convert([]byte(text), &buf); err != nil {
+			return "", fmt.Errorf("failed to convert summary: %w", err)
+		}
+		summary = template.HTML(buf.String()) //nolint:gosec // goldmark escapes raw HTML by default
+	}
+
+	var lists []htmlList
+	for _, l := range []htmlList{
+		{"Services", i.Services},
+		{"Environments", i.Environments},
+		{"Teams", i.Teams},
+		{"Causes", i.Causes},
+	} {
+		if len(l.Items) > 0 {
+			lists = append(lists, l)
+		}
+	}
+	if len(i.ActionItems) > 0 {
+		items := make([]string, 0, len(i.ActionItems))
+		for _, task := range i.ActionItems {
+			item := task.Title
+			if task.IsDone() {
+				item = "✓ " + item
+			}
+			if task.AssigneeName != "" {
+				item += " (" + task.AssigneeName + ")"
+			}
+			items = append(items, item)
+		}
+		lists = append(lists, htmlList{"Action Items", items})
+	}
+
+	var links []htmlLink
+	addLink := func(label, url string) {
+		// Only web links; anything else could smuggle a javascript: URL into the page
+		if strings.HasPrefix(url, "https://") || strings.HasPrefix(url, "http://") {
+			links = append(links, htmlLink{label, template.URL(url)}) //nolint:gosec // scheme checked above
+		}
+	}
+	if i.URL != "" {
+		addLink("Rootly", i.URL)
+	} else {
+		addLink("Rootly", i.ShortURL)
+	}
+	addLink("Slack channel", i.SlackChannelURL)
+	addLink("Jira", i.JiraIssueURL)
+	addLink("Linear", i.LinearIssueURL)
+	addLink("GitHub", i.GithubIssueURL)
+	addLink("GitLab", i.GitlabIssueURL)
+	addLink("PagerDuty", i.PagerdutyIncidentURL)
+	addLink("Opsgenie", i.OpsgenieIncidentURL)
+
+	var out bytes.Buffer
+	err := htmlTemplate.Execute(&out, struct {
+		Heading   string
+		Generated string
+		Fields    []htmlField
+		Summary   template.HTML
+		Lists     []htmlList
+		Links     []htmlLink
+	}{
+		Heading:   "[" + id + "] " + i.Title,
+		Generated: generated.Format(time.RFC1123),
+		Fields:    fields,
+		Summary:   summary,
+		Lists:     lists,
+		Links:     links,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to render HTML: %w", err)
+	}
+	return out.String(), nil
+}
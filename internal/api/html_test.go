@@ -0,0 +1,42 @@
+package api
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIncidentToHTML(t *testing.T) {
+	inc := Incident{
+		SequentialID: "INC-42",
+		Title:        "Checkout <errors>",
+		Status:       "started",
+		Summary:      "Payments are **failing**.\n\n<script>alert(1)</script>",
+		Services:     []string{"payments", "web"},
+		URL:          "https://rootly.com/account/incidents/42",
+		JiraIssueURL: "javascript:alert(1)",
+	}
+
+	got, err := inc.ToHTML(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(got, "<h1>[INC-42] Checkout &lt;errors&gt;</h1>") {
+		t.Errorf("expected escaped title heading, got %q", got)
+	}
+	for _, item := range []string{"<li>payments</li>", "<li>web</li>"} {
+		if !strings.Contains(got, item) {
+			t.Errorf("expected services list item %q", item)
+		}
+	}
+	if !strings.Contains(got, "<strong>failing</strong>") {
+		t.Error("expected Markdown summary to be converted to HTML")
+	}
+	if strings.Contains(got, "<script>") || strings.Contains(got, "javascript:") {
+		t.Error("expected raw HTML and non-web links to be dropped")
+	}
+	if !strings.Contains(got, `<a href="https://rootly.com/account/incidents/42">Rootly</a>`) {
+		t.Error("expected Rootly link")
+	}
+}
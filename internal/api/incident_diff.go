@@ -0,0 +1,116 @@
+package api
+
+import (
+	"strings"
+	"time"
+)
+
+// IncidentChangeKind identifies what changed between two incident snapshots
+type IncidentChangeKind string
+
+const (
+	ChangeStatus          IncidentChangeKind = "status"            // From/To hold the statuses
+	ChangeSeverity        IncidentChangeKind = "severity"          // From/To hold the severities
+	ChangeTitle           IncidentChangeKind = "title"             // From/To hold the titles
+	ChangeRoleAssigned    IncidentChangeKind = "role_assigned"     // Name is the role, To the new holder, From the previous one
+	ChangeRoleUnassigned  IncidentChangeKind = "role_unassigned"   // Name is the role, From the previous holder
+	ChangeMilestone       IncidentChangeKind = "milestone"         // Name is the milestone (acknowledged, mitigated, ...), At when it happened
+	ChangeActionItemAdded IncidentChangeKind = "action_item_added" // Name is the action item title
+	ChangeActionItemDone  IncidentChangeKind = "action_item_done"  // Name is the action item title
+)
+
+// IncidentChange is one difference between two snapshots of the same incident
+type IncidentChange struct {
+	Kind IncidentChangeKind
+	Name string
+	From string
+	To   string
+	At   time.Time
+}
+
+// DiffIncidents lists the changes from prev to next: status, severity and title,
+// role assignments, newly reached milestones and action items added or completed.
+// Changes are returned in that order; nil snapshots yield no changes.
+func DiffIncidents(prev, next *Incident) []IncidentChange {
+	if prev == nil || next == nil {
+		return nil
+	}
+
+	var changes []IncidentChange
+	for _, f := range []struct {
+		kind     IncidentChangeKind
+		from, to string
+	}{
+		{ChangeStatus, prev.Status, next.Status},
+		{ChangeSeverity, prev.Severity, next.Severity},
+		{ChangeTitle, prev.Title, next.Title},
+	} {
+		if f.from != f.to {
+			changes = append(changes, IncidentChange{Kind: f.kind, From: f.from, To: f.to})
+		}
+	}
+
+	changes = append(changes, diffRoles(prev.Roles, next.Roles)...)
+
+	for _, ms := range []struct {
+		name     string
+		from, to *time.Time
+	}{
+		{"acknowledged", prev.AcknowledgedAt, next.AcknowledgedAt},
+		{"mitigated", prev.MitigatedAt, next.MitigatedAt},
+		{"resolved", prev.ResolvedAt, next.ResolvedAt},
+		{"closed", prev.ClosedAt, next.ClosedAt},
+		{"cancelled", prev.CancelledAt, next.CancelledAt},
+	} {
+		if ms.to != nil && !ms.to.IsZero() && (ms.from == nil || !ms.from.Equal(*ms.to)) {
+			changes = append(changes, IncidentChange{Kind: ChangeMilestone, Name: ms.name, At: *ms.to})
+		}
+	}
+
+	prevTasks := make(map[string]bool, len(prev.ActionItems))
+	for _, task := range prev.ActionItems {
+		prevTasks[task.Title] = task.IsDone()
+	}
+	for _, task := range next.ActionItems {
+		wasDone, existed := prevTasks[task.Title]
+		switch {
+		case !existed:
+			changes = append(changes, IncidentChange{Kind: ChangeActionItemAdded, Name: task.Title})
+		case task.IsDone() && !wasDone:
+			changes = append(changes, IncidentChange{Kind: ChangeActionItemDone, Name: task.Title})
+		}
+	}
+
+	return changes
+}
+
+// diffRoles compares role holders by role name, in the order roles appear in next
+// followed by roles that were dropped
+func diffRoles(prev, next []IncidentRole) []IncidentChange {
+	holders := func(roles []IncidentRole) map[string]string {
+		m := make(map[string]string, len(roles))
+		for _, r := range roles {
+			m[r.Name] = strings.TrimSpace(r.UserName)
+		}
+		return m
+	}
+	before, after := holders(prev), holders(next)
+
+	var changes []IncidentChange
+	for _, r := range next {
+		was, now := before[r.Name], after[r.Name]
+		switch {
+		case now == was:
+		case now == "":
+			changes = append(changes, IncidentChange{Kind: ChangeRoleUnassigned, Name: r.Name, From: was})
+		default:
+			changes = append(changes, IncidentChange{Kind: ChangeRoleAssigned, Name: r.Name, From: was, To: now})
+		}
+	}
+	for _, r := range prev {
+		if _, ok := after[r.Name]; !ok && before[r.Name] != "" {
+			changes = append(changes, IncidentChange{Kind: ChangeRoleUnassigned, Name: r.Name, From: before[r.Name]})
+		}
+	}
+	return changes
+}
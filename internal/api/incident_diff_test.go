@@ -0,0 +1,74 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiffIncidentsStatusAndRoles(t *testing.T) {
+	mitigated := time.Date(2026, 3, 1, 10, 42, 0, 0, time.UTC)
+	prev := &Incident{
+		Status:   "started",
+		Severity: "sev1",
+		Roles: []IncidentRole{
+			{Name: "Commander", UserName: ""},
+			{Name: "Scribe", UserName: "Sam"},
+			{Name: "Communications Lead", UserName: "Ana"},
+		},
+	}
+	next := &Incident{
+		Status:      "mitigated",
+		Severity:    "sev1",
+		MitigatedAt: &mitigated,
+		Roles: []IncidentRole{
+			{Name: "Commander", UserName: "Jane"},
+			{Name: "Scribe", UserName: "Lee"},
+		},
+	}
+
+	got := DiffIncidents(prev, next)
+	want := []IncidentChange{
+		{Kind: ChangeStatus, From: "started", To: "mitigated"},
+		{Kind: ChangeRoleAssigned, Name: "Commander", To: "Jane"},
+		{Kind: ChangeRoleAssigned, Name: "Scribe", From: "Sam", To: "Lee"},
+		{Kind: ChangeRoleUnassigned, Name: "Communications Lead", From: "Ana"},
+		{Kind: ChangeMilestone, Name: "mitigated", At: mitigated},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d changes, got %d: %+v", len(want), len(got), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("change %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestDiffIncidentsActionItems(t *testing.T) {
+	prev := &Incident{ActionItems: []IncidentTask{
+		{Title: "Roll back", Status: TaskStatusOpen},
+		{Title: "Page DBA", Status: TaskStatusDone},
+	}}
+	next := &Incident{ActionItems: []IncidentTask{
+		{Title: "Roll back", Status: TaskStatusDone},
+		{Title: "Page DBA", Status: TaskStatusDone},
+		{Title: "Write postmortem", Status: TaskStatusOpen},
+	}}
+
+	got := DiffIncidents(prev, next)
+	if len(got) != 2 ||
+		got[0] != (IncidentChange{Kind: ChangeActionItemDone, Name: "Roll back"}) ||
+		got[1] != (IncidentChange{Kind: ChangeActionItemAdded, Name: "Write postmortem"}) {
+		t.Errorf("unexpected action item changes: %+v", got)
+	}
+}
+
+func TestDiffIncidentsUnchanged(t *testing.T) {
+	inc := &Incident{Status: "started", Roles: []IncidentRole{{Name: "Commander", UserName: "Jane"}}}
+	if got := DiffIncidents(inc, inc); len(got) != 0 {
+		t.Errorf("expected no changes, got %+v", got)
+	}
+	if got := DiffIncidents(nil, inc); got != nil {
+		t.Errorf("expected no changes without a previous snapshot, got %+v", got)
+	}
+}
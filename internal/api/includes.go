@@ -0,0 +1,55 @@
+package api
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+)
+
+// DefaultIncidentIncludes are the related resources fetched with an incident's detail
+var DefaultIncidentIncludes = []string{
+	"roles", "causes", "incident_types", "functionalities", "services", "environments", "groups", "user",
+}
+
+// DefaultAlertIncludes are the related resources fetched with an alert's detail
+var DefaultAlertIncludes = []string{
+	"services", "environments", "groups", "responders", "alert_urgency", "escalation_policy",
+}
+
+// parseIncludes parses a comma-separated include list from config. An empty
+// list uses defaults; names not in defaults are kept but logged, since they're
+// likely typos (or includes this version doesn't display).
+func parseIncludes(value string, defaults []string, kind string) []string {
+	var includes []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || slices.Contains(includes, name) {
+			continue
+		}
+		if !slices.Contains(defaults, name) {
+			debug.Logger.Warn("Unknown detail include", "kind", kind, "include", name, "known", strings.Join(defaults, ","))
+		}
+		includes = append(includes, name)
+	}
+	if len(includes) == 0 {
+		return defaults
+	}
+	return includes
+}
+
+// incidentIncludeParam returns the include query value for incident details
+func (c *Client) incidentIncludeParam() string {
+	if len(c.incidentIncludes) == 0 {
+		return strings.Join(DefaultIncidentIncludes, ",")
+	}
+	return strings.Join(c.incidentIncludes, ",")
+}
+
+// alertIncludeParam returns the include query value for alert details
+func (c *Client) alertIncludeParam() string {
+	if len(c.alertIncludes) == 0 {
+		return strings.Join(DefaultAlertIncludes, ",")
+	}
+	return strings.Join(c.alertIncludes, ",")
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"strings"
+	"time"
+)
+
+// markdownCellEscaper keeps cell text from breaking the table: pipes are
+// escaped and line breaks collapsed to spaces
+var markdownCellEscaper = strings.NewReplacer("|", `\|`, "\r\n", " ", "\n", " ", "\r", " ")
+
+// IncidentsToMarkdownTable renders incidents as a Markdown table, one row per incident
+func IncidentsToMarkdownTable(incs []Incident) string {
+	var b strings.Builder
+	b.WriteString("| INC | Title | Status | Severity |\n")
+	b.WriteString("| --- | --- | --- | --- |\n")
+	for _, inc := range incs {
+		id := inc.SequentialID
+		if id == "" {
+			id = inc.ID
+		}
+		cells := []string{id, inc.Title, inc.Status, inc.Severity}
+		for i, cell := range cells {
+			cells[i] = markdownCellEscaper.Replace(strings.TrimSpace(cell))
+		}
+		b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+	return b.String()
+}
+
+// markdownTextEscaper escapes characters that would start emphasis, links or
+// code in inline Markdown text
+var markdownTextEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "<", `\<`, "`", "\\`",
+)
+
+// ToMarkdown formats the incident as a Markdown document for pasting into
+// Notion, Jira and the like: title, status line, summary, timeline table,
+// services, teams, roles (with mailto links) and links
+func (i *Incident) ToMarkdown() string {
+	var b strings.Builder
+
+	id := i.SequentialID
+	if id == "" {
+		id = i.ID
+	}
+	title := strings.Join(strings.Fields(i.Title), " ")
+	b.WriteString("# [" + id + "] " + markdownTextEscaper.Replace(title) + "\n\n")
+
+	var facts []string
+	if i.Status != "" {
+		facts = append(facts, "**Status:** "+markdownTextEscaper.Replace(i.Status))
+	}
+	if i.Severity != "" {
+		facts = append(facts, "**Severity:** "+markdownTextEscaper.Replace(i.Severity))
+	}
+	if len(facts) > 0 {
+		b.WriteString(strings.Join(facts, " · ") + "\n\n")
+	}
+
+	// The summary is already Markdown
+	if summary := strings.TrimSpace(strings.ReplaceAll(i.Summary, "\r", "")); summary != "" && summary != strings.TrimSpace(i.Title) {
+		b.WriteString(summary + "\n\n")
+	}
+
+	type event struct {
+		label string
+		at    *time.Time
+	}
+	created := i.CreatedAt
+	var rows []string
+	for _, e := range []event{
+		{"Created", &created},
+		{"Started", i.StartedAt},
+		{"Detected", i.DetectedAt},
+		{"Acknowledged", i.AcknowledgedAt},
+		{"Mitigated", i.MitigatedAt},
+		{"Resolved", i.ResolvedAt},
+		{"Closed", i.ClosedAt},
+	} {
+		if e.at != nil && !e.at.IsZero() {
+			rows = append(rows, "| "+e.label+" | "+e.at.Format(time.RFC1123)+" |")
+		}
+	}
+	if len(rows) > 0 {
+		b.WriteString("## Timeline\n\n| Event | Time |\n| --- | --- |\n")
+		b.WriteString(strings.Join(rows, "\n") + "\n\n")
+	}
+
+	writeList := func(heading string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		b.WriteString("## " + heading + "\n\n")
+		for _, item := range items {
+			b.WriteString("- " + markdownTextEscaper.Replace(item) + "\n")
+		}
+		b.WriteString("\n")
+	}
+	writeList("Services", i.Services)
+	writeList("Environments", i.Environments)
+	writeList("Teams", i.Teams)
+
+	var roles []string
+	for _, role := range i.Roles {
+		name := strings.TrimSpace(role.UserName)
+		if name == "" {
+			continue
+		}
+		person := markdownTextEscaper.Replace(name)
+		if email := strings.TrimSpace(role.UserEmail); email != "" {
+			person = "[" + person + "](mailto:" + markdownURLEscaper.Replace(email) + ")"
+		}
+		roles = append(roles, "- **"+markdownTextEscaper.Replace(strings.TrimSpace(role.Name))+":** "+person)
+	}
+	if len(roles) > 0 {
+		b.WriteString("## Roles\n\n" + strings.Join(roles, "\n") + "\n\n")
+	}
+
+	var links []string
+	addLink := func(label, url string) {
+		if url != "" {
+			links = append(links, "- ["+label+"]("+markdownURLEscaper.Replace(url)+")")
+		}
+	}
+	if i.URL != "" {
+		addLink("Rootly", i.URL)
+	} else {
+		addLink("Rootly", i.ShortURL)
+	}
+	addLink("Slack channel", i.SlackChannelURL)
+	addLink("Jira", i.JiraIssueURL)
+	if len(links) > 0 {
+		b.WriteString("## Links\n\n" + strings.Join(links, "\n") + "\n")
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// markdownURLEscaper keeps a URL from ending a Markdown link early
+var markdownURLEscaper = strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29")
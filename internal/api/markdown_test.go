@@ -0,0 +1,84 @@
+package api
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIncidentsToMarkdownTable(t *testing.T) {
+	got := IncidentsToMarkdownTable([]Incident{
+		{SequentialID: "INC-1", Title: "API | Web down", Status: "started", Severity: "SEV1"},
+		{ID: "abc", Title: "Slow\nqueries", Status: "resolved"},
+	})
+	lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+
+	if len(lines) != 4 {
+		t.Fatalf("expected header, separator and 2 rows, got %d lines: %q", len(lines), got)
+	}
+	if lines[0] != "| INC | Title | Status | Severity |" {
+		t.Errorf("unexpected header %q", lines[0])
+	}
+	if lines[1] != "| --- | --- | --- | --- |" {
+		t.Errorf("unexpected separator %q", lines[1])
+	}
+	if lines[2] != `| INC-1 | API \| Web down | started | SEV1 |` {
+		t.Errorf("expected escaped pipe in row, got %q", lines[2])
+	}
+	if lines[3] != "| abc | Slow queries | resolved |  |" {
+		t.Errorf("expected ID fallback and collapsed newline, got %q", lines[3])
+	}
+}
+
+func TestIncidentToMarkdown(t *testing.T) {
+	started := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	resolved := started.Add(90 * time.Minute)
+	inc := &Incident{
+		ID:           "inc_1",
+		SequentialID: "INC-42",
+		Title:        "Checkout *errors*",
+		Summary:      "Payments failing for **EU** customers",
+		Status:       "resolved",
+		Severity:     "SEV1",
+		CreatedAt:    started,
+		StartedAt:    &started,
+		ResolvedAt:   &resolved,
+		Services:     []string{"checkout", "payments_api"},
+		Teams:        []string{"Payments"},
+		Roles: []IncidentRole{
+			{Name: "Commander", UserName: "Jane Doe", UserEmail: "jane@example.com"},
+			{Name: "Scribe", UserName: "Sam"},
+			{Name: "Communications Lead"},
+		},
+		URL: "https://rootly.com/account/incidents/inc_1",
+	}
+
+	got := inc.ToMarkdown()
+	for _, want := range []string{
+		`# [INC-42] Checkout \*errors\*`,
+		"**Status:** resolved · **Severity:** SEV1",
+		"Payments failing for **EU** customers",
+		"## Timeline\n\n| Event | Time |\n| --- | --- |\n| Created | Wed, 15 Jan 2025 10:00:00 UTC |",
+		"| Resolved | Wed, 15 Jan 2025 11:30:00 UTC |",
+		"## Services\n\n- checkout\n- payments\\_api",
+		"## Teams\n\n- Payments",
+		"## Roles\n\n- **Commander:** [Jane Doe](mailto:jane@example.com)\n- **Scribe:** Sam",
+		"## Links\n\n- [Rootly](https://rootly.com/account/incidents/inc_1)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "Communications Lead") {
+		t.Error("expected roles without a person to be skipped")
+	}
+	if strings.Contains(got, "\x1b") || strings.HasSuffix(got, "\n") {
+		t.Errorf("expected clean Markdown without escape codes or a trailing newline, got %q", got)
+	}
+
+	// Sections without data are left out
+	bare := (&Incident{ID: "inc_2", Title: "Bare"}).ToMarkdown()
+	if bare != "# [inc_2] Bare" {
+		t.Errorf("expected only the heading, got %q", bare)
+	}
+}
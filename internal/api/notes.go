@@ -0,0 +1,90 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+)
+
+// AddIncidentNote posts text to an incident's timeline as an internal event and
+// invalidates its cached detail so the next load shows it
+func (c *Client) AddIncidentNote(ctx context.Context, id, text string) error {
+	baseURL := c.endpoint
+	if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
+		baseURL = "https://" + baseURL
+	}
+	url := fmt.Sprintf("%s/v1/incidents/%s/events", baseURL, id)
+
+	payload := map[string]any{
+		"data": map[string]any{
+			"type": "incident_events",
+			"attributes": map[string]any{
+				"event":      text,
+				"visibility": "internal",
+			},
+		},
+	}
+	reqBody, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	if c.skipWrite("POST", url, reqBody) {
+		return nil
+	}
+
+	release, err := c.acquireWrite(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	debug.Logger.Debug("Adding incident note", "id", id, "length", len(text))
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	c.setAuthHeaders(req)
+
+	httpResp, err := c.doRequest(req)
+	if err != nil {
+		debug.Logger.Error("Failed to add incident note", "id", id, "error", err)
+		return fmt.Errorf("failed to add note: %w", err)
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	debug.Logger.Debug("Add incident note response",
+		"status", httpResp.StatusCode,
+		"bodyLength", len(body),
+	)
+
+	if httpResp.StatusCode == 403 {
+		debug.Logger.Error("API forbidden", "status", httpResp.StatusCode)
+		if detail := apiErrorDetail(body); detail != "" {
+			return fmt.Errorf("access denied: %s", detail)
+		}
+		return fmt.Errorf("access denied: API key lacks 'update incidents' permission")
+	}
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 {
+		debug.Logger.Error("API error", "status", httpResp.StatusCode, "body", debug.PrettyJSON(body))
+		if detail := apiErrorDetail(body); detail != "" {
+			return fmt.Errorf("API returned status %d: %s", httpResp.StatusCode, detail)
+		}
+		return fmt.Errorf("API returned status %d", httpResp.StatusCode)
+	}
+
+	c.invalidateIncident(id)
+	return nil
+}
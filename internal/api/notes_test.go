@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+func TestAddIncidentNote(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	detailCalls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/incidents/inc_001/events", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		var body struct {
+			Data struct {
+				Type       string `json:"type"`
+				Attributes struct {
+					Event      string `json:"event"`
+					Visibility string `json:"visibility"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if body.Data.Type != "incident_events" || body.Data.Attributes.Event != "Rolled back\nto v41" || body.Data.Attributes.Visibility != "internal" {
+			t.Errorf("unexpected request body %+v", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"evt_1","type":"incident_events"}}`))
+	})
+	mux.HandleFunc("/v1/incidents/inc_001", func(w http.ResponseWriter, r *http.Request) {
+		detailCalls++
+		_, _ = w.Write([]byte(`{"data":{"id":"inc_001","attributes":{"sequential_id":1,"status":"started","created_at":"2025-01-01T10:00:00Z"}}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	// Cache the detail, then check the note invalidates it
+	updatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	for range 2 {
+		if _, err := client.GetIncident(context.Background(), "inc_001", updatedAt); err != nil {
+			t.Fatalf("GetIncident() error = %v", err)
+		}
+	}
+	if detailCalls != 1 {
+		t.Fatalf("expected the detail to be cached, got %d requests", detailCalls)
+	}
+
+	if err := client.AddIncidentNote(context.Background(), "inc_001", "Rolled back\nto v41"); err != nil {
+		t.Fatalf("AddIncidentNote() error = %v", err)
+	}
+	if _, err := client.GetIncident(context.Background(), "inc_001", updatedAt); err != nil {
+		t.Fatalf("GetIncident() error = %v", err)
+	}
+	if detailCalls != 2 {
+		t.Errorf("expected the note to invalidate the cached detail, got %d requests", detailCalls)
+	}
+}
+
+func TestAddIncidentNoteForbidden(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":[{"title":"Forbidden","detail":"Read-only API key"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	err = client.AddIncidentNote(context.Background(), "inc_001", "note")
+	if err == nil || !strings.Contains(err.Error(), "Read-only API key") {
+		t.Errorf("expected the API error detail for a 403, got %v", err)
+	}
+}
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+)
+
+// OnCallScopes holds the services and teams covered by the current user's on-call shifts
+type OnCallScopes struct {
+	Services []string
+	Teams    []string
+}
+
+// IsEmpty returns true if the user is not on call for any service or team
+func (s *OnCallScopes) IsEmpty() bool {
+	return s == nil || (len(s.Services) == 0 && len(s.Teams) == 0)
+}
+
+// Covers returns true if any of the incident's services or teams is in scope
+func (s *OnCallScopes) Covers(inc *Incident) bool {
+	if s == nil || inc == nil {
+		return false
+	}
+	return intersectsFold(inc.Services, s.Services) || intersectsFold(inc.Teams, s.Teams)
+}
+
+// intersectsFold reports whether a and b share a value (case-insensitive)
+func intersectsFold(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if strings.EqualFold(x, y) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetOnCallScopes resolves the services and teams the current user is on call for.
+// It looks up the user, their current on-call shifts, and the escalation policies
+// those shifts belong to, then maps the policies' service and team IDs to names.
+func (c *Client) GetOnCallScopes(ctx context.Context) (*OnCallScopes, error) {
+	me, err := c.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var oncalls struct {
+		Data []struct {
+			Attributes struct {
+				EscalationPolicyID string `json:"escalation_policy_id"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	path := "/v1/oncalls?filter[user_ids]=" + url.QueryEscape(me.ID)
+	if err := c.getJSON(ctx, path, "read on-call", &oncalls); err != nil {
+		return nil, err
+	}
+
+	// Collect service and team IDs from each distinct escalation policy
+	serviceIDs := make(map[string]bool)
+	teamIDs := make(map[string]bool)
+	seenPolicies := make(map[string]bool)
+	for _, oc := range oncalls.Data {
+		policyID := oc.Attributes.EscalationPolicyID
+		if policyID == "" || seenPolicies[policyID] {
+			continue
+		}
+		seenPolicies[policyID] = true
+
+		var policy struct {
+			Data struct {
+				Attributes struct {
+					ServiceIDs []string `json:"service_ids"`
+					GroupIDs   []string `json:"group_ids"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := c.getJSON(ctx, "/v1/escalation_policies/"+url.PathEscape(policyID), "read escalation policies", &policy); err != nil {
+			return nil, err
+		}
+		for _, id := range policy.Data.Attributes.ServiceIDs {
+			serviceIDs[id] = true
+		}
+		for _, id := range policy.Data.Attributes.GroupIDs {
+			teamIDs[id] = true
+		}
+	}
+
+	scopes := &OnCallScopes{}
+
+	if len(serviceIDs) > 0 {
+		var services struct {
+			Data []struct {
+				ID         string `json:"id"`
+				Attributes struct {
+					Name string `json:"name"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := c.getJSON(ctx, "/v1/services?page[size]=100", "read services", &services); err != nil {
+			return nil, err
+		}
+		for _, s := range services.Data {
+			if serviceIDs[s.ID] && s.Attributes.Name != "" {
+				scopes.Services = append(scopes.Services, s.Attributes.Name)
+			}
+		}
+	}
+
+	if len(teamIDs) > 0 {
+		teams, err := c.ListTeams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range teams {
+			if teamIDs[t.ID] {
+				scopes.Teams = append(scopes.Teams, t.Name)
+			}
+		}
+	}
+
+	sort.Strings(scopes.Services)
+	sort.Strings(scopes.Teams)
+
+	debug.Logger.Debug("Resolved on-call scopes",
+		"policies", len(seenPolicies),
+		"services", scopes.Services,
+		"teams", scopes.Teams,
+	)
+	return scopes, nil
+}
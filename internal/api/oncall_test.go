@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+func TestOnCallScopesCovers(t *testing.T) {
+	scopes := &OnCallScopes{
+		Services: []string{"api-gateway", "payments"},
+		Teams:    []string{"Platform"},
+	}
+
+	tests := []struct {
+		name     string
+		incident Incident
+		expected bool
+	}{
+		{"matching service", Incident{Services: []string{"web", "payments"}}, true},
+		{"matching team", Incident{Teams: []string{"platform"}}, true},
+		{"service match is case-insensitive", Incident{Services: []string{"API-Gateway"}}, true},
+		{"no overlap", Incident{Services: []string{"web"}, Teams: []string{"Mobile"}}, false},
+		{"no services or teams", Incident{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scopes.Covers(&tt.incident); got != tt.expected {
+				t.Errorf("Covers() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+
+	var empty *OnCallScopes
+	if empty.Covers(&Incident{Services: []string{"payments"}}) {
+		t.Error("expected nil scopes to cover nothing")
+	}
+	if !empty.IsEmpty() || scopes.IsEmpty() {
+		t.Error("unexpected IsEmpty result")
+	}
+}
+
+func TestGetOnCallScopes(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/users/me", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"id":"42","type":"users"}}`))
+	})
+	mux.HandleFunc("/v1/oncalls", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("filter[user_ids]"); got != "42" {
+			t.Errorf("expected filter[user_ids]=42, got %q", got)
+		}
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":"1","attributes":{"escalation_policy_id":"ep_1"}},
+			{"id":"2","attributes":{"escalation_policy_id":"ep_1"}}
+		]}`))
+	})
+	policyRequests := 0
+	mux.HandleFunc("/v1/escalation_policies/ep_1", func(w http.ResponseWriter, r *http.Request) {
+		policyRequests++
+		_, _ = w.Write([]byte(`{"data":{"id":"ep_1","attributes":{"name":"Primary","service_ids":["svc_1"],"group_ids":["team_1"]}}}`))
+	})
+	mux.HandleFunc("/v1/services", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":"svc_1","attributes":{"name":"payments"}},
+			{"id":"svc_2","attributes":{"name":"web"}}
+		]}`))
+	})
+	mux.HandleFunc("/v1/teams", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":"team_1","type":"groups","attributes":{"name":"Platform"}},
+			{"id":"team_2","type":"groups","attributes":{"name":"Mobile"}}
+		],"links":{},"meta":{}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	scopes, err := client.GetOnCallScopes(context.Background())
+	if err != nil {
+		t.Fatalf("GetOnCallScopes() error = %v", err)
+	}
+	if len(scopes.Services) != 1 || scopes.Services[0] != "payments" {
+		t.Errorf("expected Services=[payments], got %v", scopes.Services)
+	}
+	if len(scopes.Teams) != 1 || scopes.Teams[0] != "Platform" {
+		t.Errorf("expected Teams=[Platform], got %v", scopes.Teams)
+	}
+	if policyRequests != 1 {
+		t.Errorf("expected escalation policy to be fetched once, got %d", policyRequests)
+	}
+}
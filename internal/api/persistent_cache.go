@@ -1,16 +1,20 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"sync"
 	"time"
 
 	bolt "go.etcd.io/bbolt"
 	berrors "go.etcd.io/bbolt/errors"
 
+	"github.com/rootlyhq/rootly-tui/internal/config"
 	"github.com/rootlyhq/rootly-tui/internal/debug"
 )
 
@@ -19,19 +23,55 @@ var (
 	errCacheNotFound = errors.New("cache key not found")
 )
 
-// PersistentCache provides a TTL-based cache backed by BoltDB
+// profileBucket returns the bucket a config profile's entries are kept in, so
+// accounts never see each other's cached data; the default profile keeps the
+// original bucket
+func profileBucket(profile string) []byte {
+	if profile == "" || profile == config.DefaultProfile {
+		return cacheBucket
+	}
+	return []byte("cache:" + profile)
+}
+
+// DefaultCacheMaxBytes caps the total size of cached entries
+const DefaultCacheMaxBytes int64 = 50 << 20
+
+// PersistentCache provides a TTL-based cache backed by BoltDB.
+// Entries are evicted least-recently-used first once the total size exceeds maxBytes.
 type PersistentCache struct {
-	db  *bolt.DB
-	ttl time.Duration
+	db     *bolt.DB
+	ttl    time.Duration
+	bucket []byte // Bucket holding this profile's entries
+
+	mu       sync.Mutex
+	size     int64                // Total bytes of keys and values stored
+	maxBytes int64                // Size cap (0 disables eviction)
+	accessed map[string]time.Time // Cache hits this session, for LRU ordering
 }
 
 type persistentCacheItem struct {
-	Value     json.RawMessage `json:"value"`
-	ExpiresAt time.Time       `json:"expires_at"`
+	Value      json.RawMessage `json:"value"`
+	StoredAt   time.Time       `json:"stored_at,omitempty"`
+	ExpiresAt  time.Time       `json:"expires_at"`
+	AccessedAt time.Time       `json:"accessed_at,omitempty"`
+}
+
+// CacheStats describes the current cache footprint
+type CacheStats struct {
+	Entries  int
+	Bytes    int64
+	MaxBytes int64
 }
 
 // NewPersistentCache creates a new persistent cache at ~/.rootly-tui/cache.db
 func NewPersistentCache(ttl time.Duration) (*PersistentCache, error) {
+	return NewProfileCache(ttl, config.DefaultProfile)
+}
+
+// NewProfileCache creates a persistent cache for a config profile, sharing
+// ~/.rootly-tui/cache.db with the other profiles but not their entries
+func NewProfileCache(ttl time.Duration, profile string) (*PersistentCache, error) {
+	bucket := profileBucket(profile)
 	// Get cache directory
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -84,7 +124,7 @@ func NewPersistentCache(ttl time.Duration) (*PersistentCache, error) {
 
 	// Create bucket if it doesn't exist
 	err = db.Update(func(tx *bolt.Tx) error {
-		_, err := tx.CreateBucketIfNotExists(cacheBucket)
+		_, err := tx.CreateBucketIfNotExists(bucket)
 		return err
 	})
 	if err != nil {
@@ -93,20 +133,63 @@ func NewPersistentCache(ttl time.Duration) (*PersistentCache, error) {
 		return nil, fmt.Errorf("create bucket: %w", err)
 	}
 
-	debug.Logger.Info("Persistent cache initialized", "path", dbPath, "ttl", ttl)
+	c := &PersistentCache{
+		db:       db,
+		ttl:      ttl,
+		bucket:   bucket,
+		maxBytes: DefaultCacheMaxBytes,
+		accessed: make(map[string]time.Time),
+	}
+	c.size = c.Stats().Bytes
+
+	debug.Logger.Info("Persistent cache initialized", "path", dbPath, "bucket", string(bucket), "ttl", ttl, "bytes", c.size)
 
-	return &PersistentCache{
-		db:  db,
-		ttl: ttl,
-	}, nil
+	return c, nil
+}
+
+// SetMaxBytes changes the size cap and evicts entries if the cache is already over it.
+// A value <= 0 disables the cap.
+func (c *PersistentCache) SetMaxBytes(maxBytes int64) {
+	if maxBytes < 0 {
+		maxBytes = 0
+	}
+	c.mu.Lock()
+	c.maxBytes = maxBytes
+	c.mu.Unlock()
+	c.evict()
+}
+
+// Stats returns the number of entries and their total size
+func (c *PersistentCache) Stats() CacheStats {
+	var stats CacheStats
+	_ = c.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(c.bucket).ForEach(func(k, v []byte) error {
+			stats.Entries++
+			stats.Bytes += int64(len(k) + len(v))
+			return nil
+		})
+	})
+	c.mu.Lock()
+	stats.MaxBytes = c.maxBytes
+	c.mu.Unlock()
+	return stats
 }
 
 // Get retrieves an item from the cache
 func (c *PersistentCache) Get(key string) (interface{}, bool) {
+	item, ok := c.getItem(key)
+	if !ok {
+		return nil, false
+	}
+	return item.Value, true
+}
+
+// getItem retrieves an unexpired entry, recording the access for LRU eviction
+func (c *PersistentCache) getItem(key string) (persistentCacheItem, bool) {
 	var item persistentCacheItem
 
 	err := c.db.View(func(tx *bolt.Tx) error {
-		b := tx.Bucket(cacheBucket)
+		b := tx.Bucket(c.bucket)
 		data := b.Get([]byte(key))
 		if data == nil {
 			return errCacheNotFound
@@ -115,7 +198,7 @@ func (c *PersistentCache) Get(key string) (interface{}, bool) {
 	})
 
 	if err != nil {
-		return nil, false
+		return item, false
 	}
 
 	// Check expiration
@@ -123,31 +206,36 @@ func (c *PersistentCache) Get(key string) (interface{}, bool) {
 		debug.Logger.Debug("Cache expired", "key", key)
 		// Clean up expired item asynchronously
 		go c.Delete(key)
-		return nil, false
+		return item, false
 	}
 
+	c.mu.Lock()
+	c.accessed[key] = time.Now()
+	c.mu.Unlock()
+
 	debug.Logger.Debug("Cache hit", "key", key)
-	return item.Value, true
+	return item, true
 }
 
-// GetTyped retrieves and unmarshals an item from the cache
-func (c *PersistentCache) GetTyped(key string, dest interface{}) bool {
-	value, ok := c.Get(key)
+// GetTyped retrieves and unmarshals an item from the cache, returning how long
+// ago it was stored
+func (c *PersistentCache) GetTyped(key string, dest interface{}) (age time.Duration, ok bool) {
+	item, ok := c.getItem(key)
 	if !ok {
-		return false
+		return 0, false
 	}
 
-	rawJSON, ok := value.(json.RawMessage)
-	if !ok {
-		return false
-	}
-
-	if err := json.Unmarshal(rawJSON, dest); err != nil {
+	if err := json.Unmarshal(item.Value, dest); err != nil {
 		debug.Logger.Debug("Cache unmarshal error", "key", key, "error", err)
-		return false
+		return 0, false
 	}
 
-	return true
+	// Entries written before stored_at was recorded date from their expiry
+	storedAt := item.StoredAt
+	if storedAt.IsZero() {
+		storedAt = item.ExpiresAt.Add(-c.ttl)
+	}
+	return max(time.Since(storedAt), 0), true
 }
 
 // Set stores an item in the cache
@@ -159,9 +247,12 @@ func (c *PersistentCache) Set(key string, value interface{}) {
 		return
 	}
 
+	now := time.Now()
 	item := persistentCacheItem{
-		Value:     valueJSON,
-		ExpiresAt: time.Now().Add(c.ttl),
+		Value:      valueJSON,
+		StoredAt:   now,
+		ExpiresAt:  now.Add(c.ttl),
+		AccessedAt: now,
 	}
 
 	data, err := json.Marshal(item)
@@ -170,8 +261,13 @@ func (c *PersistentCache) Set(key string, value interface{}) {
 		return
 	}
 
+	var delta int64
 	err = c.db.Update(func(tx *bolt.Tx) error {
-		b := tx.Bucket(cacheBucket)
+		b := tx.Bucket(c.bucket)
+		delta = int64(len(key) + len(data))
+		if old := b.Get([]byte(key)); old != nil {
+			delta -= int64(len(key) + len(old))
+		}
 		return b.Put([]byte(key), data)
 	})
 
@@ -180,27 +276,143 @@ func (c *PersistentCache) Set(key string, value interface{}) {
 		return
 	}
 
+	c.mu.Lock()
+	c.size += delta
+	c.accessed[key] = now
+	c.mu.Unlock()
+
 	debug.Logger.Debug("Cache set", "key", key, "ttl", c.ttl)
+	c.evict()
+}
+
+// evict removes least-recently-used entries until the cache fits within maxBytes
+func (c *PersistentCache) evict() {
+	c.mu.Lock()
+	maxBytes, size := c.maxBytes, c.size
+	c.mu.Unlock()
+	if maxBytes <= 0 || size <= maxBytes {
+		return
+	}
+
+	type entry struct {
+		key      string
+		size     int64
+		lastUsed time.Time
+	}
+	var entries []entry
+
+	_ = c.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(c.bucket).ForEach(func(k, v []byte) error {
+			e := entry{key: string(k), size: int64(len(k) + len(v))}
+			var item persistentCacheItem
+			if err := json.Unmarshal(v, &item); err == nil {
+				e.lastUsed = item.AccessedAt
+			}
+			entries = append(entries, e)
+			return nil
+		})
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := range entries {
+		if t, ok := c.accessed[entries[i].key]; ok && t.After(entries[i].lastUsed) {
+			entries[i].lastUsed = t
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].lastUsed.Before(entries[j].lastUsed)
+	})
+
+	// Recompute from disk in case the running total drifted
+	size = 0
+	for _, e := range entries {
+		size += e.size
+	}
+
+	removed := 0
+	_ = c.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(c.bucket)
+		for _, e := range entries {
+			if size <= c.maxBytes {
+				break
+			}
+			if err := b.Delete([]byte(e.key)); err != nil {
+				return err
+			}
+			delete(c.accessed, e.key)
+			size -= e.size
+			removed++
+		}
+		return nil
+	})
+	c.size = size
+
+	debug.Logger.Debug("Cache eviction", "removed", removed, "bytes", size, "maxBytes", c.maxBytes)
 }
 
 // Delete removes an item from the cache
 func (c *PersistentCache) Delete(key string) {
+	var freed int64
 	_ = c.db.Update(func(tx *bolt.Tx) error {
-		b := tx.Bucket(cacheBucket)
+		b := tx.Bucket(c.bucket)
+		if old := b.Get([]byte(key)); old != nil {
+			freed = int64(len(key) + len(old))
+		}
 		return b.Delete([]byte(key))
 	})
+	c.release(freed, key)
+}
+
+// release updates the size accounting after entries were removed
+func (c *PersistentCache) release(freed int64, keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.size -= freed
+	if c.size < 0 {
+		c.size = 0
+	}
+	for _, key := range keys {
+		delete(c.accessed, key)
+	}
+}
+
+// DeletePrefix removes all items whose key starts with the given prefix
+func (c *PersistentCache) DeletePrefix(prefix string) {
+	var removedKeys []string
+	var freed int64
+	_ = c.db.Update(func(tx *bolt.Tx) error {
+		cur := tx.Bucket(c.bucket).Cursor()
+		p := []byte(prefix)
+		for k, v := cur.Seek(p); k != nil && bytes.HasPrefix(k, p); k, v = cur.Seek(p) {
+			key := string(k)
+			size := int64(len(k) + len(v))
+			if err := cur.Delete(); err != nil {
+				return err
+			}
+			removedKeys = append(removedKeys, key)
+			freed += size
+		}
+		return nil
+	})
+	c.release(freed, removedKeys...)
+	debug.Logger.Debug("Cache prefix deleted", "prefix", prefix, "removed", len(removedKeys))
 }
 
 // Clear removes all items from the cache
 func (c *PersistentCache) Clear() {
 	_ = c.db.Update(func(tx *bolt.Tx) error {
 		// Delete and recreate the bucket
-		if err := tx.DeleteBucket(cacheBucket); err != nil {
+		if err := tx.DeleteBucket(c.bucket); err != nil {
 			return err
 		}
-		_, err := tx.CreateBucket(cacheBucket)
+		_, err := tx.CreateBucket(c.bucket)
 		return err
 	})
+	c.mu.Lock()
+	c.size = 0
+	c.accessed = make(map[string]time.Time)
+	c.mu.Unlock()
 	debug.Logger.Debug("Cache cleared")
 }
 
@@ -217,7 +429,7 @@ func (c *PersistentCache) Cleanup() {
 	var expiredKeys []string
 
 	_ = c.db.View(func(tx *bolt.Tx) error {
-		b := tx.Bucket(cacheBucket)
+		b := tx.Bucket(c.bucket)
 		return b.ForEach(func(k, v []byte) error {
 			var item persistentCacheItem
 			if err := json.Unmarshal(v, &item); err == nil {
@@ -230,13 +442,18 @@ func (c *PersistentCache) Cleanup() {
 	})
 
 	if len(expiredKeys) > 0 {
+		var freed int64
 		_ = c.db.Update(func(tx *bolt.Tx) error {
-			b := tx.Bucket(cacheBucket)
+			b := tx.Bucket(c.bucket)
 			for _, key := range expiredKeys {
+				if old := b.Get([]byte(key)); old != nil {
+					freed += int64(len(key) + len(old))
+				}
 				_ = b.Delete([]byte(key))
 			}
 			return nil
 		})
+		c.release(freed, expiredKeys...)
 		debug.Logger.Debug("Cache cleanup", "removed", len(expiredKeys))
 	}
 }
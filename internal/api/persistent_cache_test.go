@@ -1,9 +1,13 @@
 package api
 
 import (
+	"encoding/json"
 	"os"
+	"strings"
 	"testing"
 	"time"
+
+	bolt "go.etcd.io/bbolt"
 )
 
 func TestNewPersistentCache(t *testing.T) {
@@ -37,7 +41,7 @@ func TestPersistentCacheSetGet(t *testing.T) {
 	cache.Set("test-key", testValue)
 
 	var result []string
-	if !cache.GetTyped("test-key", &result) {
+	if _, ok := cache.GetTyped("test-key", &result); !ok {
 		t.Error("expected GetTyped to return true")
 	}
 
@@ -63,7 +67,7 @@ func TestPersistentCacheExpiry(t *testing.T) {
 
 	// Should be available immediately
 	var result string
-	if !cache.GetTyped("expiring-key", &result) {
+	if _, ok := cache.GetTyped("expiring-key", &result); !ok {
 		t.Error("expected GetTyped to return true before expiry")
 	}
 
@@ -71,11 +75,40 @@ func TestPersistentCacheExpiry(t *testing.T) {
 	time.Sleep(200 * time.Millisecond)
 
 	// Should be expired now
-	if cache.GetTyped("expiring-key", &result) {
+	if _, ok := cache.GetTyped("expiring-key", &result); ok {
 		t.Error("expected GetTyped to return false after expiry")
 	}
 }
 
+func TestPersistentCacheAge(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	cache, err := NewPersistentCache(time.Hour)
+	if err != nil {
+		t.Fatalf("NewPersistentCache() error = %v", err)
+	}
+	defer cache.Close()
+
+	cache.Set("fresh", "value")
+	var result string
+	age, ok := cache.GetTyped("fresh", &result)
+	if !ok || age < 0 || age > time.Minute {
+		t.Errorf("expected a fresh entry, got age %v, ok %v", age, ok)
+	}
+
+	// Entries without stored_at date from their expiry
+	item, _ := json.Marshal(persistentCacheItem{Value: json.RawMessage(`"old"`), ExpiresAt: time.Now().Add(50 * time.Minute)})
+	if err := cache.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(cacheBucket).Put([]byte("legacy"), item)
+	}); err != nil {
+		t.Fatalf("failed to write legacy entry: %v", err)
+	}
+	age, ok = cache.GetTyped("legacy", &result)
+	if !ok || age < 9*time.Minute || age > 11*time.Minute {
+		t.Errorf("expected a legacy entry about 10m old, got %v, ok %v", age, ok)
+	}
+}
+
 func TestPersistentCacheDelete(t *testing.T) {
 	defer setupTestEnv(t)()
 
@@ -88,17 +121,44 @@ func TestPersistentCacheDelete(t *testing.T) {
 	cache.Set("to-delete", "value")
 
 	var result string
-	if !cache.GetTyped("to-delete", &result) {
+	if _, ok := cache.GetTyped("to-delete", &result); !ok {
 		t.Error("expected value to exist before delete")
 	}
 
 	cache.Delete("to-delete")
 
-	if cache.GetTyped("to-delete", &result) {
+	if _, ok := cache.GetTyped("to-delete", &result); ok {
 		t.Error("expected value to be deleted")
 	}
 }
 
+func TestPersistentCacheDeletePrefix(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	cache, err := NewPersistentCache(30 * time.Second)
+	if err != nil {
+		t.Fatalf("NewPersistentCache() error = %v", err)
+	}
+	defer cache.Close()
+
+	cache.Set("incident_detail:id=inc_1:updated_at=a", "value1")
+	cache.Set("incident_detail:id=inc_1:updated_at=b", "value2")
+	cache.Set("incident_detail:id=inc_10:updated_at=a", "value3")
+
+	cache.DeletePrefix("incident_detail:id=inc_1:")
+
+	var result string
+	if _, ok := cache.GetTyped("incident_detail:id=inc_1:updated_at=a", &result); ok {
+		t.Error("expected first matching key to be deleted")
+	}
+	if _, ok := cache.GetTyped("incident_detail:id=inc_1:updated_at=b", &result); ok {
+		t.Error("expected second matching key to be deleted")
+	}
+	if _, ok := cache.GetTyped("incident_detail:id=inc_10:updated_at=a", &result); !ok {
+		t.Error("expected non-matching key to be kept")
+	}
+}
+
 func TestPersistentCacheClear(t *testing.T) {
 	defer setupTestEnv(t)()
 
@@ -114,10 +174,10 @@ func TestPersistentCacheClear(t *testing.T) {
 	cache.Clear()
 
 	var result string
-	if cache.GetTyped("key1", &result) {
+	if _, ok := cache.GetTyped("key1", &result); ok {
 		t.Error("expected key1 to be cleared")
 	}
-	if cache.GetTyped("key2", &result) {
+	if _, ok := cache.GetTyped("key2", &result); ok {
 		t.Error("expected key2 to be cleared")
 	}
 }
@@ -141,7 +201,7 @@ func TestPersistentCachePersistence(t *testing.T) {
 	defer cache2.Close()
 
 	var result string
-	if !cache2.GetTyped("persistent-key", &result) {
+	if _, ok := cache2.GetTyped("persistent-key", &result); !ok {
 		t.Error("expected persistent-key to persist across cache instances")
 	}
 	if result != "persistent-value" {
@@ -149,6 +209,40 @@ func TestPersistentCachePersistence(t *testing.T) {
 	}
 }
 
+func TestProfileCachesAreSeparate(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	work, err := NewProfileCache(30*time.Second, "work")
+	if err != nil {
+		t.Fatalf("NewProfileCache() error = %v", err)
+	}
+	work.Set("incidents:page=1", "work-incidents")
+	work.Close()
+
+	// The default profile shares the file but not the entries
+	def, err := NewPersistentCache(30 * time.Second)
+	if err != nil {
+		t.Fatalf("NewPersistentCache() error = %v", err)
+	}
+	var result string
+	if _, ok := def.GetTyped("incidents:page=1", &result); ok {
+		t.Errorf("expected the default profile not to see another profile's entries, got %q", result)
+	}
+	def.Set("incidents:page=1", "default-incidents")
+	def.Clear()
+	def.Close()
+
+	// Clearing one profile leaves the others' entries
+	work, err = NewProfileCache(30*time.Second, "work")
+	if err != nil {
+		t.Fatalf("NewProfileCache() second instance error = %v", err)
+	}
+	defer work.Close()
+	if _, ok := work.GetTyped("incidents:page=1", &result); !ok || result != "work-incidents" {
+		t.Errorf("expected the work profile's entry to persist, got %q (found %v)", result, ok)
+	}
+}
+
 func TestPersistentCacheWithIncidentStruct(t *testing.T) {
 	defer setupTestEnv(t)()
 
@@ -179,7 +273,7 @@ func TestPersistentCacheWithIncidentStruct(t *testing.T) {
 	cache.Set("incidents:pageSize=50", incidents)
 
 	var result []Incident
-	if !cache.GetTyped("incidents:pageSize=50", &result) {
+	if _, ok := cache.GetTyped("incidents:pageSize=50", &result); !ok {
 		t.Fatal("expected GetTyped to return true for incidents")
 	}
 
@@ -216,10 +310,10 @@ func TestPersistentCacheCleanup(t *testing.T) {
 
 	// Entries should be gone
 	var result string
-	if cache.GetTyped("key1", &result) {
+	if _, ok := cache.GetTyped("key1", &result); ok {
 		t.Error("expected key1 to be cleaned up")
 	}
-	if cache.GetTyped("key2", &result) {
+	if _, ok := cache.GetTyped("key2", &result); ok {
 		t.Error("expected key2 to be cleaned up")
 	}
 }
@@ -248,10 +342,10 @@ func TestPersistentCacheCleanupPartial(t *testing.T) {
 
 	// Old entry should be gone, new entry should remain
 	var result string
-	if cache.GetTyped("old-key", &result) {
+	if _, ok := cache.GetTyped("old-key", &result); ok {
 		t.Error("expected old-key to be cleaned up")
 	}
-	if !cache.GetTyped("new-key", &result) {
+	if _, ok := cache.GetTyped("new-key", &result); !ok {
 		t.Error("expected new-key to still exist")
 	}
 }
@@ -288,8 +382,86 @@ func TestPersistentCacheGetTypedInvalidType(t *testing.T) {
 	var result struct {
 		Field string `json:"field"`
 	}
-	if cache.GetTyped("string-key", &result) {
+	if _, ok := cache.GetTyped("string-key", &result); ok {
 		// It might still work if the JSON unmarshal succeeds
 		t.Log("GetTyped returned true (string can unmarshal to struct with string field)")
 	}
 }
+
+func TestPersistentCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	cache, err := NewPersistentCache(time.Hour)
+	if err != nil {
+		t.Fatalf("NewPersistentCache() error = %v", err)
+	}
+	defer cache.Close()
+
+	value := strings.Repeat("x", 200)
+	cache.Set("entry-0", value)
+	entrySize := cache.Stats().Bytes
+
+	// Room for three entries
+	maxBytes := entrySize*3 + entrySize/2
+	cache.SetMaxBytes(maxBytes)
+
+	cache.Set("entry-1", value)
+	time.Sleep(2 * time.Millisecond)
+	cache.Set("entry-2", value)
+	time.Sleep(2 * time.Millisecond)
+
+	// Touch entry-0 so entry-1 becomes the least recently used
+	if _, ok := cache.Get("entry-0"); !ok {
+		t.Fatal("expected entry-0 to be cached")
+	}
+	time.Sleep(2 * time.Millisecond)
+
+	cache.Set("entry-3", value)
+	time.Sleep(2 * time.Millisecond)
+	cache.Set("entry-4", value)
+
+	if _, ok := cache.Get("entry-1"); ok {
+		t.Error("expected entry-1 to be evicted")
+	}
+	if _, ok := cache.Get("entry-2"); ok {
+		t.Error("expected entry-2 to be evicted")
+	}
+	for _, key := range []string{"entry-0", "entry-3", "entry-4"} {
+		if _, ok := cache.Get(key); !ok {
+			t.Errorf("expected %s to be kept", key)
+		}
+	}
+
+	stats := cache.Stats()
+	if stats.Bytes > maxBytes {
+		t.Errorf("expected cache size <= %d, got %d", maxBytes, stats.Bytes)
+	}
+	if stats.Entries != 3 {
+		t.Errorf("expected 3 entries, got %d", stats.Entries)
+	}
+	if stats.MaxBytes != maxBytes {
+		t.Errorf("expected MaxBytes %d, got %d", maxBytes, stats.MaxBytes)
+	}
+}
+
+func TestPersistentCacheStatsTracksDeletes(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	cache, err := NewPersistentCache(time.Hour)
+	if err != nil {
+		t.Fatalf("NewPersistentCache() error = %v", err)
+	}
+	defer cache.Close()
+
+	cache.Set("a", "value")
+	cache.Set("b", "value")
+	cache.Delete("a")
+	cache.DeletePrefix("b")
+
+	if stats := cache.Stats(); stats.Entries != 0 || stats.Bytes != 0 {
+		t.Errorf("expected empty cache, got %+v", stats)
+	}
+	if cache.size != 0 {
+		t.Errorf("expected tracked size 0, got %d", cache.size)
+	}
+}
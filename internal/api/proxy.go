@@ -0,0 +1,32 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+)
+
+// newBaseTransport returns the transport API requests go out on: the default
+// one, which honors HTTP_PROXY, HTTPS_PROXY and NO_PROXY, or a copy of it
+// sending everything through proxyURL when one is configured
+func newBaseTransport(proxyURL string) (http.RoundTripper, error) {
+	proxyURL = strings.TrimSpace(proxyURL)
+	if proxyURL == "" {
+		return http.DefaultTransport, nil
+	}
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy_url %q: expected a URL like http://proxy.example.com:8080", proxyURL)
+	}
+	base, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("proxy_url is not supported with a custom default transport")
+	}
+	transport := base.Clone()
+	transport.Proxy = http.ProxyURL(u)
+	debug.Logger.Debug("Using configured proxy", "proxy", u.Redacted())
+	return transport, nil
+}
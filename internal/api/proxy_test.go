@@ -0,0 +1,41 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+func TestNewClientProxyURL(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	proxied := 0
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied++
+		if r.URL.Host != "api.rootly.test" {
+			t.Errorf("expected the proxy to receive the API host, got %q", r.URL.Host)
+		}
+		_, _ = w.Write([]byte(`{"data":[],"meta":{}}`))
+	}))
+	defer proxy.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: "http://api.rootly.test", ProxyURL: proxy.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if _, err := client.ListServices(context.Background(), 1); err != nil {
+		t.Fatalf("ListServices() error = %v", err)
+	}
+	if proxied != 1 {
+		t.Errorf("expected the request to go through the proxy, got %d proxied requests", proxied)
+	}
+
+	if _, err := NewClient(&config.Config{APIKey: "test-key", ProxyURL: "not a url"}); err == nil {
+		t.Error("expected an invalid proxy_url to fail")
+	}
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rateLimitLowRemaining is the fewest requests left in a window before the
+// rate limit counts as low (raised to a tenth of the limit for large windows)
+const rateLimitLowRemaining = 5
+
+// RateLimitStatus is the API's rate limit as of the latest response
+type RateLimitStatus struct {
+	Known     bool      // Whether any response carried rate-limit headers
+	Limit     int       // Requests allowed per window (0 when not sent)
+	Remaining int       // Requests left in the window
+	Reset     time.Time // When the window resets (zero when not sent)
+	RetryAt   time.Time // When a rate-limited request is retried (zero when none is waiting)
+}
+
+// Low reports whether few requests are left in the current window, so
+// background fetching should ease off until it resets
+func (s RateLimitStatus) Low(now time.Time) bool {
+	if !s.Known || (!s.Reset.IsZero() && !now.Before(s.Reset)) {
+		return false
+	}
+	return s.Remaining <= max(rateLimitLowRemaining, s.Limit/10)
+}
+
+// RetryingIn returns how long until a rate-limited request is retried, or 0
+func (s RateLimitStatus) RetryingIn(now time.Time) time.Duration {
+	return max(s.RetryAt.Sub(now), 0)
+}
+
+// parseRateLimit reads RateLimit-* headers, falling back to X-RateLimit-*.
+// Reset is either seconds until the window resets or, for values that can
+// only be one, a Unix timestamp.
+func parseRateLimit(h http.Header, now time.Time) (RateLimitStatus, bool) {
+	get := func(name string) string {
+		if v := h.Get("RateLimit-" + name); v != "" {
+			return v
+		}
+		return h.Get("X-RateLimit-" + name)
+	}
+	remaining, err := strconv.Atoi(strings.TrimSpace(get("Remaining")))
+	if err != nil {
+		return RateLimitStatus{}, false
+	}
+	status := RateLimitStatus{Known: true, Remaining: max(remaining, 0)}
+	if limit, err := strconv.Atoi(strings.TrimSpace(get("Limit"))); err == nil {
+		status.Limit = limit
+	}
+	if reset, err := strconv.ParseInt(strings.TrimSpace(get("Reset")), 10, 64); err == nil && reset >= 0 {
+		if reset > 1_000_000_000 {
+			status.Reset = time.Unix(reset, 0)
+		} else {
+			status.Reset = now.Add(time.Duration(reset) * time.Second)
+		}
+	}
+	return status, true
+}
+
+// rateLimitTracker holds the latest rate-limit status; written from the
+// transport and read from the UI
+type rateLimitTracker struct {
+	mu     sync.Mutex
+	status RateLimitStatus
+}
+
+// observe records the headers of one response. Any response other than a 429
+// means no request is waiting out the limit anymore.
+func (r *rateLimitTracker) observe(resp *http.Response) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if status, ok := parseRateLimit(resp.Header, time.Now()); ok {
+		status.RetryAt = r.status.RetryAt
+		r.status = status
+	}
+	if resp.StatusCode != http.StatusTooManyRequests {
+		r.status.RetryAt = time.Time{}
+	}
+}
+
+// retrying records that a rate-limited request is retried after wait
+func (r *rateLimitTracker) retrying(resp *http.Response, wait time.Duration) {
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status.RetryAt = time.Now().Add(wait)
+}
+
+func (r *rateLimitTracker) get() RateLimitStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.status
+}
+
+// rateLimitTransport records the rate-limit headers of every response,
+// including ones the retry transport retries
+type rateLimitTransport struct {
+	base    http.RoundTripper
+	tracker *rateLimitTracker
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if resp != nil {
+		t.tracker.observe(resp)
+	}
+	return resp, err
+}
+
+// RateLimitStatus returns the API's rate limit as of the latest response
+func (c *Client) RateLimitStatus() RateLimitStatus {
+	if c.rateLimits == nil {
+		return RateLimitStatus{}
+	}
+	return c.rateLimits.get()
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	h := http.Header{}
+	if _, ok := parseRateLimit(h, now); ok {
+		t.Error("expected no status without headers")
+	}
+
+	h.Set("RateLimit-Limit", "100")
+	h.Set("RateLimit-Remaining", "20")
+	h.Set("RateLimit-Reset", "30")
+	status, ok := parseRateLimit(h, now)
+	if !ok || status.Limit != 100 || status.Remaining != 20 || !status.Reset.Equal(now.Add(30*time.Second)) {
+		t.Errorf("unexpected status %+v", status)
+	}
+	if status.Low(now) {
+		t.Error("expected 20 of 100 not to be low")
+	}
+
+	// X-RateLimit-* with a Unix reset
+	h = http.Header{}
+	h.Set("X-RateLimit-Limit", "1000")
+	h.Set("X-RateLimit-Remaining", "50")
+	h.Set("X-RateLimit-Reset", "1767323105")
+	status, ok = parseRateLimit(h, now)
+	if !ok || status.Remaining != 50 || !status.Reset.Equal(time.Unix(1767323105, 0)) {
+		t.Errorf("unexpected status %+v", status)
+	}
+	if !status.Low(now) {
+		t.Error("expected 50 of 1000 to be low")
+	}
+	if status.Low(status.Reset) {
+		t.Error("expected the limit not to be low once the window resets")
+	}
+}
+
+func TestClientRateLimitStatus(t *testing.T) {
+	defer setupTestEnv(t)()
+	fastRetries(t)
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("RateLimit-Limit", "100")
+		if attempts.Add(1) == 1 {
+			w.Header().Set("RateLimit-Remaining", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("RateLimit-Remaining", "3")
+		_, _ = w.Write([]byte(`{"data":[],"meta":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if client.RateLimitStatus().Known {
+		t.Error("expected an unknown rate limit before any request")
+	}
+	if _, err := client.ListServices(context.Background(), 1); err != nil {
+		t.Fatalf("ListServices() error = %v", err)
+	}
+	status := client.RateLimitStatus()
+	if !status.Known || status.Remaining != 3 || !status.Low(time.Now()) {
+		t.Errorf("expected the latest headers to be recorded as low, got %+v", status)
+	}
+	if !status.RetryAt.IsZero() {
+		t.Error("expected no pending retry after the request succeeded")
+	}
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sequentialRefPattern matches incident references like "INC-123" or "123"
+var sequentialRefPattern = regexp.MustCompile(`^(?i)(?:inc-)?(\d+)$`)
+
+// ErrIncidentNotFound is returned when no incident has the requested number or ID
+var ErrIncidentNotFound = errors.New("incident not found")
+
+// ParseSequentialRef returns the number in an incident reference like "INC-123"
+// or "123"; ok is false for anything else (such as an incident ID)
+func ParseSequentialRef(ref string) (n int, ok bool) {
+	match := sequentialRefPattern.FindStringSubmatch(strings.TrimSpace(ref))
+	if match == nil {
+		return 0, false
+	}
+	n, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// incidentRefData is the part of an incident response needed to resolve a reference
+type incidentRefData struct {
+	ID         string `json:"id"`
+	Attributes struct {
+		SequentialID *int   `json:"sequential_id"`
+		UpdatedAt    string `json:"updated_at"`
+	} `json:"attributes"`
+}
+
+// ResolveIncidentRef turns a user-supplied reference (INC-123, 123 or an incident ID)
+// into the incident ID and its last update time, for use with GetIncident
+func (c *Client) ResolveIncidentRef(ctx context.Context, ref string) (string, time.Time, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return "", time.Time{}, fmt.Errorf("empty incident reference")
+	}
+
+	if match := sequentialRefPattern.FindStringSubmatch(ref); match != nil {
+		n, ok := ParseSequentialRef(ref)
+		if !ok {
+			return "", time.Time{}, fmt.Errorf("invalid incident number %q", ref)
+		}
+		var resp struct {
+			Data []incidentRefData `json:"data"`
+		}
+		path := "/v1/incidents?filter[search]=" + url.QueryEscape(match[1]) + "&page[size]=25"
+		if err := c.getJSON(ctx, path, "read incidents", &resp); err != nil {
+			return "", time.Time{}, err
+		}
+		for _, d := range resp.Data {
+			if d.Attributes.SequentialID != nil && *d.Attributes.SequentialID == n {
+				return d.ID, parseRefTime(d.Attributes.UpdatedAt), nil
+			}
+		}
+		return "", time.Time{}, fmt.Errorf("%w: INC-%d", ErrIncidentNotFound, n)
+	}
+
+	var resp struct {
+		Data incidentRefData `json:"data"`
+	}
+	if err := c.getJSON(ctx, "/v1/incidents/"+url.PathEscape(ref), "read incidents", &resp); err != nil {
+		var statusErr *statusError
+		if errors.As(err, &statusErr) && statusErr.Status == http.StatusNotFound {
+			return "", time.Time{}, fmt.Errorf("%w: %s", ErrIncidentNotFound, ref)
+		}
+		return "", time.Time{}, err
+	}
+	return resp.Data.ID, parseRefTime(resp.Data.Attributes.UpdatedAt), nil
+}
+
+// parseRefTime parses an RFC 3339 timestamp, returning the zero time if it is malformed
+func parseRefTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339, s)
+	return t
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+func TestResolveIncidentRef(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/incidents", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("filter[search]"); got != "123" && got != "77" {
+			t.Errorf("expected search for the bare number, got %q", got)
+		}
+		// Search also matches incidents mentioning the number elsewhere
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":"inc_other","attributes":{"sequential_id":1234,"updated_at":"2026-01-01T00:00:00Z"}},
+			{"id":"inc_123","attributes":{"sequential_id":123,"updated_at":"2026-01-02T03:04:05Z"}}
+		]}`))
+	})
+	mux.HandleFunc("/v1/incidents/abc-def", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"id":"abc-def","attributes":{"sequential_id":9,"updated_at":"2026-01-05T00:00:00Z"}}}`))
+	})
+	mux.HandleFunc("/v1/incidents/no-such-id", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"title":"Record not found"}]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	for _, ref := range []string{"INC-123", "inc-123", "123"} {
+		id, updatedAt, err := client.ResolveIncidentRef(context.Background(), ref)
+		if err != nil {
+			t.Fatalf("ResolveIncidentRef(%q) error = %v", ref, err)
+		}
+		if id != "inc_123" || updatedAt.Day() != 2 {
+			t.Errorf("ResolveIncidentRef(%q) = %q, %v; want inc_123 updated on the 2nd", ref, id, updatedAt)
+		}
+	}
+
+	id, _, err := client.ResolveIncidentRef(context.Background(), "abc-def")
+	if err != nil || id != "abc-def" {
+		t.Errorf("expected direct ID lookup, got %q, %v", id, err)
+	}
+
+	if _, _, err := client.ResolveIncidentRef(context.Background(), "INC-77"); !errors.Is(err, ErrIncidentNotFound) {
+		t.Errorf("expected ErrIncidentNotFound for an unknown incident number, got %v", err)
+	}
+
+	// The API answers 404 for an unknown ID
+	if _, _, err := client.ResolveIncidentRef(context.Background(), "no-such-id"); !errors.Is(err, ErrIncidentNotFound) {
+		t.Errorf("expected ErrIncidentNotFound for an unknown incident ID, got %v", err)
+	}
+}
+
+func TestParseSequentialRef(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want int
+		ok   bool
+	}{
+		{"INC-123", 123, true},
+		{" inc-7 ", 7, true},
+		{"42", 42, true},
+		{"abc-def", 0, false},
+		{"INC-", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		n, ok := ParseSequentialRef(tt.ref)
+		if n != tt.want || ok != tt.ok {
+			t.Errorf("ParseSequentialRef(%q) = %d, %v; want %d, %v", tt.ref, n, ok, tt.want, tt.ok)
+		}
+	}
+}
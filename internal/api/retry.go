@@ -0,0 +1,157 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+)
+
+// Backoff bounds for retried requests; variables so tests can shorten them
+var (
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 10 * time.Second
+)
+
+// retryTransport retries transient failures (429, 502, 503, 504 and dropped
+// or refused connections) with jittered exponential backoff, honoring
+// Retry-After. Requests that aren't idempotent are only retried on 429, since
+// the server didn't act on them.
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	onRetry    func(resp *http.Response, wait time.Duration) // Optional; resp is nil for transport errors
+}
+
+// newRetryTransport wraps base, calling onRetry (if set) before each wait;
+// maxRetries <= 0 returns base unchanged
+func newRetryTransport(base http.RoundTripper, maxRetries int, onRetry func(*http.Response, time.Duration)) http.RoundTripper {
+	if maxRetries <= 0 {
+		return base
+	}
+	return &retryTransport{base: base, maxRetries: maxRetries, onRetry: onRetry}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(req)
+		if attempt >= t.maxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := retryDelay(attempt, resp)
+		if t.onRetry != nil {
+			t.onRetry(resp, wait)
+		}
+		if resp != nil {
+			debug.Logger.Warn("Retrying API request", "url", req.URL.String(), "status", resp.StatusCode, "attempt", attempt+1, "wait", wait)
+			// Drain so the connection can be reused
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			_ = resp.Body.Close()
+		} else {
+			debug.Logger.Warn("Retrying API request", "url", req.URL.String(), "error", err, "attempt", attempt+1, "wait", wait)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether a response or error is worth another attempt
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	// A body that can't be replayed can't be sent again
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	if err != nil {
+		return isIdempotent(req.Method) && isTransientError(err)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+	return false
+}
+
+// isTransientError reports whether a transport error may succeed on retry:
+// timeouts, dropped connections and refused dials, but not bad addresses or
+// unknown hosts
+func isTransientError(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+	var addrErr *net.AddrError
+	if errors.As(err, &addrErr) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// isIdempotent reports whether sending a request twice has the same effect as once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryDelay returns how long to wait before the next attempt: Retry-After
+// when the server sent one, otherwise exponential backoff with full jitter
+// over its upper half. Both are capped at retryMaxDelay.
+func retryDelay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, retryMaxDelay)
+		}
+	}
+	backoff := min(retryBaseDelay<<attempt, retryMaxDelay)
+	if backoff <= 0 {
+		return 0
+	}
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// parseRetryAfter parses a Retry-After value in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(secs)*time.Second, 0), true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+// fastRetries shortens the backoff for the duration of a test
+func fastRetries(t *testing.T) {
+	t.Helper()
+	base, maxDelay := retryBaseDelay, retryMaxDelay
+	retryBaseDelay, retryMaxDelay = time.Millisecond, 10*time.Millisecond
+	t.Cleanup(func() { retryBaseDelay, retryMaxDelay = base, maxDelay })
+}
+
+func TestRetryTransientFailures(t *testing.T) {
+	defer setupTestEnv(t)()
+	fastRetries(t)
+
+	// Each path fails twice (503, then 429 with Retry-After) before succeeding
+	var mu sync.Mutex
+	attempts := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts[r.URL.Path]++
+		attempt := attempts[r.URL.Path]
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch {
+		case attempt == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case attempt == 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case r.URL.Path == "/v1/incidents/inc_1":
+			_, _ = w.Write([]byte(`{"data":{"id":"inc_1","type":"incidents","attributes":{"title":"Database outage","status":"started"}}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":[],"meta":{"current_page":1,"total_pages":1,"total_count":0}}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	inc, err := client.GetIncident(context.Background(), "inc_1", time.Now())
+	if err != nil {
+		t.Fatalf("GetIncident failed after retries: %v", err)
+	}
+	if inc.Title != "Database outage" {
+		t.Errorf("unexpected title %q", inc.Title)
+	}
+
+	if _, err := client.ListIncidents(context.Background(), 1, "", ""); err != nil {
+		t.Fatalf("ListIncidents failed after retries: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, path := range []string{"/v1/incidents/inc_1", "/v1/incidents"} {
+		if attempts[path] != 3 {
+			t.Errorf("expected 3 requests to %s, got %d", path, attempts[path])
+		}
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	defer setupTestEnv(t)()
+	fastRetries(t)
+
+	tests := []struct {
+		name    string
+		status  int
+		retries int
+		want    int32
+	}{
+		{"not found is not retried", http.StatusNotFound, 3, 1},
+		{"server error is not retried", http.StatusInternalServerError, 3, 1},
+		{"gateway errors stop at the limit", http.StatusBadGateway, 2, 3},
+		{"negative disables retries", http.StatusServiceUnavailable, -1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL, MaxRetries: tt.retries})
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			defer client.Close()
+
+			if _, err := client.GetIncident(context.Background(), "inc_1", time.Now()); err == nil {
+				t.Error("expected an error")
+			}
+			if got := requests.Load(); got != tt.want {
+				t.Errorf("expected %d requests, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRetryDroppedConnection(t *testing.T) {
+	defer setupTestEnv(t)()
+	fastRetries(t)
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			// Close the connection without a response
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			_ = conn.Close()
+			return
+		}
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{"data":[],"meta":{"current_page":1,"total_pages":1,"total_count":0}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if _, err := client.ListIncidents(context.Background(), 1, "", ""); err != nil {
+		t.Fatalf("ListIncidents failed after a dropped connection: %v", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestRetryRespectsCancellation(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "5")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := client.GetIncident(ctx, "inc_1", time.Now()); err == nil {
+		t.Error("expected an error")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected the retry wait to stop on cancel, took %v", elapsed)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected 1 request before cancel, got %d", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if d, ok := parseRetryAfter("2"); !ok || d != 2*time.Second {
+		t.Errorf("parseRetryAfter(2) = %v, %v", d, ok)
+	}
+	if _, ok := parseRetryAfter(""); ok {
+		t.Error("expected an empty value to be ignored")
+	}
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Error("expected an invalid value to be ignored")
+	}
+	future := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if d, ok := parseRetryAfter(future); !ok || d <= 0 || d > time.Minute {
+		t.Errorf("parseRetryAfter(%q) = %v, %v", future, d, ok)
+	}
+}
+
+func TestRetryDelayBounds(t *testing.T) {
+	for attempt := range 6 {
+		d := retryDelay(attempt, nil)
+		upper := min(retryBaseDelay<<attempt, retryMaxDelay)
+		if d < upper/2 || d > upper {
+			t.Errorf("attempt %d: delay %v outside [%v, %v]", attempt, d, upper/2, upper)
+		}
+	}
+}
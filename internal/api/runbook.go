@@ -0,0 +1,27 @@
+package api
+
+import (
+	"regexp"
+	"strings"
+)
+
+// RunbookLabel is the incident label holding the runbook link
+const RunbookLabel = "runbook_url"
+
+// summaryURLPattern matches web links in free-form summary text
+var summaryURLPattern = regexp.MustCompile(`https?://[^\s)\]>"'` + "`" + `]+`)
+
+// RunbookURL returns the incident's runbook link: the runbook_url label if set,
+// otherwise the first URL in the summary. Returns "" if there is neither.
+func (i *Incident) RunbookURL() string {
+	for k, v := range i.Labels {
+		if strings.EqualFold(k, RunbookLabel) {
+			v = strings.TrimSpace(v)
+			if strings.HasPrefix(v, "https://") || strings.HasPrefix(v, "http://") {
+				return v
+			}
+		}
+	}
+	// Trailing punctuation usually belongs to the sentence, not the link
+	return strings.TrimRight(summaryURLPattern.FindString(i.Summary), ".,;:!?")
+}
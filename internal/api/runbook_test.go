@@ -0,0 +1,45 @@
+package api
+
+import "testing"
+
+func TestIncidentRunbookURL(t *testing.T) {
+	tests := []struct {
+		name string
+		inc  Incident
+		want string
+	}{
+		{
+			name: "label takes precedence over summary",
+			inc: Incident{
+				Labels:  map[string]string{"runbook_url": "https://wiki.example.com/runbooks/db"},
+				Summary: "See https://status.example.com for updates.",
+			},
+			want: "https://wiki.example.com/runbooks/db",
+		},
+		{
+			name: "falls back to first summary URL",
+			inc:  Incident{Summary: "Runbook: https://wiki.example.com/runbooks/api. Also https://other.example.com"},
+			want: "https://wiki.example.com/runbooks/api",
+		},
+		{
+			name: "ignores non-web label values",
+			inc: Incident{
+				Labels:  map[string]string{"Runbook_URL": "see wiki"},
+				Summary: "(https://wiki.example.com/rb)",
+			},
+			want: "https://wiki.example.com/rb",
+		},
+		{
+			name: "none found",
+			inc:  Incident{Summary: "No links here"},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.inc.RunbookURL(); got != tt.want {
+				t.Errorf("RunbookURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
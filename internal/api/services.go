@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+)
+
+// Service is a service from the Rootly service catalog
+type Service struct {
+	ID          string
+	Name        string
+	Slug        string
+	Description string
+	Status      string
+	Owner       string // Owning teams, comma-separated
+	UpdatedAt   time.Time
+}
+
+// ServicesResult contains services and pagination info
+type ServicesResult struct {
+	Services   []Service
+	Pagination PaginationInfo
+}
+
+// URL returns the service's page in the Rootly web app
+func (s Service) URL() string {
+	ref := s.Slug
+	if ref == "" {
+		ref = s.ID
+	}
+	if ref == "" {
+		return ""
+	}
+	return "https://rootly.com/account/services/" + ref
+}
+
+// ListServices fetches a page of services sorted by name. Owning teams are
+// resolved to names with ListTeams, so both lists are served from the cache
+// until they expire.
+func (c *Client) ListServices(ctx context.Context, page int) (*ServicesResult, error) {
+	pageSize := c.PageSize()
+
+	cacheKey := NewCacheKey(CacheKeyPrefixServices).
+		With("page", page).
+		With("pageSize", pageSize).
+		Build()
+
+	// Check cache first
+	if c.cache != nil {
+		var cached ServicesResult
+		if _, ok := c.cache.GetTyped(cacheKey, &cached); ok {
+			debug.Logger.Debug("Cache hit for services", "key", cacheKey)
+			return &cached, nil
+		}
+	}
+
+	debug.Logger.Debug("Fetching services", "pageSize", pageSize, "cache", "miss", "key", cacheKey)
+
+	var resp struct {
+		Data []struct {
+			ID         string `json:"id"`
+			Attributes struct {
+				Name          string   `json:"name"`
+				Slug          string   `json:"slug"`
+				Description   *string  `json:"description"`
+				Status        string   `json:"status"`
+				OwnerGroupIDs []string `json:"owner_group_ids"`
+				UpdatedAt     string   `json:"updated_at"`
+			} `json:"attributes"`
+		} `json:"data"`
+		Meta struct {
+			CurrentPage int  `json:"current_page"`
+			NextPage    *int `json:"next_page"`
+			PrevPage    *int `json:"prev_page"`
+			TotalPages  int  `json:"total_pages"`
+			TotalCount  int  `json:"total_count"`
+		} `json:"meta"`
+	}
+	path := fmt.Sprintf("/v1/services?page[number]=%d&page[size]=%d&sort=name", page, pageSize)
+	if err := c.getJSON(ctx, path, "read services", &resp); err != nil {
+		return nil, err
+	}
+
+	var teamNames map[string]string
+	services := make([]Service, 0, len(resp.Data))
+	for _, d := range resp.Data {
+		svc := Service{
+			ID:     d.ID,
+			Name:   strings.TrimSpace(d.Attributes.Name),
+			Slug:   d.Attributes.Slug,
+			Status: d.Attributes.Status,
+		}
+		if d.Attributes.Description != nil {
+			svc.Description = strings.TrimSpace(*d.Attributes.Description)
+		}
+		if t, err := time.Parse(time.RFC3339, d.Attributes.UpdatedAt); err == nil {
+			svc.UpdatedAt = t
+		}
+		if len(d.Attributes.OwnerGroupIDs) > 0 {
+			if teamNames == nil {
+				teamNames = c.teamNamesByID(ctx)
+			}
+			var owners []string
+			for _, id := range d.Attributes.OwnerGroupIDs {
+				if name, ok := teamNames[id]; ok {
+					owners = append(owners, name)
+				}
+			}
+			svc.Owner = strings.Join(owners, ", ")
+		}
+		services = append(services, svc)
+	}
+
+	currentPage := page
+	if resp.Meta.CurrentPage > 0 {
+		currentPage = resp.Meta.CurrentPage
+	}
+	result := &ServicesResult{
+		Services: services,
+		Pagination: PaginationInfo{
+			CurrentPage: currentPage,
+			TotalPages:  resp.Meta.TotalPages,
+			TotalCount:  resp.Meta.TotalCount,
+			HasNext:     resp.Meta.NextPage != nil && *resp.Meta.NextPage > 0,
+			HasPrev:     resp.Meta.PrevPage != nil && *resp.Meta.PrevPage > 0,
+		},
+	}
+
+	// Store in cache
+	if c.cache != nil {
+		c.cache.Set(cacheKey, result)
+		debug.Logger.Debug("Cached services", "count", len(services), "key", cacheKey)
+	}
+
+	return result, nil
+}
+
+// teamNamesByID maps team IDs to names; owners are left blank when teams can't be listed
+func (c *Client) teamNamesByID(ctx context.Context) map[string]string {
+	names := make(map[string]string)
+	teams, err := c.ListTeams(ctx)
+	if err != nil {
+		debug.Logger.Warn("Failed to list teams for service owners", "error", err)
+		return names
+	}
+	for _, t := range teams {
+		names[t.ID] = t.Name
+	}
+	return names
+}
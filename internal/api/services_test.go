@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+func TestListServices(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	serviceCalls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/services", func(w http.ResponseWriter, r *http.Request) {
+		serviceCalls++
+		if got := r.URL.Query().Get("page[number]"); got != "2" {
+			t.Errorf("expected page 2, got %q", got)
+		}
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":"svc_1","attributes":{"name":"API","slug":"api","description":"Public REST API","status":"operational","owner_group_ids":["team_1","team_gone"],"updated_at":"2026-01-02T03:04:05Z"}},
+			{"id":"svc_2","attributes":{"name":"Checkout","description":null}}
+		],"meta":{"current_page":2,"next_page":3,"prev_page":1,"total_pages":3,"total_count":60}}`))
+	})
+	mux.HandleFunc("/v1/teams", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{"data":[{"id":"team_1","type":"groups","attributes":{"name":"Platform"}}],"links":{},"meta":{}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	result, err := client.ListServices(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("ListServices() error = %v", err)
+	}
+	if len(result.Services) != 2 {
+		t.Fatalf("expected 2 services, got %d", len(result.Services))
+	}
+	svc := result.Services[0]
+	if svc.Name != "API" || svc.Description != "Public REST API" || svc.Status != "operational" || svc.UpdatedAt.IsZero() {
+		t.Errorf("unexpected service %+v", svc)
+	}
+	if svc.Owner != "Platform" {
+		t.Errorf("expected unknown owner teams to be skipped, got %q", svc.Owner)
+	}
+	if svc.URL() != "https://rootly.com/account/services/api" {
+		t.Errorf("unexpected URL %q", svc.URL())
+	}
+	if checkout := result.Services[1]; checkout.Description != "" || checkout.URL() != "https://rootly.com/account/services/svc_2" {
+		t.Errorf("expected a blank description and an ID URL, got %+v", checkout)
+	}
+	p := result.Pagination
+	if p.CurrentPage != 2 || p.TotalPages != 3 || p.TotalCount != 60 || !p.HasNext || !p.HasPrev {
+		t.Errorf("unexpected pagination %+v", p)
+	}
+
+	if _, err := client.ListServices(context.Background(), 2); err != nil {
+		t.Fatalf("ListServices() from cache error = %v", err)
+	}
+	if serviceCalls != 1 {
+		t.Errorf("expected the second call to be cached, got %d requests", serviceCalls)
+	}
+}
@@ -0,0 +1,57 @@
+package api
+
+import "strings"
+
+// slackEscaper escapes the characters Slack mrkdwn treats as control sequences
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// ToSlackMrkdwn formats the incident for pasting into Slack, using mrkdwn
+// (single-asterisk bold and <url|text> links) rather than standard Markdown.
+func (i *Incident) ToSlackMrkdwn() string {
+	var b strings.Builder
+
+	id := i.SequentialID
+	if id == "" {
+		id = i.ID
+	}
+	b.WriteString("*[" + slackEscaper.Replace(id) + "] " + slackEscaper.Replace(i.Title) + "*\n")
+
+	var facts []string
+	if i.Status != "" {
+		facts = append(facts, "*Status:* "+slackEscaper.Replace(i.Status))
+	}
+	if i.Severity != "" {
+		facts = append(facts, "*Severity:* "+slackEscaper.Replace(i.Severity))
+	}
+	if len(facts) > 0 {
+		b.WriteString(strings.Join(facts, " | ") + "\n")
+	}
+	if len(i.Services) > 0 {
+		b.WriteString("*Services:* " + slackEscaper.Replace(strings.Join(i.Services, ", ")) + "\n")
+	}
+
+	if summary := strings.TrimSpace(i.Summary); summary != "" {
+		b.WriteString(slackEscaper.Replace(summary) + "\n")
+	}
+
+	var links []string
+	if i.URL != "" {
+		links = append(links, slackLink(i.URL, "Rootly"))
+	} else if i.ShortURL != "" {
+		links = append(links, slackLink(i.ShortURL, "Rootly"))
+	}
+	if i.SlackChannelURL != "" {
+		links = append(links, slackLink(i.SlackChannelURL, "Slack channel"))
+	}
+	if len(links) > 0 {
+		b.WriteString(strings.Join(links, " | ") + "\n")
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// slackLink renders a mrkdwn link; "|" and ">" would end the URL early
+func slackLink(url, text string) string {
+	url = strings.NewReplacer("|", "%7C", ">", "%3E").Replace(url)
+	return "<" + url + "|" + slackEscaper.Replace(text) + ">"
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIncidentToSlackMrkdwn(t *testing.T) {
+	inc := Incident{
+		SequentialID:    "INC-123",
+		Title:           "Checkout <errors> & timeouts",
+		Status:          "started",
+		Severity:        "SEV1",
+		Services:        []string{"payments", "web"},
+		URL:             "https://rootly.com/account/incidents/123",
+		SlackChannelURL: "https://slack.com/archives/C123",
+	}
+
+	got := inc.ToSlackMrkdwn()
+	lines := strings.Split(got, "\n")
+
+	if lines[0] != "*[INC-123] Checkout &lt;errors&gt; &amp; timeouts*" {
+		t.Errorf("expected single-asterisk bold title with escaped text, got %q", lines[0])
+	}
+	if strings.Contains(got, "**") {
+		t.Error("expected no Markdown double-asterisk bold")
+	}
+	if !strings.Contains(got, "*Status:* started | *Severity:* SEV1") {
+		t.Errorf("expected status/severity line, got %q", got)
+	}
+	if !strings.Contains(got, "*Services:* payments, web") {
+		t.Errorf("expected services line, got %q", got)
+	}
+	if !strings.Contains(got, "<https://rootly.com/account/incidents/123|Rootly>") {
+		t.Errorf("expected Slack link syntax for the Rootly URL, got %q", got)
+	}
+	if !strings.Contains(got, "<https://slack.com/archives/C123|Slack channel>") {
+		t.Errorf("expected Slack channel link, got %q", got)
+	}
+	if strings.Contains(got, "](") {
+		t.Error("expected no Markdown link syntax")
+	}
+}
+
+func TestIncidentToSlackMrkdwnMinimal(t *testing.T) {
+	inc := Incident{ID: "abc", Title: "Outage"}
+	if got := inc.ToSlackMrkdwn(); got != "*[abc] Outage*" {
+		t.Errorf("expected only the title line, got %q", got)
+	}
+}
@@ -0,0 +1,16 @@
+package api
+
+import (
+	"strings"
+	"text/template"
+)
+
+// RenderStatusUpdate renders the incident through a status update template
+// (see config.StatusUpdateTemplate); the incident's fields are the template data
+func (i *Incident) RenderStatusUpdate(tmpl *template.Template) (string, error) {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, i); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(b.String()), nil
+}
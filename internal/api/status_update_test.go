@@ -0,0 +1,45 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+func TestIncidentRenderStatusUpdate(t *testing.T) {
+	tmpl, err := (&config.Config{}).StatusUpdate()
+	if err != nil {
+		t.Fatalf("failed to parse default template: %v", err)
+	}
+
+	inc := Incident{
+		SequentialID: "INC-42",
+		Title:        "Checkout errors",
+		Status:       "mitigated",
+		Summary:      "Payments are recovering after a rollback.",
+	}
+	got, err := inc.RenderStatusUpdate(tmpl)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "INC-42 [mitigated] Checkout errors\n\nPayments are recovering after a rollback."
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	// Without a summary there's no trailing blank paragraph
+	inc.Summary = ""
+	if got, _ := inc.RenderStatusUpdate(tmpl); got != "INC-42 [mitigated] Checkout errors" {
+		t.Errorf("unexpected update without summary: %q", got)
+	}
+}
+
+func TestIncidentRenderStatusUpdateUnknownField(t *testing.T) {
+	tmpl, err := (&config.Config{StatusUpdateTemplate: "{{.Nope}}"}).StatusUpdate()
+	if err != nil {
+		t.Fatalf("unexpected parse error: %v", err)
+	}
+	if _, err := (&Incident{}).RenderStatusUpdate(tmpl); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+)
+
+// maxSummaryPages bounds how many pages ListIncidentCreatedTimes fetches
+const maxSummaryPages = 10
+
+// ListIncidentCreatedTimes returns the creation time of every incident created since the given time.
+// Only the created_at field is requested, so a week of incidents is cheap to fetch.
+func (c *Client) ListIncidentCreatedTimes(ctx context.Context, since time.Time) ([]time.Time, error) {
+	var times []time.Time
+	for page := 1; page <= maxSummaryPages; page++ {
+		path := fmt.Sprintf("/v1/incidents?filter[created_at][gte]=%s&fields[incidents]=created_at&page[number]=%d&page[size]=100",
+			url.QueryEscape(since.UTC().Format(time.RFC3339)), page)
+
+		var result struct {
+			Data []struct {
+				Attributes struct {
+					CreatedAt string `json:"created_at"`
+				} `json:"attributes"`
+			} `json:"data"`
+			Meta struct {
+				NextPage *int `json:"next_page"`
+			} `json:"meta"`
+		}
+		if err := c.getJSON(ctx, path, "read incidents", &result); err != nil {
+			return nil, err
+		}
+
+		for _, d := range result.Data {
+			t, err := time.Parse(time.RFC3339, d.Attributes.CreatedAt)
+			if err != nil {
+				continue
+			}
+			times = append(times, t)
+		}
+
+		if result.Meta.NextPage == nil || *result.Meta.NextPage <= page || len(result.Data) == 0 {
+			break
+		}
+	}
+
+	debug.Logger.Debug("Fetched incident creation times", "since", since, "count", len(times))
+	return times, nil
+}
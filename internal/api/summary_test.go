@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+func TestListIncidentCreatedTimes(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if got := r.URL.Query().Get("filter[created_at][gte]"); got != "2026-03-01T00:00:00Z" {
+			t.Errorf("expected created_at filter, got %q", got)
+		}
+		switch r.URL.Query().Get("page[number]") {
+		case "1":
+			_, _ = w.Write([]byte(`{"data":[
+				{"attributes":{"created_at":"2026-03-02T10:00:00Z"}},
+				{"attributes":{"created_at":"not a time"}}
+			],"meta":{"next_page":2}}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"data":[{"attributes":{"created_at":"2026-03-03T11:30:00Z"}}],"meta":{"next_page":null}}`))
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page[number]"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	times, err := client.ListIncidentCreatedTimes(context.Background(), since)
+	if err != nil {
+		t.Fatalf("ListIncidentCreatedTimes() error = %v", err)
+	}
+	if len(times) != 2 {
+		t.Fatalf("expected 2 times, got %d", len(times))
+	}
+	if !times[1].Equal(time.Date(2026, 3, 3, 11, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected time %v", times[1])
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// Action item statuses reported by the API
+const (
+	TaskStatusOpen       = "open"
+	TaskStatusInProgress = "in_progress"
+	TaskStatusDone       = "done"
+	TaskStatusCancelled  = "cancelled"
+)
+
+// IncidentTask is a follow-up action item attached to an incident
+type IncidentTask struct {
+	Title        string
+	Status       string
+	AssigneeName string
+}
+
+// IsDone returns true if the action item has been completed
+func (t IncidentTask) IsDone() bool {
+	return strings.EqualFold(t.Status, TaskStatusDone)
+}
+
+// ListIncidentTasks fetches the action items of an incident
+func (c *Client) ListIncidentTasks(ctx context.Context, id string) ([]IncidentTask, error) {
+	var resp struct {
+		Data []struct {
+			Attributes struct {
+				Summary    string `json:"summary"`
+				Status     string `json:"status"`
+				AssignedTo *struct {
+					FullName string `json:"full_name"`
+					Name     string `json:"name"`
+				} `json:"assigned_to"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	path := "/v1/incidents/" + url.PathEscape(id) + "/action_items?page[size]=100"
+	if err := c.getJSON(ctx, path, "read incident action items", &resp); err != nil {
+		return nil, err
+	}
+
+	tasks := make([]IncidentTask, 0, len(resp.Data))
+	for _, item := range resp.Data {
+		task := IncidentTask{
+			Title:  strings.TrimSpace(item.Attributes.Summary),
+			Status: item.Attributes.Status,
+		}
+		if a := item.Attributes.AssignedTo; a != nil {
+			task.AssigneeName = a.FullName
+			if task.AssigneeName == "" {
+				task.AssigneeName = a.Name
+			}
+		}
+		if task.Title == "" {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
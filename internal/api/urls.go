@@ -0,0 +1,35 @@
+package api
+
+import "fmt"
+
+// ResolveIncidentURL returns the incident's Rootly link: the short URL, then
+// the full URL, then one built from the ID. Returns "" for an empty incident.
+func ResolveIncidentURL(inc *Incident) string {
+	if inc == nil {
+		return ""
+	}
+	switch {
+	case inc.ShortURL != "":
+		return inc.ShortURL
+	case inc.URL != "":
+		return inc.URL
+	case inc.ID != "":
+		return fmt.Sprintf("https://rootly.com/account/incidents/%s", inc.ID)
+	}
+	return ""
+}
+
+// ResolveAlertURL returns the alert's Rootly link: the URL from the API, else
+// one built from the short ID. Returns "" when there is neither.
+func ResolveAlertURL(alert *Alert) string {
+	if alert == nil {
+		return ""
+	}
+	switch {
+	case alert.URL != "":
+		return alert.URL
+	case alert.ShortID != "":
+		return fmt.Sprintf("https://rootly.com/account/alerts/%s", alert.ShortID)
+	}
+	return ""
+}
@@ -0,0 +1,44 @@
+package api
+
+import "testing"
+
+func TestResolveIncidentURL(t *testing.T) {
+	tests := []struct {
+		name string
+		inc  *Incident
+		want string
+	}{
+		{"nil", nil, ""},
+		{"short URL first", &Incident{ID: "inc_1", URL: "https://rootly.com/account/incidents/1", ShortURL: "https://rootly.com/i/abc"}, "https://rootly.com/i/abc"},
+		{"full URL", &Incident{ID: "inc_1", URL: "https://rootly.com/account/incidents/1"}, "https://rootly.com/account/incidents/1"},
+		{"built from ID", &Incident{ID: "inc_1"}, "https://rootly.com/account/incidents/inc_1"},
+		{"empty", &Incident{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveIncidentURL(tt.inc); got != tt.want {
+				t.Errorf("ResolveIncidentURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveAlertURL(t *testing.T) {
+	tests := []struct {
+		name  string
+		alert *Alert
+		want  string
+	}{
+		{"nil", nil, ""},
+		{"API URL first", &Alert{ShortID: "ABC123", URL: "https://rootly.com/account/alerts/xyz"}, "https://rootly.com/account/alerts/xyz"},
+		{"built from short ID", &Alert{ShortID: "ABC123"}, "https://rootly.com/account/alerts/ABC123"},
+		{"empty", &Alert{ID: "alert_1"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveAlertURL(tt.alert); got != tt.want {
+				t.Errorf("ResolveAlertURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
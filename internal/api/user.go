@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// User is the user the API key or OAuth token belongs to
+type User struct {
+	ID    string
+	Name  string
+	Email string
+}
+
+// CurrentUser returns the authenticated user. The result is cached for the
+// lifetime of the client, since it can't change without new credentials.
+func (c *Client) CurrentUser(ctx context.Context) (*User, error) {
+	c.currentUserMu.Lock()
+	defer c.currentUserMu.Unlock()
+	if c.currentUser != nil {
+		return c.currentUser, nil
+	}
+
+	var me struct {
+		Data struct {
+			ID         string `json:"id"`
+			Attributes struct {
+				Name     string `json:"name"`
+				FullName string `json:"full_name"`
+				Email    string `json:"email"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := c.getJSON(ctx, "/v1/users/me", "read users", &me); err != nil {
+		return nil, err
+	}
+
+	user := &User{
+		ID:    me.Data.ID,
+		Name:  me.Data.Attributes.FullName,
+		Email: me.Data.Attributes.Email,
+	}
+	if user.Name == "" {
+		user.Name = me.Data.Attributes.Name
+	}
+	c.currentUser = user
+	return user, nil
+}
+
+// CurrentUserTeams returns the names of the teams the authenticated user belongs to
+func (c *Client) CurrentUserTeams(ctx context.Context) ([]string, error) {
+	me, err := c.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var teams struct {
+		Data []struct {
+			Attributes struct {
+				Name    string `json:"name"`
+				UserIDs []int  `json:"user_ids"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := c.getJSON(ctx, "/v1/teams?page[size]=100&sort=name", "read teams", &teams); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, t := range teams.Data {
+		for _, id := range t.Attributes.UserIDs {
+			if strconv.Itoa(id) == me.ID && strings.TrimSpace(t.Attributes.Name) != "" {
+				names = append(names, strings.TrimSpace(t.Attributes.Name))
+				break
+			}
+		}
+	}
+	return names, nil
+}
+
+// InvolvesUser returns true if the user created the incident or holds one of
+// its roles. Emails are compared when known, otherwise names.
+func (i *Incident) InvolvesUser(u *User) bool {
+	if i == nil || u == nil {
+		return false
+	}
+	if sameUser(u, i.CreatedByName, i.CreatedByEmail) {
+		return true
+	}
+	for _, role := range i.Roles {
+		if sameUser(u, role.UserName, role.UserEmail) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameUser reports whether name/email identify u (case-insensitive)
+func sameUser(u *User, name, email string) bool {
+	email = strings.TrimSpace(email)
+	if email != "" && u.Email != "" {
+		return strings.EqualFold(email, u.Email)
+	}
+	name = strings.TrimSpace(name)
+	return name != "" && strings.EqualFold(name, u.Name)
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+func TestIncidentInvolvesUser(t *testing.T) {
+	user := &User{ID: "42", Name: "Jane Smith", Email: "jane@example.com"}
+
+	tests := []struct {
+		name     string
+		incident Incident
+		expected bool
+	}{
+		{"creator by email", Incident{CreatedByEmail: "Jane@Example.com"}, true},
+		{"role holder", Incident{Roles: []IncidentRole{{Name: "Commander", UserEmail: "jane@example.com"}}}, true},
+		{"name fallback without email", Incident{CreatedByName: "jane smith"}, true},
+		{"different email wins over same name", Incident{CreatedByName: "Jane Smith", CreatedByEmail: "other@example.com"}, false},
+		{"unrelated", Incident{Roles: []IncidentRole{{Name: "Commander", UserName: "Bob"}}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.incident.InvolvesUser(user); got != tt.expected {
+				t.Errorf("InvolvesUser() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCurrentUserTeams(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/users/me", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"id":"42","type":"users"}}`))
+	})
+	mux.HandleFunc("/v1/teams", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":"team_1","attributes":{"name":"Mobile","user_ids":[7]}},
+			{"id":"team_2","attributes":{"name":"Platform","user_ids":[7,42]}}
+		]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	teams, err := client.CurrentUserTeams(context.Background())
+	if err != nil {
+		t.Fatalf("CurrentUserTeams() error = %v", err)
+	}
+	if len(teams) != 1 || teams[0] != "Platform" {
+		t.Errorf("expected [Platform], got %v", teams)
+	}
+}
+
+func TestCurrentUser(t *testing.T) {
+	defer setupTestEnv(t)()
+
+	meRequests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/users/me", func(w http.ResponseWriter, r *http.Request) {
+		meRequests++
+		_, _ = w.Write([]byte(`{"data":{"id":"42","type":"users","attributes":{"full_name":"Jane Smith","email":"jane@example.com"}}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	me, err := client.CurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("CurrentUser() error = %v", err)
+	}
+	if me.ID != "42" || me.Name != "Jane Smith" || me.Email != "jane@example.com" {
+		t.Errorf("unexpected current user %+v", me)
+	}
+
+	// The current user is cached
+	if _, err := client.CurrentUser(context.Background()); err != nil {
+		t.Fatalf("CurrentUser() error = %v", err)
+	}
+	if meRequests != 1 {
+		t.Errorf("expected /v1/users/me to be fetched once, got %d", meRequests)
+	}
+}
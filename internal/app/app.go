@@ -3,7 +3,9 @@ package app
 import (
 	"context"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"time"
@@ -17,10 +19,12 @@ import (
 	"errors"
 
 	"github.com/rootlyhq/rootly-tui/internal/api"
+	"github.com/rootlyhq/rootly-tui/internal/components"
 	"github.com/rootlyhq/rootly-tui/internal/config"
 	"github.com/rootlyhq/rootly-tui/internal/debug"
 	"github.com/rootlyhq/rootly-tui/internal/i18n"
 	"github.com/rootlyhq/rootly-tui/internal/oauth"
+	"github.com/rootlyhq/rootly-tui/internal/state"
 	"github.com/rootlyhq/rootly-tui/internal/styles"
 	"github.com/rootlyhq/rootly-tui/internal/views"
 )
@@ -30,6 +34,7 @@ type Screen int
 const (
 	ScreenSetup Screen = iota
 	ScreenMain
+	ScreenWatch // Full-screen detail of one incident (--focus)
 )
 
 type Tab int
@@ -37,8 +42,57 @@ type Tab int
 const (
 	TabIncidents Tab = iota
 	TabAlerts
+	TabServices
 )
 
+// next returns the tab after t, wrapping from the last back to incidents
+func (t Tab) next() Tab {
+	if t == TabServices {
+		return TabIncidents
+	}
+	return t + 1
+}
+
+// String returns the tab's name, as parsed by ParseTab
+func (t Tab) String() string {
+	switch t {
+	case TabAlerts:
+		return viewTabAlerts
+	case TabServices:
+		return viewTabServices
+	default:
+		return viewTabIncidents
+	}
+}
+
+// ParseTab parses a tab name as used by --tab and saved views (incidents,
+// alerts or services); ok is false for anything else
+func ParseTab(name string) (tab Tab, ok bool) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case viewTabIncidents:
+		return TabIncidents, true
+	case viewTabAlerts:
+		return TabAlerts, true
+	case viewTabServices:
+		return TabServices, true
+	}
+	return TabIncidents, false
+}
+
+// scopeFilter narrows the incidents list to the user's team or their own incidents
+type scopeFilter int
+
+const (
+	scopeAll    scopeFilter = iota
+	scopeMyTeam             // Incidents of the user's team
+	scopeMine               // Incidents the user created or holds a role in
+)
+
+// next returns the scope that follows s in the all → my team → mine cycle
+func (s scopeFilter) next() scopeFilter {
+	return (s + 1) % 3
+}
+
 // URLOpener is a function type for opening URLs in a browser (injectable for testing)
 type URLOpener func(url string) error
 
@@ -62,10 +116,22 @@ type Model struct {
 	setup     views.SetupModel
 	incidents views.IncidentsModel
 	alerts    views.AlertsModel
+	services  views.ServicesModel
 	help      views.HelpModel
 	logs      views.LogsModel
 	about     views.AboutModel
+	summary   views.SummaryModel
+	intro     views.IntroModel
 	spinner   spinner.Model
+	confirm   *components.ConfirmModel
+	prompt    *components.PromptModel
+
+	// Saved views (w saves, F picks); currentView is the last one saved or applied
+	viewPicker  *components.PickerModel
+	currentView string
+
+	// Config profile picker (P)
+	profilePicker *components.PickerModel
 
 	// Loading state
 	loading        bool
@@ -73,65 +139,280 @@ type Model struct {
 	statusMsg      string
 	errorMsg       string
 
+	// Background requests started but not yet handled (shown in the status bar)
+	inFlight int
+	// Outcome of the latest list request (the header's connection dot)
+	conn connState
+
+	// Incident scope cycled with U; the user and their team are resolved on first use
+	scope     scopeFilter
+	scopeUser *api.User
+	scopeTeam string
+	// Progress of a bulk acknowledge, reset once every result has arrived
+	bulkAck bulkAckState
+
+	// Present mode hides the version, endpoint, emails and links while screensharing
+	presentMode bool
+	// relativeTimes shows detail timestamps as "12m ago" (t)
+	relativeTimes bool
+
+	// In-progress } walk to the last page of a list
+	pageWalk pageWalk
+
+	// Cursor positions from the last run, applied as each list first loads
+	restore state.State
+
+	// Dry-run mode (--dry-run or dry_run config): write actions are logged, not sent
+	dryRun bool
+
+	// Generation of the auto-refresh tick chain (auto_refresh_seconds)
+	autoRefreshGen int
+
+	// Leader sequences (leader_keys): the mappings, the leader waiting for its
+	// second key and the generation of its timeout. leaderBypass is set while a
+	// sequence's action runs as a single key.
+	leaderKeys    map[string]string
+	leaderPending string
+	leaderGen     int
+	leaderBypass  bool
+
+	// Watch mode (--focus): the incident reference and when it was last refreshed
+	focusRef     string
+	watchUpdated time.Time
+	// Change log of the watched incident, oldest first, and how many of the
+	// newest entries are scrolled past in the Updates panel
+	watchLog    []string
+	watchScroll int
+	// Incident to select once the list has loaded (--open), cleared when requested
+	openRef string
+	// Whether an incident jumped to with : is being fetched
+	jumping bool
+	// Incident whose idle prefetch timer is pending, and incidents being prefetched
+	prefetchID  string
+	prefetching map[string]bool
+	// When the displayed incidents and alerts pages were cached (zero when fetched fresh)
+	incidentsCachedAt time.Time
+	alertsCachedAt    time.Time
+
 	// URL opener (injectable for testing)
 	urlOpener URLOpener
 }
 
+// bulkAckState tallies results of an in-progress bulk acknowledge
+type bulkAckState struct {
+	done   int
+	acked  int
+	failed []string // Short IDs of alerts that could not be acknowledged
+}
+
+// hasDarkBackground reports whether the terminal background is dark (stubbed in tests)
+var hasDarkBackground = func() bool {
+	return lipgloss.HasDarkBackground(os.Stdin, os.Stdout)
+}
+
 func New(version string) Model {
+	// Pick the palette before any view captures styles
+	styles.SetTheme(styles.ResolveTheme(configuredTheme(), hasDarkBackground))
+	loadAlertSources()
+
 	s := spinner.New()
 	s.Spinner = spinner.Dot
 	s.Style = styles.Spinner
 
 	m := Model{
-		version:   version,
-		screen:    ScreenSetup,
-		activeTab: TabIncidents,
-		keys:      DefaultKeyMap(),
-		setup:     views.NewSetupModel(),
-		incidents: views.NewIncidentsModel(),
-		alerts:    views.NewAlertsModel(),
-		help:      views.NewHelpModel(),
-		logs:      views.NewLogsModel(),
-		about:     views.NewAboutModel(version),
-		spinner:   s,
-		urlOpener: defaultURLOpener,
-	}
-
-	// Check if config exists
-	if config.Exists() {
-		cfg, err := config.Load()
-		if err == nil && cfg.IsValid() {
-			m.cfg = cfg
-			// Re-initialize setup with config so auth method is preserved
-			m.setup = views.NewSetupModelWithConfig(cfg)
-			// Set language from config
-			if cfg.Language != "" {
-				i18n.SetLanguage(i18n.Language(cfg.Language))
-			}
-			// Set layout from config
-			if cfg.Layout != "" {
-				m.incidents.SetLayout(cfg.Layout)
-				m.alerts.SetLayout(cfg.Layout)
-			}
-			// Create the API client once here
-			client, err := api.NewClient(cfg)
-			if err == nil {
-				m.apiClient = client
-				m.screen = ScreenMain
-				m.initialLoading = true
-			}
-			// If client creation fails, fall through to setup screen
+		version:    version,
+		screen:     ScreenSetup,
+		activeTab:  TabIncidents,
+		keys:       DefaultKeyMap(),
+		leaderKeys: leaderSequences(nil),
+		setup:      views.NewSetupModel(),
+		incidents:  views.NewIncidentsModel(),
+		alerts:     views.NewAlertsModel(),
+		services:   views.NewServicesModel(),
+		help:       views.NewHelpModel(),
+		logs:       views.NewLogsModel(),
+		about:      views.NewAboutModel(version),
+		summary:    views.NewSummaryModel(),
+		intro:      views.NewIntroModel(),
+		spinner:    s,
+		confirm:    components.NewConfirm(),
+		prompt:     components.NewPrompt(),
+		urlOpener:  defaultURLOpener,
+
+		viewPicker:    components.NewPicker(i18n.T("views.picker_title")),
+		profilePicker: components.NewPicker(i18n.T("profiles.picker_title")),
+	}
+
+	// Use the config file, overridden by (or, without a file, taken from)
+	// ROOTLY_API_KEY and ROOTLY_ENDPOINT
+	if cfg, err := config.Resolve(); err != nil {
+		debug.Logger.Info("No usable config, starting setup", "reason", err)
+	} else {
+		// Re-initialize setup with config so auth method is preserved
+		m.setup = views.NewSetupModelWithConfig(cfg)
+		m.applyProfileConfig(cfg)
+		// Create the API client once here
+		client, err := m.newAPIClient(cfg)
+		if err == nil {
+			m.apiClient = client
+			m.screen = ScreenMain
+			m.initialLoading = true
+			m.restoreState()
 		}
+		// If client creation fails, fall through to setup screen
+	}
+
+	// Show the one-time welcome overlay to new users
+	if m.screen == ScreenSetup && !welcomeSeen() {
+		m.intro.Show()
 	}
 
 	return m
 }
 
+// newAPIClient creates the API client for cfg, in dry-run mode when either the
+// config or --dry-run asks for it
+func (m *Model) newAPIClient(cfg *config.Config) (*api.Client, error) {
+	client, err := api.NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	m.dryRun = m.dryRun || cfg.DryRun
+	client.SetDryRun(m.dryRun)
+	m.incidents.SetAPIPageSize(client.PageSize())
+	m.alerts.SetAPIPageSize(client.PageSize())
+	m.services.SetAPIPageSize(client.PageSize())
+	return client, nil
+}
+
+// applyPageSize applies the page_size config to the lists; auto_page_size
+// keeps the size fitted to the terminal instead
+func (m *Model) applyPageSize(cfg *config.Config) {
+	if m.apiClient == nil || cfg.AutoPageSize {
+		return
+	}
+	m.apiClient.SetPageSize(cfg.ListPageSize())
+	m.incidents.SetAPIPageSize(m.apiClient.PageSize())
+	m.alerts.SetAPIPageSize(m.apiClient.PageSize())
+	m.services.SetAPIPageSize(m.apiClient.PageSize())
+}
+
+// SetDryRun makes write actions log the request they would send instead of
+// calling the API, with a banner in the header
+func (m *Model) SetDryRun(enabled bool) {
+	m.dryRun = enabled
+	if m.apiClient != nil {
+		m.apiClient.SetDryRun(enabled)
+	}
+}
+
+// SetStartTab selects the tab shown on startup (--tab); services are then
+// loaded with the rest of the initial data
+func (m *Model) SetStartTab(tab Tab) {
+	m.activeTab = tab
+	if tab == TabServices {
+		m.services.SetLoading(true)
+	}
+}
+
+// welcomeSeen reports whether the welcome overlay was already dismissed.
+// The config may exist without being valid (e.g. setup not finished yet).
+func welcomeSeen() bool {
+	if !config.Exists() {
+		return false
+	}
+	cfg, err := config.Load()
+	return err == nil && cfg.WelcomeSeen
+}
+
+// loadAlertSources merges the icons, codes and names in sources.yaml over the
+// built-in alert sources
+func loadAlertSources() {
+	sources, err := config.LoadSources()
+	if err != nil {
+		debug.Logger.Warn("Failed to load alert sources, using the built-in ones", "path", config.SourcesPath(), "error", err)
+		return
+	}
+	overrides := make(map[string]styles.AlertSource, len(sources))
+	for key, src := range sources {
+		overrides[key] = styles.AlertSource{Icon: src.Icon, Code: src.Code, Name: src.Name}
+	}
+	styles.SetAlertSources(overrides)
+}
+
+// configuredTheme returns the theme from config, or "" (auto) if there is none
+func configuredTheme() string {
+	if !config.Exists() {
+		return ""
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		return ""
+	}
+	return cfg.Theme
+}
+
+// showingIntro reports whether the welcome overlay is on screen.
+// It only applies to the first-run setup, never once a valid config is loaded.
+func (m Model) showingIntro() bool {
+	return m.intro.Visible && m.screen == ScreenSetup && (m.cfg == nil || !m.cfg.IsValid())
+}
+
+// dismissIntro hides the welcome overlay and records it so it doesn't reappear
+func (m *Model) dismissIntro() {
+	m.intro.Hide()
+	cfg, err := config.Load()
+	if err != nil {
+		cfg = &config.Config{}
+	}
+	cfg.WelcomeSeen = true
+	if err := config.Save(cfg); err != nil {
+		debug.Logger.Warn("Failed to record welcome overlay as seen", "error", err)
+	}
+}
+
+// saveAlertsSort records the alerts sort in config so it's restored next launch
+func (m *Model) saveAlertsSort() {
+	sort := m.alerts.SortConfig()
+	if m.cfg != nil {
+		m.cfg.AlertsSort = sort
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		debug.Logger.Warn("Failed to load config to save alerts sort", "error", err)
+		return
+	}
+	cfg.AlertsSort = sort
+	if err := config.Save(cfg); err != nil {
+		debug.Logger.Warn("Failed to save alerts sort", "error", err)
+	}
+}
+
+// saveRelativeTimes records the relative times toggle in config so it's restored next launch
+func (m *Model) saveRelativeTimes() {
+	if m.cfg != nil {
+		m.cfg.RelativeTimes = m.relativeTimes
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		debug.Logger.Warn("Failed to load config to save relative times", "error", err)
+		return
+	}
+	cfg.RelativeTimes = m.relativeTimes
+	if err := config.Save(cfg); err != nil {
+		debug.Logger.Warn("Failed to save relative times", "error", err)
+	}
+}
+
 func (m Model) Init() tea.Cmd {
+	if m.screen == ScreenWatch {
+		return tea.Batch(m.spinner.Tick, m.loadFocusIncident())
+	}
 	if m.screen == ScreenMain {
 		return tea.Batch(
 			m.spinner.Tick,
 			m.loadData(),
+			m.scheduleAutoRefresh(),
 		)
 	}
 	return m.setup.Init()
@@ -140,8 +421,44 @@ func (m Model) Init() tea.Cmd {
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	m.finishBackground(msg)
+
 	switch msg := msg.(type) {
+	case BackgroundStartedMsg:
+		m.inFlight++
+		return m, nil
+
 	case tea.KeyPressMsg:
+		if m.screen == ScreenWatch {
+			return m.updateWatchKey(msg)
+		}
+
+		// Any key other than quit dismisses the first-run welcome overlay
+		if m.showingIntro() && !key.Matches(msg, m.keys.Quit) {
+			m.dismissIntro()
+			return m, nil
+		}
+
+		// Confirmation prompt captures the next key (y confirms, anything else cancels)
+		if m.confirm.IsVisible() {
+			return m, m.confirm.HandleKey(msg.String())
+		}
+
+		// Text prompt captures typing until enter or esc
+		if m.prompt.IsVisible() {
+			return m, m.prompt.HandleKey(msg)
+		}
+
+		// Search prompt captures typing, filtering the incidents as the query changes
+		if m.screen == ScreenMain && m.activeTab == TabIncidents && m.incidents.IsSearching() {
+			prevID := m.selectedID()
+			cmds = append(cmds, m.incidents.HandleSearchKey(msg))
+			if id := m.selectedID(); id != "" && id != prevID {
+				cmds = append(cmds, m.scheduleDetailFetch(id))
+			}
+			return m, tea.Batch(cmds...)
+		}
+
 		// Handle quit/escape - if on setup screen with valid config, return to main instead of exiting
 		if key.Matches(msg, m.keys.Quit) || (m.screen == ScreenSetup && msg.String() == "esc") {
 			if m.screen == ScreenSetup && m.cfg != nil && m.cfg.IsValid() {
@@ -154,7 +471,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Handle logs overlay first
 		if m.logs.Visible {
-			if key.Matches(msg, m.keys.Logs) || msg.String() == "esc" {
+			if (key.Matches(msg, m.keys.Logs) || msg.String() == "esc") && !m.logs.CapturesKey(msg) {
 				m.logs.Toggle()
 				return m, nil
 			}
@@ -169,6 +486,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.about.Toggle()
 				return m, nil
 			}
+			if key.Matches(msg, m.keys.Copy) {
+				// Copy the config for a support ticket, closing the dialog so the status shows
+				m.copyRedactedConfig()
+				m.about.Hide()
+			}
+			return m, nil
+		}
+
+		// Handle summary overlay
+		if m.summary.Visible {
+			if key.Matches(msg, m.keys.Summary) || msg.String() == "esc" {
+				m.summary.Toggle()
+			}
 			return m, nil
 		}
 
@@ -190,6 +520,33 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, nil
 		}
+		if m.activeTab == TabAlerts && m.alerts.IsSortMenuVisible() {
+			if m.alerts.HandleSortMenuKey(msg.String()) {
+				// Re-sorted in place; remember the choice for next time
+				m.saveAlertsSort()
+			}
+			return m, nil
+		}
+
+		// Handle saved view picker
+		if m.viewPicker.IsVisible() {
+			return m, m.handleViewPickerKey(msg.String())
+		}
+
+		// Handle profile picker
+		if m.profilePicker.IsVisible() {
+			return m, m.handleProfilePickerKey(msg.String())
+		}
+
+		// Handle team picker
+		if m.activeTab == TabIncidents && m.incidents.IsTeamPickerVisible() {
+			if m.incidents.HandleTeamPickerKey(msg.String()) {
+				// The API filters by team; reload from the first page
+				m.incidents.SetLoading(true)
+				return m, m.loadIncidents()
+			}
+			return m, nil
+		}
 
 		// Handle setup screen
 		if m.screen == ScreenSetup {
@@ -199,6 +556,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Batch(cmds...)
 		}
 
+		// Leader sequences (g then t, "," then o, ...)
+		if !m.leaderBypass {
+			if model, cmd, handled := m.handleLeaderKey(msg); handled {
+				return model, cmd
+			}
+		}
+
 		// Handle main screen navigation
 		switch {
 		case key.Matches(msg, m.keys.Help):
@@ -216,6 +580,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.about.Toggle()
 			return m, nil
 
+		case key.Matches(msg, m.keys.Summary):
+			m.summary.Toggle()
+			m.summary.SetLoading(true)
+			return m, m.loadIncidentSummary()
+
 		case key.Matches(msg, m.keys.Setup):
 			// Reset to setup screen with existing config
 			m.screen = ScreenSetup
@@ -227,10 +596,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Clear focus when switching tabs
 			m.incidents.SetDetailFocused(false)
 			m.alerts.SetDetailFocused(false)
-			if m.activeTab == TabIncidents {
-				m.activeTab = TabAlerts
-			} else {
-				m.activeTab = TabIncidents
+			m.services.SetDetailFocused(false)
+			m.prefetchID = ""
+			m.activeTab = m.activeTab.next()
+			// Services are loaded the first time their tab is shown
+			if m.activeTab == TabServices && !m.services.Requested() {
+				m.services.SetLoading(true)
+				return m, tea.Batch(m.spinner.Tick, m.loadServices())
 			}
 			return m, nil
 
@@ -254,6 +626,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.alerts.SetLoading(true)
 				m.loading = true
 				return m, tea.Batch(m.spinner.Tick, m.loadAlerts())
+			} else if m.activeTab == TabServices && m.services.HasPrevPage() {
+				m.services.PrevPage()
+				m.services.SetLoading(true)
+				m.loading = true
+				return m, tea.Batch(m.spinner.Tick, m.loadServices())
 			}
 			return m, nil
 
@@ -268,9 +645,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.alerts.SetLoading(true)
 				m.loading = true
 				return m, tea.Batch(m.spinner.Tick, m.loadAlerts())
+			} else if m.activeTab == TabServices && m.services.HasNextPage() {
+				m.services.NextPage()
+				m.services.SetLoading(true)
+				m.loading = true
+				return m, tea.Batch(m.spinner.Tick, m.loadServices())
 			}
 			return m, nil
 
+		case key.Matches(msg, m.keys.FirstPage):
+			return m.firstPage()
+
+		case key.Matches(msg, m.keys.LastPage):
+			return m.lastPage()
+
 		case key.Matches(msg, m.keys.Enter):
 			// Fetch detailed data for selected item, or focus detail pane for scrolling if already loaded
 			if m.activeTab == TabIncidents {
@@ -278,12 +666,28 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if inc != nil {
 					if !inc.DetailLoaded {
 						m.incidents.SetDetailLoading(inc.ID)
+						if m.prefetching[inc.ID] {
+							// Already being prefetched; its result finishes this load
+							return m, m.spinner.Tick
+						}
 						return m, tea.Batch(m.spinner.Tick, m.loadIncidentDetail(inc.ID, inc.UpdatedAt, m.incidents.SelectedIndex()))
 					}
 					// Detail already loaded, focus the detail pane for scrolling
-					m.incidents.SetDetailFocused(true)
+					return m, m.focusIncidentDetail()
+				}
+			} else if m.activeTab == TabServices {
+				// Services are listed in full, so Enter only focuses the detail pane
+				if m.services.SelectedService() != nil {
+					m.services.SetDetailFocused(true)
 				}
 			} else {
+				if ref := m.alerts.SelectedGroupIncident(); ref != "" {
+					// Group header: jump to the incident on the incidents tab
+					m.alerts.SetDetailFocused(false)
+					m.activeTab = TabIncidents
+					m.statusMsg = i18n.Tf("alerts.group.opening", map[string]any{"ID": ref})
+					return m, m.loadOpenIncident(ref)
+				}
 				alert := m.alerts.SelectedAlert()
 				if alert != nil {
 					if !alert.DetailLoaded {
@@ -296,180 +700,541 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, nil
 
-		case key.Matches(msg, m.keys.Open):
-			// Open URL in browser
-			var url string
-			if m.activeTab == TabIncidents {
-				inc := m.incidents.SelectedIncident()
-				if inc != nil {
-					// Prefer short URL, then URL, then construct from ID
-					if inc.ShortURL != "" {
-						url = inc.ShortURL
-					} else if inc.URL != "" {
-						url = inc.URL
-					} else if inc.ID != "" {
-						url = fmt.Sprintf("https://rootly.com/account/incidents/%s", inc.ID)
-					}
-				}
+		case key.Matches(msg, m.keys.GroupAlerts):
+			if m.activeTab != TabAlerts {
+				return m, nil
+			}
+			if m.alerts.ToggleGroupByIncident() {
+				m.statusMsg = i18n.T("alerts.group.on")
 			} else {
-				alert := m.alerts.SelectedAlert()
-				if alert != nil {
-					// Construct URL from short ID
-					if alert.ShortID != "" {
-						url = fmt.Sprintf("https://rootly.com/account/alerts/%s", alert.ShortID)
-					}
-				}
+				m.statusMsg = i18n.T("alerts.group.off")
 			}
-			if url != "" && m.urlOpener != nil {
-				_ = m.urlOpener(url)
+			return m, nil
+
+		case key.Matches(msg, m.keys.GroupServices):
+			if m.activeTab != TabAlerts {
+				return m, nil
+			}
+			if m.alerts.ToggleGroupByService() {
+				m.statusMsg = i18n.T("alerts.group.service_on")
+			} else {
+				m.statusMsg = i18n.T("alerts.group.off")
 			}
 			return m, nil
 
-		case key.Matches(msg, m.keys.Sort):
-			// Toggle sort menu for incidents tab
-			if m.activeTab == TabIncidents {
-				m.incidents.ToggleSortMenu()
+		case key.Matches(msg, m.keys.CollapseGroup):
+			if m.activeTab != TabAlerts {
+				return m, nil
+			}
+			if !m.alerts.ToggleGroupCollapsed() {
+				m.statusMsg = i18n.T("alerts.group.collapse_hint")
 			}
 			return m, nil
 
-		case key.Matches(msg, m.keys.Copy):
-			// Copy detail panel to clipboard
-			var text string
-			if m.activeTab == TabIncidents {
-				text = m.incidents.GetDetailPlainText()
-			} else {
-				text = m.alerts.GetDetailPlainText()
+		case key.Matches(msg, m.keys.Expand):
+			// Show the full values of the selected alert's truncated labels, or truncate them again
+			if m.activeTab != TabAlerts {
+				return m, nil
 			}
-			if text != "" {
-				if err := clipboard.Init(); err != nil {
-					debug.Logger.Error("Failed to initialize clipboard", "error", err)
-					m.statusMsg = i18n.T("logs.clipboard_unavailable")
-				} else {
-					clipboard.Write(clipboard.FmtText, []byte(text))
-					m.statusMsg = i18n.T("logs.copied")
-				}
+			if !m.alerts.ToggleLabelsExpanded() {
+				m.statusMsg = i18n.T("alerts.no_truncated_labels")
 			}
 			return m, nil
 
-		default:
-			// Pass key events to active view
-			if m.activeTab == TabIncidents {
-				var cmd tea.Cmd
-				m.incidents, cmd = m.incidents.Update(msg)
-				cmds = append(cmds, cmd)
-			} else {
-				var cmd tea.Cmd
-				m.alerts, cmd = m.alerts.Update(msg)
-				cmds = append(cmds, cmd)
+		case key.Matches(msg, m.keys.Runbook):
+			// Open the selected incident's runbook (runbook_url label, else first summary link)
+			if m.activeTab != TabIncidents {
+				return m, nil
 			}
-		}
+			inc := m.incidents.SelectedIncident()
+			if inc == nil {
+				return m, nil
+			}
+			url := inc.RunbookURL()
+			if url == "" {
+				m.statusMsg = i18n.T("incidents.no_runbook")
+				return m, nil
+			}
+			if m.urlOpener != nil {
+				_ = m.urlOpener(url)
+			}
+			return m, nil
 
-	case tea.WindowSizeMsg:
-		m.width = msg.Width
-		m.height = msg.Height
-		m.setup.SetDimensions(msg.Width, msg.Height)
-		m.incidents.SetDimensions(msg.Width-4, msg.Height-10)
-		m.alerts.SetDimensions(msg.Width-4, msg.Height-10)
-		m.logs.SetDimensions(msg.Width, msg.Height)
-		return m, nil
+		case key.Matches(msg, m.keys.Open):
+			// Open URL in browser
+			if url := m.selectedURL(); url != "" && m.urlOpener != nil {
+				_ = m.urlOpener(url)
+			}
+			return m, nil
 
-	case tea.MouseMsg:
-		// Forward mouse events to logs view when visible
-		if m.logs.Visible {
-			var cmd tea.Cmd
-			m.logs, cmd = m.logs.Update(msg)
-			cmds = append(cmds, cmd)
-			return m, tea.Batch(cmds...)
-		}
-		// Forward mouse events to active view for viewport scrolling
-		if m.screen == ScreenMain && !m.help.Visible {
+		case key.Matches(msg, m.keys.CopyURL):
+			// Copy the Rootly URL that o would open
+			url := m.selectedURL()
+			if url == "" {
+				return m, nil
+			}
+			if m.copyToClipboard(url) {
+				m.statusMsg = i18n.T("common.url_copied")
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Search):
 			if m.activeTab == TabIncidents {
-				var cmd tea.Cmd
-				m.incidents, cmd = m.incidents.Update(msg)
-				cmds = append(cmds, cmd)
-			} else {
-				var cmd tea.Cmd
-				m.alerts, cmd = m.alerts.Update(msg)
-				cmds = append(cmds, cmd)
+				return m, m.incidents.StartSearch()
 			}
-		}
-		return m, tea.Batch(cmds...)
+			return m, nil
 
-	case spinner.TickMsg:
-		// Only continue spinner when actually loading
-		if m.loading || m.initialLoading || m.incidents.IsDetailLoading() || m.alerts.IsDetailLoading() {
-			var cmd tea.Cmd
-			m.spinner, cmd = m.spinner.Update(msg)
-			cmds = append(cmds, cmd)
-		}
+		case key.Matches(msg, m.keys.JumpTo):
+			return m, m.askJumpToIncident()
 
-	// Setup screen messages
-	case views.OAuthLoginResultMsg:
-		var cmd tea.Cmd
-		m.setup, cmd = m.setup.Update(msg)
-		return m, cmd
+		case key.Matches(msg, m.keys.AddNote):
+			return m, m.askIncidentNote()
 
-	case views.OAuthLogoutResultMsg:
-		m.setup, _ = m.setup.Update(msg)
-		return m, nil
+		case key.Matches(msg, m.keys.SaveView):
+			return m, m.askSaveView()
 
-	case views.APIKeyValidatedMsg:
-		m.setup.HandleValidationResult(msg)
-		m.setup.SetTesting(false)
-		if msg.Valid && m.setup.IsFirstRun() {
-			// Auto-save and proceed on first-run
-			return m, m.setup.DoSaveConnection()
-		}
-		return m, nil
+		case key.Matches(msg, m.keys.Views):
+			m.openViewPicker()
+			return m, nil
 
-	case views.ConfigSavedMsg:
-		if msg.Success {
-			// Config saved, load it and switch to main screen
-			cfg, err := config.Load()
-			if err == nil && cfg.IsValid() {
-				m.cfg = cfg
-				// Update language from saved config
-				if cfg.Language != "" {
-					i18n.SetLanguage(i18n.Language(cfg.Language))
+		case key.Matches(msg, m.keys.Profiles):
+			m.openProfilePicker()
+			return m, nil
+
+		case key.Matches(msg, m.keys.Sort):
+			switch m.activeTab {
+			case TabIncidents:
+				m.incidents.ToggleSortMenu()
+			case TabAlerts:
+				m.alerts.ToggleSortMenu()
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Reopen):
+			// Reopen is only offered for resolved/closed incidents, after confirmation
+			if m.activeTab == TabIncidents {
+				inc := m.incidents.SelectedIncident()
+				if inc == nil {
+					return m, nil
 				}
-				// Update layout from saved config
-				if cfg.Layout != "" {
-					m.incidents.SetLayout(cfg.Layout)
-					m.alerts.SetLayout(cfg.Layout)
+				if !inc.IsResolved() {
+					m.statusMsg = i18n.T("incidents.reopen_not_resolved")
+					return m, nil
 				}
-				client, err := api.NewClient(cfg)
-				if err == nil {
-					m.apiClient = client
-					m.screen = ScreenMain
-					m.initialLoading = true
-					return m, tea.Batch(m.spinner.Tick, m.loadData())
+				prompt := i18n.Tf("incidents.reopen_confirm", map[string]any{"ID": inc.SequentialID})
+				return m, m.confirmWrite(prompt, m.reopenIncident(inc.ID, m.incidents.SelectedIndex()))
+			}
+			// On alerts, R resolves the selected alert unless it's already resolved
+			if m.activeTab == TabAlerts {
+				alert := m.alerts.SelectedAlert()
+				if alert == nil {
+					return m, nil
 				}
+				if strings.EqualFold(alert.Status, api.AlertStatusResolved) {
+					m.statusMsg = i18n.T("alerts.resolve_already")
+					return m, nil
+				}
+				prompt := i18n.Tf("alerts.resolve_confirm", map[string]any{"ID": alertRef(alert)})
+				return m, m.confirmWrite(prompt, m.updateAlertStatus(*alert, m.alerts.SelectedIndex(), api.AlertStatusResolved))
 			}
-		}
-		return m, nil
+			return m, nil
 
-	case views.ConnectionSavedMsg:
-		m.setup.HandleConnectionSaved(msg)
-		if msg.Success {
-			// Connection saved, load it and switch to main screen
-			cfg, err := config.Load()
-			if err == nil && cfg.IsValid() {
-				m.cfg = cfg
-				// Update language from saved config
-				if cfg.Language != "" {
-					i18n.SetLanguage(i18n.Language(cfg.Language))
+		case key.Matches(msg, m.keys.AckIncident):
+			// Acknowledge the selected incident unless it's already acknowledged or over
+			if m.activeTab == TabAlerts {
+				// Only triggered alerts can be acknowledged
+				alert := m.alerts.SelectedAlert()
+				if alert == nil {
+					return m, nil
 				}
-				// Update layout from saved config
-				if cfg.Layout != "" {
-					m.incidents.SetLayout(cfg.Layout)
-					m.alerts.SetLayout(cfg.Layout)
+				if !strings.EqualFold(alert.Status, api.AlertStatusTriggered) {
+					m.statusMsg = i18n.Tf("alerts.ack_not_triggered", map[string]any{"Status": alert.Status})
+					return m, nil
 				}
-				client, err := api.NewClient(cfg)
+				return m, m.updateAlertStatus(*alert, m.alerts.SelectedIndex(), api.AlertStatusAcknowledged)
+			}
+			if m.activeTab != TabIncidents {
+				return m, nil
+			}
+			inc := m.incidents.SelectedIncident()
+			if inc == nil {
+				return m, nil
+			}
+			if inc.IsResolved() || strings.EqualFold(inc.Status, api.IncidentStatusAcknowledged) {
+				m.statusMsg = i18n.Tf("incidents.ack_not_open", map[string]any{"Status": inc.Status})
+				return m, nil
+			}
+			m.statusMsg = i18n.T("incidents.acknowledging")
+			return m, m.acknowledgeIncident(inc.ID, m.incidents.SelectedIndex())
+
+		case key.Matches(msg, m.keys.NextNeedsAck):
+			// Jump to the next open incident nobody has acknowledged yet
+			if m.activeTab != TabIncidents {
+				return m, nil
+			}
+			prevID := m.selectedID()
+			if !m.incidents.JumpToNextNeedsAck() {
+				m.statusMsg = i18n.T("incidents.needs_ack_none")
+				return m, nil
+			}
+			if id := m.selectedID(); id != "" && id != prevID {
+				return m, m.scheduleDetailFetch(id)
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.StatusFilter):
+			// Cycle all → active → resolved over the loaded page
+			if m.activeTab == TabIncidents {
+				m.incidents.CycleStatusFilter()
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.SeverityFilter):
+			// Cycle all → critical → high+ → medium+ over the loaded page
+			if m.activeTab == TabIncidents {
+				m.incidents.CycleSeverityFilter()
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.AckAll):
+			// Acknowledge every triggered alert on the page, after confirmation
+			if m.activeTab != TabAlerts {
+				return m, nil
+			}
+			triggered := m.alerts.TriggeredAlerts()
+			if len(triggered) == 0 {
+				m.statusMsg = i18n.T("alerts.ack_all_none")
+				return m, nil
+			}
+			prompt := i18n.Tf("alerts.ack_all_confirm", map[string]any{"Count": len(triggered)})
+			return m, m.confirmWrite(prompt, m.acknowledgeAlerts(triggered))
+
+		case key.Matches(msg, m.keys.Team):
+			// Open team picker for incidents tab, seeded from loaded incidents until the API list arrives
+			if m.activeTab == TabIncidents {
+				m.incidents.SetTeamOptions(m.incidents.LoadedTeams())
+				m.incidents.ToggleTeamPicker()
+				if m.incidents.IsTeamPickerVisible() {
+					return m, m.loadTeams()
+				}
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.OnCall):
+			// Toggle the on-call filter; scopes are resolved once per session
+			if m.activeTab == TabIncidents {
+				if m.incidents.IsOnCallOnly() {
+					m.incidents.SetOnCallOnly(false)
+					return m, nil
+				}
+				if scopes := m.incidents.OnCallScopes(); scopes != nil {
+					m.applyOnCallFilter(scopes)
+					return m, nil
+				}
+				m.statusMsg = i18n.T("oncall.resolving")
+				return m, m.loadOnCallScopes()
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Scope):
+			// Cycle all → my team → mine
+			if m.activeTab != TabIncidents {
+				return m, nil
+			}
+			if m.scopeUser == nil {
+				m.statusMsg = i18n.T("scope.resolving")
+				return m, m.resolveScope()
+			}
+			return m, m.applyScope(m.scope.next())
+
+		case key.Matches(msg, m.keys.ToggleID):
+			// Switch between sequential and opaque incident IDs
+			if m.activeTab == TabIncidents {
+				m.incidents.ToggleOpaqueID()
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Present):
+			m.presentMode = !m.presentMode
+			m.incidents.SetPresentMode(m.presentMode)
+			m.alerts.SetPresentMode(m.presentMode)
+			if m.presentMode {
+				m.statusMsg = i18n.T("present.on")
+			} else {
+				m.statusMsg = i18n.T("present.off")
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.RelativeTimes):
+			m.relativeTimes = !m.relativeTimes
+			m.incidents.SetRelativeTimes(m.relativeTimes)
+			m.alerts.SetRelativeTimes(m.relativeTimes)
+			m.saveRelativeTimes()
+			if m.relativeTimes {
+				m.statusMsg = i18n.T("times.relative")
+			} else {
+				m.statusMsg = i18n.T("times.absolute")
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Copy):
+			// Copy detail panel to clipboard
+			var text string
+			switch m.activeTab {
+			case TabIncidents:
+				text = m.incidents.GetDetailPlainText()
+			case TabAlerts:
+				text = m.alerts.GetDetailPlainText()
+			case TabServices:
+				text = m.services.GetDetailPlainText()
+			}
+			if text != "" {
+				m.copyToClipboard(text)
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.CopyContact):
+			// Copy the commander's (or communications lead's) contact card
+			if m.activeTab != TabIncidents {
+				return m, nil
+			}
+			card := m.incidents.SelectedContactCard()
+			if card == "" {
+				m.statusMsg = i18n.T("contact.none")
+				return m, nil
+			}
+			if m.copyToClipboard(card) {
+				m.statusMsg = i18n.Tf("contact.copied", map[string]any{"Card": card})
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.CopySlack):
+			// Copy the selected incident formatted as Slack mrkdwn
+			if m.activeTab != TabIncidents {
+				return m, nil
+			}
+			inc := m.incidents.SelectedIncident()
+			if inc == nil {
+				return m, nil
+			}
+			if m.copyToClipboard(inc.ToSlackMrkdwn()) {
+				m.statusMsg = i18n.Tf("incidents.copied_slack", map[string]any{"ID": inc.SequentialID})
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.CopyDetail):
+			// Copy the selected incident's detail pane as plain text
+			if m.activeTab != TabIncidents {
+				return m, nil
+			}
+			inc := m.incidents.SelectedIncident()
+			if inc == nil {
+				return m, nil
+			}
+			if m.copyToClipboard(m.incidents.SelectedDetailText()) {
+				m.statusMsg = i18n.Tf("incidents.copied_detail", map[string]any{"ID": inc.SequentialID})
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.CopyMarkdown):
+			// Copy the selected incident as a Markdown document
+			if m.activeTab != TabIncidents {
+				return m, nil
+			}
+			inc := m.incidents.SelectedIncident()
+			if inc == nil {
+				return m, nil
+			}
+			if m.copyToClipboard(inc.ToMarkdown()) {
+				m.statusMsg = i18n.Tf("incidents.copied_markdown", map[string]any{"ID": inc.SequentialID})
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.StatusUpdate):
+			// Copy the selected incident rendered through the status update template
+			if m.activeTab != TabIncidents || m.cfg == nil {
+				return m, nil
+			}
+			inc := m.incidents.SelectedIncident()
+			if inc == nil {
+				return m, nil
+			}
+			text, err := m.statusUpdate(inc)
+			if err != nil {
+				m.errorMsg = i18n.Tf("incidents.status_update_failed", map[string]any{"Error": err.Error()})
+				return m, nil
+			}
+			if m.copyToClipboard(text) {
+				m.statusMsg = i18n.Tf("incidents.copied_status_update", map[string]any{"ID": inc.SequentialID})
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Permalink):
+			// Copy a command line that reopens the selected incident
+			cmdline := m.permalinkCommand()
+			if cmdline == "" {
+				m.statusMsg = i18n.T("permalink.none")
+				return m, nil
+			}
+			if m.copyToClipboard(cmdline) {
+				m.statusMsg = i18n.Tf("permalink.copied", map[string]any{"Command": cmdline})
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.CopyServices):
+			// Copy the affected services of the selected incident or alert, for impact comms
+			services := m.selectedServices()
+			if len(services) == 0 {
+				m.statusMsg = i18n.T("services.none")
+				return m, nil
+			}
+			if m.copyToClipboard(strings.Join(services, ", ")) {
+				m.statusMsg = i18n.Tf("services.copied", map[string]any{"Count": len(services)})
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.CopyTable):
+			// Copy the visible (filtered) incidents as a Markdown table for reports
+			if m.activeTab != TabIncidents {
+				return m, nil
+			}
+			incs := m.incidents.Incidents()
+			if len(incs) == 0 {
+				return m, nil
+			}
+			if m.copyToClipboard(api.IncidentsToMarkdownTable(incs)) {
+				m.statusMsg = i18n.Tf("incidents.copied_table", map[string]any{"Count": len(incs)})
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.ExportHTML):
+			// Save the selected incident's detail as a self-contained HTML file
+			if m.activeTab != TabIncidents {
+				return m, nil
+			}
+			inc := m.incidents.SelectedIncident()
+			if inc == nil {
+				return m, nil
+			}
+			path, err := saveIncidentHTML(inc, time.Now())
+			if err != nil {
+				debug.Logger.Error("Failed to save HTML snapshot", "error", err)
+				m.statusMsg = ""
+				m.errorMsg = i18n.Tf("incidents.snapshot_failed", map[string]any{"Error": err.Error()})
+				return m, nil
+			}
+			m.statusMsg = i18n.Tf("incidents.snapshot_saved", map[string]any{"Path": path})
+			return m, nil
+
+		case key.Matches(msg, m.keys.CopyJSON):
+			// Copy the raw JSON of the selected item's detail response (debug mode only)
+			body := m.selectedResponseBody()
+			if len(body) == 0 {
+				m.statusMsg = i18n.T("debug.no_response")
+				return m, nil
+			}
+			m.copyToClipboard(debug.PrettyJSON(body))
+			return m, nil
+
+		default:
+			// Pass key events to active view
+			prevID := m.selectedID()
+			cmds = append(cmds, m.updateActiveView(msg))
+			// Infinite scroll: reaching the last row fetches the next page to append
+			if m.activeTab == TabIncidents && m.cfg != nil && m.cfg.InfiniteScroll &&
+				key.Matches(msg, m.keys.Down) && m.incidents.AtLastRow() && m.incidents.BeginAppend() {
+				m.statusMsg = i18n.T("incidents.loading_more")
+				cmds = append(cmds, m.loadMoreIncidents())
+			}
+			// Schedule a debounced detail fetch when the selection changed
+			if id := m.selectedID(); id != "" && id != prevID {
+				cmds = append(cmds, m.scheduleDetailFetch(id))
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.setup.SetDimensions(msg.Width, msg.Height)
+		m.incidents.SetDimensions(msg.Width-4, m.listHeight())
+		m.alerts.SetDimensions(msg.Width-4, m.listHeight())
+		m.services.SetDimensions(msg.Width-4, m.listHeight())
+		m.logs.SetDimensions(msg.Width, msg.Height)
+		return m, m.applyAutoPageSize()
+
+	case tea.MouseMsg:
+		// Forward mouse events to logs view when visible
+		if m.logs.Visible {
+			var cmd tea.Cmd
+			m.logs, cmd = m.logs.Update(msg)
+			cmds = append(cmds, cmd)
+			return m, tea.Batch(cmds...)
+		}
+		// Forward mouse events to active view for viewport scrolling
+		if m.screen == ScreenMain && !m.help.Visible {
+			cmds = append(cmds, m.updateActiveView(msg))
+		}
+		return m, tea.Batch(cmds...)
+
+	case spinner.TickMsg:
+		// Only continue spinner when actually loading
+		if m.loading || m.initialLoading || m.incidents.IsDetailLoading() || m.alerts.IsDetailLoading() {
+			var cmd tea.Cmd
+			m.spinner, cmd = m.spinner.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+
+	// Setup screen messages
+	case views.OAuthLoginResultMsg:
+		var cmd tea.Cmd
+		m.setup, cmd = m.setup.Update(msg)
+		return m, cmd
+
+	case views.OAuthLogoutResultMsg:
+		m.setup, _ = m.setup.Update(msg)
+		return m, nil
+
+	case views.APIKeyValidatedMsg:
+		m.setup.HandleValidationResult(msg)
+		m.setup.SetTesting(false)
+		if msg.Valid && m.setup.IsFirstRun() {
+			// Auto-save and proceed on first-run
+			return m, m.setup.DoSaveConnection()
+		}
+		return m, nil
+
+	case views.ConfigSavedMsg:
+		if msg.Success {
+			// Config saved, load it and switch to main screen
+			cfg, err := config.Resolve()
+			if err == nil && cfg.IsValid() {
+				m.applyProfileConfig(cfg)
+				client, err := m.newAPIClient(cfg)
+				if err == nil {
+					m.apiClient = client
+					m.conn = connLoading
+					m.screen = ScreenMain
+					m.initialLoading = true
+					return m, tea.Batch(m.spinner.Tick, m.loadData(), m.restartAutoRefresh())
+				}
+			}
+		}
+		return m, nil
+
+	case views.ConnectionSavedMsg:
+		m.setup.HandleConnectionSaved(msg)
+		if msg.Success {
+			// Connection saved, load it and switch to main screen
+			cfg, err := config.Resolve()
+			if err == nil && cfg.IsValid() {
+				m.applyProfileConfig(cfg)
+				client, err := m.newAPIClient(cfg)
 				if err == nil {
 					m.apiClient = client
+					m.conn = connLoading
 					m.screen = ScreenMain
 					m.initialLoading = true
-					return m, tea.Batch(m.spinner.Tick, m.loadData())
+					return m, tea.Batch(m.spinner.Tick, m.loadData(), m.restartAutoRefresh())
 				}
 			}
 		}
@@ -479,18 +1244,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.setup.HandlePreferencesSaved(msg)
 		if msg.Success {
 			// Preferences saved, update settings but stay on setup screen
-			cfg, err := config.Load()
-			if err == nil {
-				m.cfg = cfg
-				// Update language from saved config
-				if cfg.Language != "" {
-					i18n.SetLanguage(i18n.Language(cfg.Language))
-				}
-				// Update layout from saved config
-				if cfg.Layout != "" {
-					m.incidents.SetLayout(cfg.Layout)
-					m.alerts.SetLayout(cfg.Layout)
-				}
+			// (credentials may not be set up yet)
+			if cfg, _ := config.Resolve(); cfg != nil {
+				m.applyProfileConfig(cfg)
+				m.applyPageSize(cfg)
 			}
 		}
 		return m, nil
@@ -508,6 +1265,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case IncidentsLoadedMsg:
 		m.loading = false
 		m.initialLoading = false
+		m.recordListResult(msg.Err)
 		if msg.Err != nil {
 			if m.handleOAuthExpired(msg.Err) {
 				return m, m.setup.Init()
@@ -516,36 +1274,156 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.incidents.SetError(msg.Err.Error())
 		} else {
 			m.incidents.SetIncidents(msg.Incidents, msg.Pagination)
+			m.incidentsCachedAt = cachedAt(msg.CachedAge)
+			if m.restore.IncidentIndex > 0 {
+				m.incidents.SelectIndex(m.restore.IncidentIndex)
+				m.restore.IncidentIndex = 0
+			}
 			m.errorMsg = ""
 			m.statusMsg = ""
+			// Select the incident requested with --open now that the list is in place
+			if m.openRef != "" {
+				ref := m.openRef
+				m.openRef = ""
+				m.activeTab = TabIncidents
+				return m, m.loadOpenIncident(ref)
+			}
+			if m.incidents.HasNewBadges() {
+				model, cmd := m.pageLoaded(TabIncidents, nil)
+				return model, tea.Batch(cmd, scheduleNewBadgesExpiry())
+			}
+		}
+		return m.pageLoaded(TabIncidents, msg.Err)
+
+	case NewBadgesExpireMsg:
+		m.incidents.ExpireNewBadges(time.Now())
+		return m, nil
+
+	case SaveViewMsg:
+		m.saveView(msg.Name)
+		return m, nil
+
+	case OpenIncidentLoadedMsg:
+		if msg.Err != nil {
+			if m.handleOAuthExpired(msg.Err) {
+				return m, m.setup.Init()
+			}
+			m.errorMsg = msg.Err.Error()
+			return m, nil
+		}
+		m.statusMsg = ""
+		m.incidents.ShowIncident(*msg.Incident)
+		return m, nil
+
+	case FocusIncidentLoadedMsg:
+		return m.handleFocusIncidentLoaded(msg)
+
+	case PostIncidentNoteMsg:
+		m.statusMsg = i18n.Tf("incidents.note_posting", map[string]any{"ID": msg.SequentialID})
+		return m, m.postIncidentNote(msg)
+
+	case IncidentNotePostedMsg:
+		return m.handleIncidentNotePosted(msg)
+
+	case IncidentEventsLoadedMsg:
+		return m.handleIncidentEventsLoaded(msg)
+
+	case JumpToIncidentMsg:
+		return m.jumpToIncident(msg.Ref)
+
+	case JumpIncidentLoadedMsg:
+		return m.handleJumpIncidentLoaded(msg)
+
+	case AutoRefreshTickMsg:
+		return m.handleAutoRefreshTick(msg)
+
+	case LeaderTimeoutMsg:
+		return m.handleLeaderTimeout(msg)
+
+	case WatchTickMsg:
+		if m.screen != ScreenWatch {
+			return m, nil
+		}
+		return m, m.loadFocusIncident()
+
+	case IncidentsAppendedMsg:
+		m.recordListResult(msg.Err)
+		if msg.Err != nil {
+			// Leave the loaded rows alone; scrolling down again retries
+			m.incidents.CancelAppend()
+			if m.handleOAuthExpired(msg.Err) {
+				return m, m.setup.Init()
+			}
+			m.statusMsg = ""
+			m.errorMsg = msg.Err.Error()
+			return m, nil
 		}
+		m.incidents.AppendIncidents(msg.Incidents, msg.Pagination)
+		m.errorMsg = ""
+		m.statusMsg = ""
 		return m, nil
 
 	case AlertsLoadedMsg:
+		m.loading = false
+		m.recordListResult(msg.Err)
 		if msg.Err != nil {
 			if m.handleOAuthExpired(msg.Err) {
 				return m, m.setup.Init()
 			}
+			m.errorMsg = msg.Err.Error()
 			m.alerts.SetError(msg.Err.Error())
 		} else {
 			m.alerts.SetAlerts(msg.Alerts, msg.Pagination)
+			m.alertsCachedAt = cachedAt(msg.CachedAge)
+			if m.restore.AlertIndex > 0 {
+				m.alerts.SelectIndex(m.restore.AlertIndex)
+				m.restore.AlertIndex = 0
+			}
 		}
-		return m, nil
+		return m.pageLoaded(TabAlerts, msg.Err)
 
-	case IncidentDetailLoadedMsg:
-		m.incidents.ClearDetailLoading()
+	case ServicesLoadedMsg:
+		m.loading = false
+		m.recordListResult(msg.Err)
 		if msg.Err != nil {
 			if m.handleOAuthExpired(msg.Err) {
 				return m, m.setup.Init()
 			}
 			m.errorMsg = msg.Err.Error()
-		} else if msg.Incident != nil {
-			m.incidents.UpdateIncidentDetail(msg.Index, msg.Incident)
-			m.errorMsg = ""
-			// Auto-focus detail pane for scrolling after load completes
-			m.incidents.SetDetailFocused(true)
+			m.services.SetError(msg.Err.Error())
+		} else {
+			m.services.SetServices(msg.Services, msg.Pagination)
 		}
-		return m, nil
+		return m.pageLoaded(TabServices, msg.Err)
+
+	case PrefetchTickMsg:
+		return m.handlePrefetchTick(msg)
+
+	case DetailDebounceMsg:
+		// Only fetch if the selection hasn't moved on since the timer started
+		if msg.Tab != m.activeTab || msg.ID != m.selectedID() {
+			return m, nil
+		}
+		if m.activeTab == TabIncidents {
+			inc := m.incidents.SelectedIncident()
+			if inc.DetailLoaded || m.incidents.IsLoadingIncident(inc.ID) {
+				return m, nil
+			}
+			m.incidents.SetDetailLoading(inc.ID)
+			return m, tea.Batch(m.spinner.Tick, m.loadIncidentDetail(inc.ID, inc.UpdatedAt, m.incidents.SelectedIndex()))
+		}
+		alert := m.alerts.SelectedAlert()
+		if alert.DetailLoaded || m.alerts.IsLoadingAlert(alert.ID) {
+			return m, nil
+		}
+		m.alerts.SetDetailLoading(alert.ID)
+		return m, tea.Batch(m.spinner.Tick, m.loadAlertDetail(alert.ID, alert.UpdatedAt, m.alerts.SelectedIndex()))
+
+	case IncidentDetailLoadedMsg:
+		if msg.Prefetch {
+			return m.handlePrefetchedIncident(msg)
+		}
+		return m.handleIncidentDetailLoaded(msg)
 
 	case AlertDetailLoadedMsg:
 		m.alerts.ClearDetailLoading()
@@ -555,10 +1433,125 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			m.errorMsg = msg.Err.Error()
 		} else if msg.Alert != nil {
+			m.alerts.SetDetailFromCache(msg.Alert.ID, msg.FromCache)
 			m.alerts.UpdateAlertDetail(msg.Index, msg.Alert)
 			m.errorMsg = ""
-			// Auto-focus detail pane for scrolling after load completes
-			m.alerts.SetDetailFocused(true)
+			// Auto-focus detail pane for scrolling after load completes,
+			// unless details load automatically while browsing the list
+			if m.cfg == nil || !m.cfg.AutoLoadDetails {
+				m.alerts.SetDetailFocused(true)
+			}
+		}
+		return m, nil
+
+	case IncidentReopenedMsg:
+		return m.handleIncidentStatusUpdated(msg.ID, msg.Incident, msg.Index, msg.Err, api.IncidentStatusStarted, "incidents.reopened")
+
+	case IncidentAcknowledgedMsg:
+		return m.handleIncidentStatusUpdated(msg.ID, msg.Incident, msg.Index, msg.Err, api.IncidentStatusAcknowledged, "incidents.acknowledged")
+
+	case AlertStatusChangingMsg:
+		m.alerts.SetAlertStatus(msg.ID, msg.Status)
+		m.errorMsg = ""
+		m.statusMsg = i18n.T("alerts.updating")
+		return m, nil
+
+	case AlertStatusUpdatedMsg:
+		if msg.Err != nil {
+			m.alerts.SetAlertStatus(msg.ID, msg.PrevStatus)
+			if m.handleOAuthExpired(msg.Err) {
+				return m, m.setup.Init()
+			}
+			m.statusMsg = ""
+			m.errorMsg = msg.Err.Error()
+			return m, nil
+		}
+		m.errorMsg = ""
+		doneKey := "alerts.acknowledged"
+		if msg.Status == api.AlertStatusResolved {
+			doneKey = "alerts.resolved"
+		}
+		m.statusMsg = i18n.Tf(doneKey, map[string]any{"ID": msg.ShortID})
+		// Cached detail was invalidated by the client; fetch it again
+		m.alerts.SetDetailLoading(msg.ID)
+		return m, tea.Batch(m.spinner.Tick, m.loadAlertDetail(msg.ID, msg.UpdatedAt, msg.Index))
+
+	case AlertAcknowledgedMsg:
+		m.bulkAck.done++
+		if msg.Err != nil {
+			if m.handleOAuthExpired(msg.Err) {
+				m.bulkAck = bulkAckState{}
+				return m, m.setup.Init()
+			}
+			debug.Logger.Warn("Failed to acknowledge alert", "id", msg.ID, "error", msg.Err)
+			m.bulkAck.failed = append(m.bulkAck.failed, msg.ShortID)
+		} else {
+			m.bulkAck.acked++
+			m.alerts.SetAlertStatus(msg.ID, api.AlertStatusAcknowledged)
+		}
+		m.statusMsg = i18n.Tf("alerts.ack_all_progress", map[string]any{"Done": m.bulkAck.acked, "Total": msg.Total})
+		if m.bulkAck.done >= msg.Total {
+			m.errorMsg = ""
+			if len(m.bulkAck.failed) > 0 {
+				m.errorMsg = i18n.Tf("alerts.ack_all_failed", map[string]any{"IDs": strings.Join(m.bulkAck.failed, ", ")})
+			}
+			m.bulkAck = bulkAckState{}
+		}
+		return m, nil
+
+	case OnCallScopesLoadedMsg:
+		if msg.Err != nil {
+			if m.handleOAuthExpired(msg.Err) {
+				return m, m.setup.Init()
+			}
+			m.statusMsg = ""
+			m.errorMsg = msg.Err.Error()
+			return m, nil
+		}
+		m.incidents.SetOnCallScopes(msg.Scopes)
+		m.applyOnCallFilter(msg.Scopes)
+		return m, nil
+
+	case ScopeResolvedMsg:
+		if msg.Err != nil {
+			if m.handleOAuthExpired(msg.Err) {
+				return m, m.setup.Init()
+			}
+			m.statusMsg = ""
+			m.errorMsg = msg.Err.Error()
+			return m, nil
+		}
+		m.scopeUser = msg.User
+		m.scopeTeam = msg.Team
+		return m, m.applyScope(m.scope.next())
+
+	case IncidentSummaryLoadedMsg:
+		if msg.Err != nil {
+			if m.handleOAuthExpired(msg.Err) {
+				return m, m.setup.Init()
+			}
+			m.summary.SetError(msg.Err.Error())
+			return m, nil
+		}
+		loc := time.UTC
+		if m.cfg != nil {
+			loc = m.cfg.GetLocation()
+		}
+		m.summary.SetCreatedTimes(msg.CreatedAt, time.Now(), loc)
+		return m, nil
+
+	case TeamsLoadedMsg:
+		if msg.Err != nil {
+			// Keep the teams derived from loaded incidents
+			debug.Logger.Warn("Failed to load teams, using teams from loaded incidents", "error", msg.Err)
+			return m, nil
+		}
+		if len(msg.Teams) > 0 {
+			names := make([]string, 0, len(msg.Teams))
+			for _, t := range msg.Teams {
+				names = append(names, t.Name)
+			}
+			m.incidents.SetTeamOptions(names)
 		}
 		return m, nil
 
@@ -588,6 +1581,11 @@ func (m Model) View() tea.View {
 		content = i18n.T("common.loading")
 	} else if m.screen == ScreenSetup {
 		content = m.setup.View()
+		if m.showingIntro() {
+			content = lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.intro.View())
+		}
+	} else if m.screen == ScreenWatch {
+		content = m.renderWatchView()
 	} else {
 		content = m.renderMainView()
 	}
@@ -598,6 +1596,60 @@ func (m Model) View() tea.View {
 	return v
 }
 
+// helpBarHeight is the number of lines the help bar takes, including its top margin
+const helpBarHeight = 2
+
+// confirmWrite gates a destructive write action: with confirm_writes on (the default)
+// the command is held by the confirmation prompt until y is pressed and nil is
+// returned; otherwise the command is returned to run right away
+func (m Model) confirmWrite(prompt string, cmd tea.Cmd) tea.Cmd {
+	if m.cfg != nil && !m.cfg.WriteConfirmationsEnabled() {
+		return cmd
+	}
+	m.confirm.Ask(prompt, cmd)
+	return nil
+}
+
+// showHelpBar reports whether the bottom help bar is enabled in config
+func (m Model) showHelpBar() bool {
+	return m.cfg == nil || m.cfg.HelpBarEnabled()
+}
+
+// listHeight is the height available to the incidents/alerts views below
+// the header and above the status and help bars
+func (m Model) listHeight() int {
+	height := m.height - 10
+	if !m.showHelpBar() {
+		height += helpBarHeight
+	}
+	return height
+}
+
+// renderHelpBar renders the key hints for the active tab
+func (m Model) renderHelpBar() string {
+	hasSelection := false
+	var currentPage, totalPages, totalCount int
+	switch m.activeTab {
+	case TabIncidents:
+		hasSelection = m.incidents.SelectedIncident() != nil
+		currentPage = m.incidents.CurrentPage()
+		totalPages = m.incidents.TotalPages()
+		totalCount = m.incidents.TotalCount()
+	case TabAlerts:
+		hasSelection = m.alerts.SelectedAlert() != nil
+		currentPage = m.alerts.CurrentPage()
+		totalPages = m.alerts.TotalPages()
+		totalCount = m.alerts.TotalCount()
+	case TabServices:
+		hasSelection = m.services.SelectedService() != nil
+		currentPage = m.services.CurrentPage()
+		totalPages = m.services.TotalPages()
+		totalCount = m.services.TotalCount()
+	}
+	isIncidentsTab := m.activeTab == TabIncidents
+	return views.RenderHelpBar(m.width, hasSelection, m.loading, isIncidentsTab, currentPage, totalPages, totalCount)
+}
+
 func (m Model) renderMainView() string {
 	var b strings.Builder
 
@@ -613,11 +1665,15 @@ func (m Model) renderMainView() string {
 		// Pass spinner to views for loading state
 		m.incidents.SetSpinner(m.spinner.View())
 		m.alerts.SetSpinner(m.spinner.View())
+		m.services.SetSpinner(m.spinner.View())
 
-		if m.activeTab == TabIncidents {
+		switch m.activeTab {
+		case TabIncidents:
 			b.WriteString(m.incidents.View())
-		} else {
+		case TabAlerts:
 			b.WriteString(m.alerts.View())
+		case TabServices:
+			b.WriteString(m.services.View())
 		}
 	}
 
@@ -625,23 +1681,11 @@ func (m Model) renderMainView() string {
 	b.WriteString("\n")
 	b.WriteString(m.renderStatusBar())
 
-	// Help bar
-	b.WriteString("\n")
-	hasSelection := false
-	var currentPage, totalPages, totalCount int
-	if m.activeTab == TabIncidents {
-		hasSelection = m.incidents.SelectedIncident() != nil
-		currentPage = m.incidents.CurrentPage()
-		totalPages = m.incidents.TotalPages()
-		totalCount = m.incidents.TotalCount()
-	} else {
-		hasSelection = m.alerts.SelectedAlert() != nil
-		currentPage = m.alerts.CurrentPage()
-		totalPages = m.alerts.TotalPages()
-		totalCount = m.alerts.TotalCount()
+	// Help bar (can be hidden in config; ? still shows the full help)
+	if m.showHelpBar() {
+		b.WriteString("\n")
+		b.WriteString(m.renderHelpBar())
 	}
-	isIncidentsTab := m.activeTab == TabIncidents
-	b.WriteString(views.RenderHelpBar(m.width, hasSelection, m.loading, isIncidentsTab, currentPage, totalPages, totalCount))
 
 	// Wrap content
 	content := styles.App.Render(b.String())
@@ -664,11 +1708,41 @@ func (m Model) renderMainView() string {
 		content = lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, aboutDialog)
 	}
 
-	// Sort menu overlay (incidents tab only)
+	// Summary overlay
+	if m.summary.Visible {
+		summaryDialog := m.summary.View()
+		content = lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, summaryDialog)
+	}
+
+	// Sort menu overlay
 	if m.activeTab == TabIncidents && m.incidents.IsSortMenuVisible() {
 		sortMenu := m.incidents.RenderSortMenu()
 		content = lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, sortMenu)
 	}
+	if m.activeTab == TabAlerts && m.alerts.IsSortMenuVisible() {
+		sortMenu := m.alerts.RenderSortMenu()
+		content = lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, sortMenu)
+	}
+
+	// Team picker overlay (incidents tab only)
+	if m.activeTab == TabIncidents && m.incidents.IsTeamPickerVisible() {
+		teamPicker := m.incidents.RenderTeamPicker()
+		content = lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, teamPicker)
+	}
+
+	// Confirmation prompt overlay
+	if m.viewPicker.IsVisible() {
+		content = lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.viewPicker.Render(m.currentView))
+	}
+	if m.profilePicker.IsVisible() {
+		content = lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.profilePicker.Render(m.activeProfile()))
+	}
+	if m.prompt.IsVisible() {
+		content = lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.prompt.Render())
+	}
+	if m.confirm.IsVisible() {
+		content = lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.confirm.Render())
+	}
 
 	return content
 }
@@ -676,19 +1750,32 @@ func (m Model) renderMainView() string {
 func (m Model) renderHeader() string {
 	title := styles.Title.Render(i18n.T("app.title"))
 
-	// Tab indicators
-	var incidentsTab, alertsTab string
-	if m.activeTab == TabIncidents {
-		incidentsTab = styles.TabActive.Render(i18n.T("incidents.title"))
-		alertsTab = styles.TabInactive.Render(i18n.T("alerts.title"))
-	} else {
-		incidentsTab = styles.TabInactive.Render(i18n.T("incidents.title"))
-		alertsTab = styles.TabActive.Render(i18n.T("alerts.title"))
+	// Tab indicators, with the active incidents and triggered alerts on the
+	// current page counted (no badge at zero)
+	counts := []int{m.incidents.ActiveCount(), m.alerts.TriggeredCount(), 0}
+	var tabLabels []string
+	for tab, key := range []string{"incidents.title", "alerts.title", "services.title"} {
+		style := styles.TabInactive
+		if Tab(tab) == m.activeTab {
+			style = styles.TabActive
+		}
+		label := i18n.T(key)
+		if counts[tab] > 0 {
+			label = fmt.Sprintf("%s (%d)", label, counts[tab])
+		}
+		tabLabels = append(tabLabels, style.Render(label))
 	}
-	tabs := incidentsTab + " " + alertsTab
+	tabs := strings.Join(tabLabels, " ")
 
-	// Version
+	// Version (replaced by a badge in present mode)
 	version := styles.TextDim.Render("v" + m.version)
+	if m.presentMode {
+		version = styles.Warning.Render(i18n.T("present.badge"))
+	}
+	if m.dryRun {
+		version = styles.Warning.Bold(true).Render(i18n.T("app.dry_run_badge")) + "  " + version
+	}
+	version = m.renderConnStatus() + "  " + version
 
 	// Calculate spacing
 	leftPart := title + "  "
@@ -707,111 +1794,695 @@ func (m Model) renderHeader() string {
 }
 
 func (m Model) renderStatusBar() string {
+	// Activity indicator for background requests still in flight
+	activity := ""
+	if m.inFlight > 0 {
+		activity = styles.TextDim.Render(fmt.Sprintf("⟳ %d", m.inFlight)) + "  "
+	}
+	if m.leaderPending != "" {
+		activity = styles.TextBold.Render(i18n.Tf("common.leader_pending", map[string]any{"Keys": m.leaderPending})) + "  " + activity
+	}
+	if interval := m.autoRefreshInterval(); interval > 0 {
+		activity += styles.TextDim.Render(i18n.Tf("common.auto_refresh", map[string]any{"Interval": interval.String()})) + "  "
+	}
+	if wait := m.rateLimitStatus().RetryingIn(time.Now()); wait > 0 {
+		activity += styles.Warning.Render(i18n.Tf("common.rate_limited", map[string]any{"Seconds": int((wait + time.Second - 1) / time.Second)})) + "  "
+	}
+	if at := m.activeCachedAt(); !at.IsZero() {
+		activity += styles.TextDim.Render(i18n.Tf("common.cached_ago", map[string]any{"Age": views.FormatAge(time.Since(at))})) + "  "
+	}
+
 	if m.errorMsg != "" {
-		return styles.Error.Render("Error: " + m.errorMsg)
+		return activity + styles.Error.Render("Error: "+m.redact(m.errorMsg))
 	}
 	// Don't show loading in status bar when views handle it (page loading)
 	// Views show their own spinner in the content area
 	if m.statusMsg != "" && !m.loading {
-		return styles.StatusBar.Render(m.statusMsg)
+		return activity + styles.StatusBar.Render(m.redact(m.statusMsg))
 	}
-	return ""
+	return activity
+}
+
+// cachedAt returns when a list page served from the cache was stored, or the
+// zero time for a fresh page
+func cachedAt(age time.Duration) time.Time {
+	if age <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(-age)
+}
+
+// activeCachedAt returns when the active tab's list was cached, or the zero
+// time when it was fetched fresh
+func (m Model) activeCachedAt() time.Time {
+	switch m.activeTab {
+	case TabIncidents:
+		return m.incidentsCachedAt
+	case TabAlerts:
+		return m.alertsCachedAt
+	}
+	return time.Time{}
+}
+
+// redact hides the API endpoint and key in present mode (errors often embed request URLs)
+func (m Model) redact(s string) string {
+	if !m.presentMode || m.cfg == nil {
+		return s
+	}
+	for _, secret := range []string{m.cfg.APIKey, m.cfg.Endpoint} {
+		if secret != "" {
+			s = strings.ReplaceAll(s, secret, "[hidden]")
+		}
+	}
+	return s
 }
 
 func (m Model) loadData() tea.Cmd {
-	return tea.Batch(
-		m.loadIncidents(),
-		m.loadAlerts(),
-	)
+	cmds := []tea.Cmd{m.loadIncidents(), m.loadAlerts()}
+	if m.services.Requested() {
+		cmds = append(cmds, m.loadServices())
+	}
+	return tea.Batch(cmds...)
 }
 
 func (m Model) loadIncidents() tea.Cmd {
-	// Capture the client, page, and sort - it should already be initialized in New()
+	// Capture the client, page, sort and team - it should already be initialized in New()
 	client := m.apiClient
 	page := m.incidents.CurrentPage()
 	sort := m.incidents.GetSortParam()
-	return func() tea.Msg {
+	team := m.incidents.TeamFilter()
+	timeout := m.requestTimeout()
+	return background(func() tea.Msg {
 		if client == nil {
 			return IncidentsLoadedMsg{Err: fmt.Errorf("API client not initialized")}
 		}
 
-		ctx := context.Background()
-		result, err := client.ListIncidents(ctx, page, sort)
+		ctx, cancel := requestContext(timeout)
+		defer cancel()
+		result, err := client.ListIncidents(ctx, page, sort, team)
 		if err != nil {
-			return IncidentsLoadedMsg{Err: err}
+			return IncidentsLoadedMsg{Err: requestError(err, timeout)}
 		}
 
 		return IncidentsLoadedMsg{
 			Incidents:  result.Incidents,
 			Pagination: result.Pagination,
+			CachedAge:  result.CachedAge,
 		}
-	}
+	})
+}
+
+// loadMoreIncidents fetches the page after the loaded ones, to append for infinite scroll
+func (m Model) loadMoreIncidents() tea.Cmd {
+	client := m.apiClient
+	page := m.incidents.NextAppendPage()
+	sort := m.incidents.GetSortParam()
+	team := m.incidents.TeamFilter()
+	timeout := m.requestTimeout()
+	return background(func() tea.Msg {
+		if client == nil {
+			return IncidentsAppendedMsg{Err: fmt.Errorf("API client not initialized")}
+		}
+
+		ctx, cancel := requestContext(timeout)
+		defer cancel()
+		result, err := client.ListIncidents(ctx, page, sort, team)
+		if err != nil {
+			return IncidentsAppendedMsg{Err: requestError(err, timeout)}
+		}
+
+		return IncidentsAppendedMsg{
+			Incidents:  result.Incidents,
+			Pagination: result.Pagination,
+		}
+	})
 }
 
 func (m Model) loadAlerts() tea.Cmd {
 	// Capture the client and page - it should already be initialized in New()
 	client := m.apiClient
 	page := m.alerts.CurrentPage()
-	return func() tea.Msg {
+	timeout := m.requestTimeout()
+	return background(func() tea.Msg {
 		if client == nil {
 			return AlertsLoadedMsg{Err: fmt.Errorf("API client not initialized")}
 		}
 
-		ctx := context.Background()
+		ctx, cancel := requestContext(timeout)
+		defer cancel()
 		result, err := client.ListAlerts(ctx, page)
 		if err != nil {
-			return AlertsLoadedMsg{Err: err}
+			return AlertsLoadedMsg{Err: requestError(err, timeout)}
 		}
 
 		return AlertsLoadedMsg{
 			Alerts:     result.Alerts,
 			Pagination: result.Pagination,
+			CachedAge:  result.CachedAge,
+		}
+	})
+}
+
+// loadServices fetches the current page of services
+func (m Model) loadServices() tea.Cmd {
+	client := m.apiClient
+	page := m.services.CurrentPage()
+	timeout := m.requestTimeout()
+	return background(func() tea.Msg {
+		if client == nil {
+			return ServicesLoadedMsg{Err: fmt.Errorf("API client not initialized")}
+		}
+
+		ctx, cancel := requestContext(timeout)
+		defer cancel()
+		result, err := client.ListServices(ctx, page)
+		if err != nil {
+			return ServicesLoadedMsg{Err: requestError(err, timeout)}
+		}
+
+		return ServicesLoadedMsg{
+			Services:   result.Services,
+			Pagination: result.Pagination,
+		}
+	})
+}
+
+// applyOnCallFilter enables the on-call filter, or reports that the user isn't on call
+func (m *Model) applyOnCallFilter(scopes *api.OnCallScopes) {
+	if scopes.IsEmpty() {
+		m.statusMsg = i18n.T("oncall.not_on_call")
+		return
+	}
+	m.statusMsg = ""
+	m.incidents.SetOnCallOnly(true)
+}
+
+// applyScope switches to the given scope and sets the matching incident filters,
+// returning the reload of the first page when the team filter changed. My team
+// is skipped when the user's team is unknown.
+func (m *Model) applyScope(scope scopeFilter) tea.Cmd {
+	m.statusMsg = ""
+	if scope == scopeMyTeam && m.scopeTeam == "" {
+		m.statusMsg = i18n.T("scope.no_team")
+		scope = scopeMine
+	}
+	m.scope = scope
+	var teamChanged bool
+	switch scope {
+	case scopeMyTeam:
+		m.incidents.SetMineFilter(nil)
+		teamChanged = m.incidents.SetTeamFilter(m.scopeTeam)
+	case scopeMine:
+		teamChanged = m.incidents.SetTeamFilter("")
+		m.incidents.SetMineFilter(m.scopeUser)
+	default:
+		teamChanged = m.incidents.SetTeamFilter("")
+		m.incidents.SetMineFilter(nil)
+	}
+	if !teamChanged {
+		return nil
+	}
+	m.incidents.SetLoading(true)
+	return m.loadIncidents()
+}
+
+// selectedServices returns the services of the selected incident or alert
+func (m Model) selectedServices() []string {
+	switch m.activeTab {
+	case TabIncidents:
+		if inc := m.incidents.SelectedIncident(); inc != nil {
+			return inc.Services
+		}
+	case TabAlerts:
+		if alert := m.alerts.SelectedAlert(); alert != nil {
+			return alert.Services
+		}
+	case TabServices:
+		if svc := m.services.SelectedService(); svc != nil {
+			return []string{svc.Name}
+		}
+	}
+	return nil
+}
+
+// copyRedactedConfig copies the effective config as YAML with its secrets redacted
+func (m *Model) copyRedactedConfig() {
+	if m.cfg == nil {
+		return
+	}
+	out, err := config.RedactedYAML(m.cfg)
+	if err != nil {
+		m.errorMsg = err.Error()
+		return
+	}
+	if m.copyToClipboard(out) {
+		m.statusMsg = i18n.T("about.config_copied")
+	}
+}
+
+// statusUpdate renders inc through the configured status update template
+func (m Model) statusUpdate(inc *api.Incident) (string, error) {
+	tmpl, err := m.cfg.StatusUpdate()
+	if err != nil {
+		return "", err
+	}
+	return inc.RenderStatusUpdate(tmpl)
+}
+
+// copyToClipboard writes text to the system clipboard and reports the result in the status bar.
+// Returns false if the clipboard is unavailable.
+func (m *Model) copyToClipboard(text string) bool {
+	if err := clipboard.Init(); err != nil {
+		debug.Logger.Error("Failed to initialize clipboard", "error", err)
+		m.statusMsg = i18n.T("logs.clipboard_unavailable")
+		return false
+	}
+	clipboard.Write(clipboard.FmtText, []byte(text))
+	m.statusMsg = i18n.T("logs.copied")
+	return true
+}
+
+// snapshotDir is where HTML snapshots are saved (overridden in tests)
+var snapshotDir = func() string {
+	return filepath.Join(config.Dir(), "snapshots")
+}
+
+// saveIncidentHTML writes the incident as HTML under snapshotDir and returns the file path
+func saveIncidentHTML(inc *api.Incident, now time.Time) (string, error) {
+	page, err := inc.ToHTML(now)
+	if err != nil {
+		return "", err
+	}
+	dir := snapshotDir()
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+	id := inc.SequentialID
+	if id == "" {
+		id = inc.ID
+	}
+	name := strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' {
+			return '-'
+		}
+		return r
+	}, id) + "-" + now.Format("20060102-150405") + ".html"
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(page), 0600); err != nil {
+		return "", fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return path, nil
+}
+
+// pageSizeRefetchDelta is how many rows the fitted page size must change by before lists are re-fetched
+const pageSizeRefetchDelta = 3
+
+// applyAutoPageSize fits the list page size to the table height when auto_page_size is enabled,
+// re-fetching the lists if the fit changed significantly
+func (m *Model) applyAutoPageSize() tea.Cmd {
+	if m.cfg == nil || !m.cfg.AutoPageSize || m.apiClient == nil {
+		return nil
+	}
+	size := m.incidents.FitPageSize()
+	if size > api.MaxPageSize {
+		size = api.MaxPageSize
+	}
+	current := m.apiClient.PageSize()
+	if size <= 0 || (size-current < pageSizeRefetchDelta && current-size < pageSizeRefetchDelta) {
+		return nil
+	}
+
+	debug.Logger.Debug("Fitting page size to terminal", "from", current, "to", size)
+	m.apiClient.SetPageSize(size)
+	m.incidents.SetAPIPageSize(size)
+	m.alerts.SetAPIPageSize(size)
+	m.services.SetAPIPageSize(size)
+	if m.screen != ScreenMain {
+		return nil
+	}
+	return m.loadData()
+}
+
+// background wraps a load command so it's counted as in flight: BackgroundStartedMsg is
+// delivered before cmd runs, and the count drops again when the result message arrives.
+func background(cmd tea.Cmd) tea.Cmd {
+	return tea.Sequence(func() tea.Msg { return BackgroundStartedMsg{} }, cmd)
+}
+
+// finishBackground decrements the in-flight count when msg is the result of a background load
+func (m *Model) finishBackground(msg tea.Msg) {
+	switch msg.(type) {
+	case IncidentsLoadedMsg, IncidentsAppendedMsg, AlertsLoadedMsg, ServicesLoadedMsg, IncidentDetailLoadedMsg, AlertDetailLoadedMsg,
+		TeamsLoadedMsg, OnCallScopesLoadedMsg, ScopeResolvedMsg, IncidentSummaryLoadedMsg, FocusIncidentLoadedMsg,
+		OpenIncidentLoadedMsg, JumpIncidentLoadedMsg, IncidentEventsLoadedMsg:
+		if m.inFlight > 0 {
+			m.inFlight--
+		}
+	}
+}
+
+// autoLoadDelay is how long the selection must stay put before its detail is fetched
+const autoLoadDelay = 300 * time.Millisecond
+
+// scheduleDetailLoad starts the debounce timer for auto-loading the selected item's detail
+func scheduleDetailLoad(tab Tab, id string) tea.Cmd {
+	return tea.Tick(autoLoadDelay, func(time.Time) tea.Msg {
+		return DetailDebounceMsg{Tab: tab, ID: id}
+	})
+}
+
+// selectedID returns the ID of the selected item on the active tab
+func (m Model) selectedID() string {
+	switch m.activeTab {
+	case TabIncidents:
+		if inc := m.incidents.SelectedIncident(); inc != nil {
+			return inc.ID
+		}
+	case TabAlerts:
+		if alert := m.alerts.SelectedAlert(); alert != nil {
+			return alert.ID
+		}
+	}
+	return ""
+}
+
+// selectedResponseBody returns the raw detail response kept for the selected
+// item, if any (debug mode only)
+func (m Model) selectedResponseBody() []byte {
+	id := m.selectedID()
+	if m.apiClient == nil || id == "" {
+		return nil
+	}
+	return m.apiClient.ResponseBody(id)
+}
+
+// selectedURL returns the Rootly URL of the selected incident, alert or service
+func (m Model) selectedURL() string {
+	switch m.activeTab {
+	case TabIncidents:
+		return api.ResolveIncidentURL(m.incidents.SelectedIncident())
+	case TabAlerts:
+		return api.ResolveAlertURL(m.alerts.SelectedAlert())
+	case TabServices:
+		if svc := m.services.SelectedService(); svc != nil {
+			return svc.URL()
 		}
 	}
+	return ""
+}
+
+// updateActiveView forwards msg to the active tab's view
+func (m *Model) updateActiveView(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	switch m.activeTab {
+	case TabIncidents:
+		m.incidents, cmd = m.incidents.Update(msg)
+	case TabAlerts:
+		m.alerts, cmd = m.alerts.Update(msg)
+	case TabServices:
+		m.services, cmd = m.services.Update(msg)
+	}
+	return cmd
 }
 
 func (m Model) loadIncidentDetail(id string, updatedAt time.Time, index int) tea.Cmd {
+	return m.fetchIncidentDetail(id, updatedAt, index, false)
+}
+
+// fetchIncidentDetail loads an incident's detail; prefetch marks a background
+// fetch the user didn't ask for
+func (m Model) fetchIncidentDetail(id string, updatedAt time.Time, index int, prefetch bool) tea.Cmd {
 	client := m.apiClient
-	return func() tea.Msg {
+	timeout := m.requestTimeout()
+	return background(func() tea.Msg {
 		if client == nil {
-			return IncidentDetailLoadedMsg{Err: fmt.Errorf("API client not initialized")}
+			return IncidentDetailLoadedMsg{ID: id, Prefetch: prefetch, Err: fmt.Errorf("API client not initialized")}
 		}
 
-		ctx := context.Background()
-		incident, err := client.GetIncident(ctx, id, updatedAt)
+		ctx, cancel := requestContext(timeout)
+		defer cancel()
+		incident, fromCache, err := client.GetIncidentDetail(ctx, id, updatedAt)
 		if err != nil {
-			return IncidentDetailLoadedMsg{Err: err, Index: index}
+			return IncidentDetailLoadedMsg{ID: id, Prefetch: prefetch, Err: requestError(err, timeout), Index: index}
 		}
 
 		return IncidentDetailLoadedMsg{
-			Incident: incident,
-			Index:    index,
+			ID:        id,
+			Incident:  incident,
+			Index:     index,
+			FromCache: fromCache,
+			Prefetch:  prefetch,
+		}
+	})
+}
+
+func (m Model) loadOnCallScopes() tea.Cmd {
+	client := m.apiClient
+	timeout := m.requestTimeout()
+	return background(func() tea.Msg {
+		if client == nil {
+			return OnCallScopesLoadedMsg{Err: fmt.Errorf("API client not initialized")}
 		}
+
+		ctx, cancel := requestContext(timeout)
+		defer cancel()
+		scopes, err := client.GetOnCallScopes(ctx)
+		return OnCallScopesLoadedMsg{Scopes: scopes, Err: requestError(err, timeout)}
+	})
+}
+
+// resolveScope looks up the current user and their team (my_team in config wins)
+func (m Model) resolveScope() tea.Cmd {
+	client := m.apiClient
+	team := ""
+	if m.cfg != nil {
+		team = m.cfg.MyTeam
 	}
+	timeout := m.requestTimeout()
+	return background(func() tea.Msg {
+		if client == nil {
+			return ScopeResolvedMsg{Err: fmt.Errorf("API client not initialized")}
+		}
+
+		ctx, cancel := requestContext(timeout)
+		defer cancel()
+		user, err := client.CurrentUser(ctx)
+		if err != nil {
+			return ScopeResolvedMsg{Err: requestError(err, timeout)}
+		}
+		if team == "" {
+			teams, err := client.CurrentUserTeams(ctx)
+			if err != nil {
+				return ScopeResolvedMsg{Err: requestError(err, timeout)}
+			}
+			if len(teams) > 0 {
+				team = teams[0]
+			}
+		}
+		return ScopeResolvedMsg{User: user, Team: team}
+	})
+}
+
+func (m Model) loadIncidentSummary() tea.Cmd {
+	client := m.apiClient
+	timeout := m.requestTimeout()
+	return background(func() tea.Msg {
+		if client == nil {
+			return IncidentSummaryLoadedMsg{Err: fmt.Errorf("API client not initialized")}
+		}
+
+		// A full week back reaches midnight of the oldest bucket in any timezone
+		since := time.Now().AddDate(0, 0, -views.SummaryDays)
+		ctx, cancel := requestContext(timeout)
+		defer cancel()
+		times, err := client.ListIncidentCreatedTimes(ctx, since)
+		return IncidentSummaryLoadedMsg{CreatedAt: times, Err: requestError(err, timeout)}
+	})
 }
 
 func (m Model) loadAlertDetail(id string, updatedAt time.Time, index int) tea.Cmd {
 	client := m.apiClient
-	return func() tea.Msg {
+	timeout := m.requestTimeout()
+	return background(func() tea.Msg {
 		if client == nil {
 			return AlertDetailLoadedMsg{Err: fmt.Errorf("API client not initialized")}
 		}
 
-		ctx := context.Background()
-		alert, err := client.GetAlert(ctx, id, updatedAt)
+		ctx, cancel := requestContext(timeout)
+		defer cancel()
+		alert, fromCache, err := client.GetAlertDetail(ctx, id, updatedAt)
 		if err != nil {
-			return AlertDetailLoadedMsg{Err: err, Index: index}
+			return AlertDetailLoadedMsg{Err: requestError(err, timeout), Index: index}
 		}
 
 		return AlertDetailLoadedMsg{
-			Alert: alert,
-			Index: index,
+			Alert:     alert,
+			Index:     index,
+			FromCache: fromCache,
+		}
+	})
+}
+
+// handleIncidentDetailLoaded shows a detail the user asked for and focuses it
+func (m Model) handleIncidentDetailLoaded(msg IncidentDetailLoadedMsg) (tea.Model, tea.Cmd) {
+	m.incidents.ClearDetailLoading()
+	if msg.Err != nil {
+		if m.handleOAuthExpired(msg.Err) {
+			return m, m.setup.Init()
+		}
+		m.errorMsg = msg.Err.Error()
+	} else if msg.Incident != nil {
+		m.incidents.SetDetailFromCache(msg.Incident.ID, msg.FromCache)
+		m.incidents.UpdateIncidentDetail(msg.Index, msg.Incident)
+		m.errorMsg = ""
+		// Auto-focus detail pane for scrolling after load completes,
+		// unless details load automatically while browsing the list
+		if m.cfg == nil || !m.cfg.AutoLoadDetails {
+			return m, m.focusIncidentDetail()
+		}
+	}
+	return m, nil
+}
+
+// handleIncidentStatusUpdated updates the row of an incident whose status was changed
+// (falling back to status when the response has none) and reloads its detail
+func (m Model) handleIncidentStatusUpdated(id string, incident *api.Incident, index int, err error, status, doneKey string) (tea.Model, tea.Cmd) {
+	if err != nil {
+		if m.handleOAuthExpired(err) {
+			return m, m.setup.Init()
+		}
+		m.errorMsg = err.Error()
+		return m, nil
+	}
+	m.errorMsg = ""
+	var updatedAt time.Time
+	if incident != nil {
+		if incident.Status != "" {
+			status = incident.Status
+		}
+		updatedAt = incident.UpdatedAt
+		ref := incident.SequentialID
+		if ref == "" {
+			ref = id
+		}
+		m.statusMsg = i18n.Tf(doneKey, map[string]any{"ID": ref})
+	}
+	m.incidents.SetIncidentStatus(id, status)
+	// Cached detail was invalidated by the client; fetch it again
+	m.incidents.SetDetailLoading(id)
+	return m, tea.Batch(m.spinner.Tick, m.loadIncidentDetail(id, updatedAt, index))
+}
+
+func (m Model) reopenIncident(id string, index int) tea.Cmd {
+	client := m.apiClient
+	timeout := m.requestTimeout()
+	return func() tea.Msg {
+		if client == nil {
+			return IncidentReopenedMsg{ID: id, Index: index, Err: fmt.Errorf("API client not initialized")}
+		}
+
+		ctx, cancel := requestContext(timeout)
+		defer cancel()
+		incident, err := client.ReopenIncident(ctx, id)
+		return IncidentReopenedMsg{ID: id, Incident: incident, Index: index, Err: requestError(err, timeout)}
+	}
+}
+
+func (m Model) acknowledgeIncident(id string, index int) tea.Cmd {
+	client := m.apiClient
+	timeout := m.requestTimeout()
+	return func() tea.Msg {
+		if client == nil {
+			return IncidentAcknowledgedMsg{ID: id, Index: index, Err: fmt.Errorf("API client not initialized")}
+		}
+
+		ctx, cancel := requestContext(timeout)
+		defer cancel()
+		incident, err := client.AcknowledgeIncident(ctx, id)
+		return IncidentAcknowledgedMsg{ID: id, Incident: incident, Index: index, Err: requestError(err, timeout)}
+	}
+}
+
+// updateAlertStatus acknowledges or resolves an alert; the row shows the new
+// status while the request is in flight and reverts if it fails
+func (m Model) updateAlertStatus(alert api.Alert, index int, status string) tea.Cmd {
+	client := m.apiClient
+	timeout := m.requestTimeout()
+	id, shortID, prev := alert.ID, alertRef(&alert), alert.Status
+	return tea.Sequence(
+		func() tea.Msg { return AlertStatusChangingMsg{ID: id, Status: status} },
+		func() tea.Msg {
+			result := AlertStatusUpdatedMsg{ID: id, ShortID: shortID, UpdatedAt: alert.UpdatedAt, Index: index, Status: status, PrevStatus: prev}
+			if client == nil {
+				result.Err = fmt.Errorf("API client not initialized")
+				return result
+			}
+			ctx, cancel := requestContext(timeout)
+			defer cancel()
+			var err error
+			if status == api.AlertStatusResolved {
+				err = client.ResolveAlert(ctx, id)
+			} else {
+				err = client.AcknowledgeAlert(ctx, id)
+			}
+			result.Err = requestError(err, timeout)
+			return result
+		},
+	)
+}
+
+// alertRef is the short ID used to name an alert in status messages
+func alertRef(alert *api.Alert) string {
+	if alert.ShortID != "" {
+		return alert.ShortID
+	}
+	return alert.ID
+}
+
+// acknowledgeAlerts issues one acknowledge request per alert; the client
+// bounds how many run concurrently and each result arrives as its own message
+func (m Model) acknowledgeAlerts(alerts []api.Alert) tea.Cmd {
+	client := m.apiClient
+	timeout := m.requestTimeout()
+	total := len(alerts)
+	cmds := make([]tea.Cmd, 0, total)
+	for _, alert := range alerts {
+		id, shortID := alert.ID, alert.ShortID
+		if shortID == "" {
+			shortID = id
 		}
+		cmds = append(cmds, func() tea.Msg {
+			if client == nil {
+				return AlertAcknowledgedMsg{ID: id, ShortID: shortID, Total: total, Err: fmt.Errorf("API client not initialized")}
+			}
+			ctx, cancel := requestContext(timeout)
+			defer cancel()
+			err := client.AcknowledgeAlert(ctx, id)
+			return AlertAcknowledgedMsg{ID: id, ShortID: shortID, Total: total, Err: requestError(err, timeout)}
+		})
 	}
+	return tea.Batch(cmds...)
+}
+
+func (m Model) loadTeams() tea.Cmd {
+	client := m.apiClient
+	timeout := m.requestTimeout()
+	return background(func() tea.Msg {
+		if client == nil {
+			return TeamsLoadedMsg{Err: fmt.Errorf("API client not initialized")}
+		}
+
+		ctx, cancel := requestContext(timeout)
+		defer cancel()
+		teams, err := client.ListTeams(ctx)
+		return TeamsLoadedMsg{Teams: teams, Err: requestError(err, timeout)}
+	})
 }
 
-// Close cleans up resources (cache, connections) when the app exits
+// Close cleans up resources (cache, connections) when the app exits. Auto-refresh
+// ticks are plain tea.Tick commands, so they stop with the program.
 func (m Model) Close() error {
+	m.saveState()
 	if m.apiClient != nil {
 		return m.apiClient.Close()
 	}
@@ -1,14 +1,28 @@
 package app
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
 
 	"github.com/rootlyhq/rootly-tui/internal/api"
 	"github.com/rootlyhq/rootly-tui/internal/config"
+	"github.com/rootlyhq/rootly-tui/internal/debug"
 	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/state"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
 )
 
 func TestMain(m *testing.M) {
@@ -96,12 +110,83 @@ func TestModelUpdateTabSwitch(t *testing.T) {
 		t.Errorf("expected active tab to be TabAlerts after tab press, got %d", model.activeTab)
 	}
 
-	// Switch back
+	// Services are loaded the first time their tab is shown
+	newModel, cmd := model.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	model = newModel.(Model)
+
+	if model.activeTab != TabServices {
+		t.Errorf("expected active tab to be TabServices after second tab press, got %d", model.activeTab)
+	}
+	if cmd == nil || !model.services.Requested() {
+		t.Error("expected services to be loaded when their tab is first shown")
+	}
+
+	// Wrap around
 	newModel, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyTab})
 	model = newModel.(Model)
 
 	if model.activeTab != TabIncidents {
-		t.Errorf("expected active tab to be TabIncidents after second tab press, got %d", model.activeTab)
+		t.Errorf("expected active tab to be TabIncidents after third tab press, got %d", model.activeTab)
+	}
+}
+
+func TestModelSelectedURL(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.incidents.SetIncidents([]api.Incident{{ID: "inc_1", ShortURL: "https://rootly.com/i/abc"}}, api.PaginationInfo{CurrentPage: 1})
+	m.alerts.SetAlerts([]api.Alert{{ID: "alert_1", ShortID: "ABC123"}}, api.PaginationInfo{CurrentPage: 1})
+
+	var opened string
+	m.urlOpener = func(url string) error { opened = url; return nil }
+
+	tests := []struct {
+		tab  Tab
+		want string
+	}{
+		{TabIncidents, "https://rootly.com/i/abc"},
+		{TabAlerts, "https://rootly.com/account/alerts/ABC123"},
+		{TabServices, ""},
+	}
+	for _, tt := range tests {
+		m.activeTab = tt.tab
+		if got := m.selectedURL(); got != tt.want {
+			t.Errorf("tab %d: selectedURL() = %q, want %q", tt.tab, got, tt.want)
+		}
+		// o opens the same URL that y copies
+		opened = ""
+		_, _ = m.Update(tea.KeyPressMsg{Code: 'o', Text: "o"})
+		if opened != tt.want {
+			t.Errorf("tab %d: o opened %q, want %q", tt.tab, opened, tt.want)
+		}
+	}
+}
+
+func TestModelServicesTab(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabServices
+	var opened string
+	m.urlOpener = func(url string) error { opened = url; return nil }
+
+	newModel, _ := m.Update(ServicesLoadedMsg{
+		Services:   []api.Service{{ID: "svc_1", Name: "API", Slug: "api"}},
+		Pagination: api.PaginationInfo{CurrentPage: 1},
+	})
+	m = newModel.(Model)
+	if svc := m.services.SelectedService(); svc == nil || svc.Name != "API" {
+		t.Fatalf("expected the loaded service to be selected, got %+v", svc)
+	}
+
+	newModel, _ = m.Update(tea.KeyPressMsg{Code: 'o', Text: "o"})
+	m = newModel.(Model)
+	if opened != "https://rootly.com/account/services/api" {
+		t.Errorf("expected o to open the service URL, got %q", opened)
+	}
+
+	newModel, _ = m.Update(ServicesLoadedMsg{Err: fmt.Errorf("forbidden")})
+	m = newModel.(Model)
+	if m.errorMsg != "forbidden" {
+		t.Errorf("expected the load error to be reported, got %q", m.errorMsg)
 	}
 }
 
@@ -262,6 +347,31 @@ func TestModelUpdateLogsEscape(t *testing.T) {
 	}
 }
 
+func TestModelLogsSearchKeepsOverlayOpen(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.logs.Visible = true
+
+	// l is typed into the search prompt instead of closing the overlay
+	var model tea.Model = m
+	for _, k := range []tea.KeyPressMsg{{Code: '/', Text: "/"}, {Code: 'l', Text: "l"}, {Code: tea.KeyEnter}} {
+		model, _ = model.Update(k)
+	}
+	if !model.(Model).logs.Visible {
+		t.Fatal("expected the overlay to stay open while searching")
+	}
+
+	// The first Esc clears the search, the second closes the overlay
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyEsc})
+	if !model.(Model).logs.Visible {
+		t.Error("expected Esc to clear the search first")
+	}
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyEsc})
+	if model.(Model).logs.Visible {
+		t.Error("expected the second Esc to close the overlay")
+	}
+}
+
 func TestModelLogsBlocksOtherKeys(t *testing.T) {
 	m := New("1.0.0")
 	m.screen = ScreenMain
@@ -299,6 +409,22 @@ func TestNewWithValidConfigCreatesClientOnce(t *testing.T) {
 	}
 }
 
+func TestNewWithEnvironmentCredentials(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(config.EnvAPIKey, "env-key")
+	t.Setenv(config.EnvEndpoint, "api.example.com")
+
+	m := New("1.0.0")
+	defer func() { _ = m.Close() }()
+
+	if m.screen != ScreenMain || m.apiClient == nil {
+		t.Fatalf("expected the main screen with a client, got screen %d", m.screen)
+	}
+	if m.cfg.APIKey != "env-key" || m.cfg.Endpoint != "api.example.com" {
+		t.Errorf("expected credentials from the environment, got %q at %q", m.cfg.APIKey, m.cfg.Endpoint)
+	}
+}
+
 func TestModelClientNotRecreatedOnRefresh(t *testing.T) {
 	m := New("1.0.0")
 	m.screen = ScreenMain
@@ -1039,3 +1165,1958 @@ func TestModelUpKeyPassedToView(t *testing.T) {
 		t.Errorf("expected cursor at 0 after 'k', got %d", model.incidents.SelectedIndex())
 	}
 }
+
+func TestModelTeamPicker(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabIncidents
+	m.incidents.SetIncidents(api.MockIncidents(), api.PaginationInfo{CurrentPage: 1})
+
+	// Open picker
+	newModel, cmd := m.Update(tea.KeyPressMsg{Code: 'T', Text: "T"})
+	model := newModel.(Model)
+	if !model.incidents.IsTeamPickerVisible() {
+		t.Fatal("expected team picker to be visible after 'T'")
+	}
+	if cmd == nil {
+		t.Error("expected a command to load teams")
+	}
+
+	// API teams replace the options seeded from loaded incidents
+	newModel, _ = model.Update(TeamsLoadedMsg{Teams: []api.Team{{ID: "t1", Name: "Backend"}}})
+	model = newModel.(Model)
+
+	// Select "Backend" (All teams, Backend)
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	model = newModel.(Model)
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	model = newModel.(Model)
+
+	if model.incidents.IsTeamPickerVisible() {
+		t.Error("expected team picker to close after selection")
+	}
+	if model.incidents.TeamFilter() != "Backend" {
+		t.Errorf("expected team filter 'Backend', got %q", model.incidents.TeamFilter())
+	}
+	if inc := model.incidents.SelectedIncident(); inc == nil || inc.Teams[0] != "Backend" {
+		t.Error("expected selected incident to belong to Backend")
+	}
+}
+
+func TestModelTeamsLoadedWithError(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+
+	newModel, _ := m.Update(TeamsLoadedMsg{Err: os.ErrPermission})
+	model := newModel.(Model)
+
+	// Teams list failures are non-fatal
+	if model.errorMsg != "" {
+		t.Errorf("expected no error message, got %q", model.errorMsg)
+	}
+}
+
+func TestModelWelcomeOverlay(t *testing.T) {
+	// Start without any config so the welcome overlay is shown
+	os.Remove(config.Path())
+	m := New("1.0.0")
+	if !m.intro.Visible {
+		t.Fatal("expected welcome overlay to be visible on first run")
+	}
+
+	newModel, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = newModel.(Model)
+	view := m.View()
+	if !strings.Contains(view.Content, "Welcome to Rootly TUI") {
+		t.Error("expected welcome overlay in view")
+	}
+
+	// Any key dismisses the overlay and records it in the config
+	newModel, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = newModel.(Model)
+	if cmd != nil {
+		t.Error("expected dismissing key not to be forwarded")
+	}
+	if m.intro.Visible {
+		t.Error("expected welcome overlay to be hidden after key press")
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("expected config to be saved: %v", err)
+	}
+	if !cfg.WelcomeSeen {
+		t.Error("expected WelcomeSeen to be set after dismissing")
+	}
+
+	// Not shown again on next start
+	if New("1.0.0").intro.Visible {
+		t.Error("expected welcome overlay not to reappear")
+	}
+	os.Remove(config.Path())
+}
+
+func TestModelReopenOnlyForResolved(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabIncidents
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", SequentialID: "INC-1", Status: "started"},
+		{ID: "inc_2", SequentialID: "INC-2", Status: "resolved"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	// Not offered for an ongoing incident
+	newModel, cmd := m.Update(tea.KeyPressMsg{Code: 'R', Text: "R"})
+	model := newModel.(Model)
+	if model.confirm.IsVisible() {
+		t.Error("expected no reopen prompt for a started incident")
+	}
+	if cmd != nil {
+		t.Error("expected no command for a started incident")
+	}
+	if model.statusMsg == "" {
+		t.Error("expected status message explaining reopen is unavailable")
+	}
+
+	// Offered for a resolved incident, gated behind confirmation
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	model = newModel.(Model)
+	newModel, cmd = model.Update(tea.KeyPressMsg{Code: 'R', Text: "R"})
+	model = newModel.(Model)
+	if !model.confirm.IsVisible() {
+		t.Fatal("expected reopen prompt for a resolved incident")
+	}
+	if cmd != nil {
+		t.Error("expected no command before confirmation")
+	}
+
+	// Confirming dispatches the reopen command
+	newModel, cmd = model.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	model = newModel.(Model)
+	if cmd == nil {
+		t.Fatal("expected reopen command after confirmation")
+	}
+	if model.confirm.IsVisible() {
+		t.Error("expected prompt to close after confirmation")
+	}
+	msg, ok := cmd().(IncidentReopenedMsg)
+	if !ok {
+		t.Fatal("expected IncidentReopenedMsg")
+	}
+	if msg.ID != "inc_2" {
+		t.Errorf("expected reopen for inc_2, got %q", msg.ID)
+	}
+}
+
+func TestModelIncidentReopened(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", SequentialID: "INC-1", Status: "resolved"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	newModel, cmd := m.Update(IncidentReopenedMsg{
+		ID:       "inc_1",
+		Incident: &api.Incident{ID: "inc_1", SequentialID: "INC-1", Status: "started"},
+		Index:    0,
+	})
+	model := newModel.(Model)
+
+	if got := model.incidents.SelectedIncident().Status; got != "started" {
+		t.Errorf("expected row status 'started', got %q", got)
+	}
+	if !model.incidents.IsLoadingIncident("inc_1") {
+		t.Error("expected detail to be reloaded")
+	}
+	if cmd == nil {
+		t.Error("expected detail reload command")
+	}
+	if !strings.Contains(model.statusMsg, "INC-1") {
+		t.Errorf("expected status message to mention INC-1, got %q", model.statusMsg)
+	}
+}
+
+func TestModelAutoLoadDetailsDebounce(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.cfg = &config.Config{AutoLoadDetails: true}
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", SequentialID: "INC-1"},
+		{ID: "inc_2", SequentialID: "INC-2"},
+		{ID: "inc_3", SequentialID: "INC-3"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	// Scroll quickly through the list; each move schedules a debounce timer
+	var model tea.Model = m
+	for i := 0; i < 2; i++ {
+		var cmd tea.Cmd
+		model, cmd = model.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+		if cmd == nil {
+			t.Fatal("expected debounce command after selection change")
+		}
+	}
+
+	// The timer for the row we scrolled past fires, but the selection has moved on
+	model, cmd := model.Update(DetailDebounceMsg{Tab: TabIncidents, ID: "inc_2"})
+	if cmd != nil {
+		t.Error("expected no fetch for a stale selection")
+	}
+	if model.(Model).incidents.IsDetailLoading() {
+		t.Error("expected no detail loading for a stale selection")
+	}
+
+	// The timer for the final selection fetches its detail
+	model, cmd = model.Update(DetailDebounceMsg{Tab: TabIncidents, ID: "inc_3"})
+	if cmd == nil {
+		t.Error("expected fetch for the final selection")
+	}
+	if !model.(Model).incidents.IsLoadingIncident("inc_3") {
+		t.Error("expected detail loading for inc_3")
+	}
+
+	// Detail arriving does not steal focus from the list
+	model, _ = model.Update(IncidentDetailLoadedMsg{
+		Incident: &api.Incident{ID: "inc_3", SequentialID: "INC-3", DetailLoaded: true},
+		Index:    2,
+	})
+	if model.(Model).incidents.IsDetailFocused() {
+		t.Error("expected list to keep focus when details auto-load")
+	}
+}
+
+func TestModelAutoLoadDetailsDisabled(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.cfg = &config.Config{}
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", SequentialID: "INC-1"},
+		{ID: "inc_2", SequentialID: "INC-2"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	_, cmd := m.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	if cmd != nil {
+		if _, ok := cmd().(DetailDebounceMsg); ok {
+			t.Error("expected no debounce when auto-load is disabled")
+		}
+	}
+}
+
+func TestModelOnCallFilter(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", SequentialID: "INC-1", Services: []string{"payments"}},
+		{ID: "inc_2", SequentialID: "INC-2", Services: []string{"web"}},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	// First toggle resolves scopes
+	newModel, cmd := m.Update(tea.KeyPressMsg{Code: 'O', Text: "O"})
+	if cmd == nil {
+		t.Fatal("expected command to resolve on-call scopes")
+	}
+	model := newModel.(Model)
+
+	newModel, _ = model.Update(OnCallScopesLoadedMsg{Scopes: &api.OnCallScopes{Services: []string{"payments"}}})
+	model = newModel.(Model)
+	if !model.incidents.IsOnCallOnly() {
+		t.Error("expected on-call filter to be active after scopes load")
+	}
+
+	// Toggling off and on again reuses the session's scopes
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: 'O', Text: "O"})
+	model = newModel.(Model)
+	if model.incidents.IsOnCallOnly() {
+		t.Error("expected on-call filter to be cleared")
+	}
+	newModel, cmd = model.Update(tea.KeyPressMsg{Code: 'O', Text: "O"})
+	model = newModel.(Model)
+	if cmd != nil {
+		t.Error("expected cached scopes to be reused")
+	}
+	if !model.incidents.IsOnCallOnly() {
+		t.Error("expected on-call filter to be re-enabled")
+	}
+}
+
+func TestModelScopeCycle(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", SequentialID: "INC-1", Teams: []string{"Platform"}, CreatedByEmail: "jane@example.com"},
+		{ID: "inc_2", SequentialID: "INC-2", Teams: []string{"Platform"}},
+		{ID: "inc_3", SequentialID: "INC-3", Teams: []string{"Mobile"},
+			Roles: []api.IncidentRole{{Name: "Commander", UserName: "Jane Smith", UserEmail: "JANE@example.com"}}},
+		{ID: "inc_4", SequentialID: "INC-4", Teams: []string{"Mobile"}},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	ids := func(model Model) string {
+		var got []string
+		for _, inc := range model.incidents.Incidents() {
+			got = append(got, inc.ID)
+		}
+		return strings.Join(got, ",")
+	}
+
+	// First press resolves the user and team
+	newModel, cmd := m.Update(tea.KeyPressMsg{Code: 'U', Text: "U"})
+	model := newModel.(Model)
+	if cmd == nil {
+		t.Fatal("expected command to resolve the user and team")
+	}
+	user := &api.User{ID: "42", Name: "Jane Smith", Email: "jane@example.com"}
+	newModel, _ = model.Update(ScopeResolvedMsg{User: user, Team: "Platform"})
+	model = newModel.(Model)
+	if model.scope != scopeMyTeam || ids(model) != "inc_1,inc_2" {
+		t.Errorf("expected my team scope (inc_1,inc_2), got scope %d: %s", model.scope, ids(model))
+	}
+
+	// Leaving my team reloads without the API team filter, reusing the resolved user
+	newModel, cmd = model.Update(tea.KeyPressMsg{Code: 'U', Text: "U"})
+	model = newModel.(Model)
+	if cmd == nil || model.statusMsg == i18n.T("scope.resolving") {
+		t.Error("expected the unfiltered list to be reloaded with the resolved user")
+	}
+	if model.scope != scopeMine || ids(model) != "inc_1,inc_3" {
+		t.Errorf("expected mine scope (inc_1,inc_3), got scope %d: %s", model.scope, ids(model))
+	}
+	if !strings.Contains(model.incidents.View(), i18n.T("scope.mine")) {
+		t.Error("expected mine scope in the list title")
+	}
+
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: 'U', Text: "U"})
+	model = newModel.(Model)
+	if model.scope != scopeAll || ids(model) != "inc_1,inc_2,inc_3,inc_4" {
+		t.Errorf("expected all scope, got scope %d: %s", model.scope, ids(model))
+	}
+
+	// Without a team, my team is skipped
+	model.scopeTeam = ""
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: 'U', Text: "U"})
+	model = newModel.(Model)
+	if model.scope != scopeMine || model.statusMsg != i18n.T("scope.no_team") {
+		t.Errorf("expected mine scope with no-team notice, got scope %d, status %q", model.scope, model.statusMsg)
+	}
+}
+
+func TestModelOnCallFilterNotOnCall(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+
+	newModel, _ := m.Update(OnCallScopesLoadedMsg{Scopes: &api.OnCallScopes{}})
+	model := newModel.(Model)
+	if model.incidents.IsOnCallOnly() {
+		t.Error("expected filter to stay off when not on call")
+	}
+	if model.statusMsg == "" {
+		t.Error("expected status message explaining the user is not on call")
+	}
+}
+
+func TestModelConnectionStatus(t *testing.T) {
+	m := New("1.2.3")
+	m.screen = ScreenMain
+	m.width = 160
+	m.height = 40
+
+	if !strings.Contains(m.renderHeader(), i18n.T("app.connection.loading")) {
+		t.Error("expected a loading indicator before any list loads")
+	}
+
+	newModel, _ := m.Update(IncidentsLoadedMsg{Pagination: api.PaginationInfo{CurrentPage: 1}})
+	model := newModel.(Model)
+	if model.conn != connOK || !strings.Contains(model.renderHeader(), i18n.T("app.connection.ok")) {
+		t.Errorf("expected connected after a list loads, got %v", model.conn)
+	}
+
+	// Repeated failures stay red until a list loads again
+	for range 2 {
+		newModel, _ = model.Update(AlertsLoadedMsg{Err: errors.New("connection refused")})
+		model = newModel.(Model)
+	}
+	if model.conn != connError || !strings.Contains(model.renderHeader(), i18n.T("app.connection.error")) {
+		t.Errorf("expected error after failed loads, got %v", model.conn)
+	}
+	newModel, _ = model.Update(ServicesLoadedMsg{})
+	if newModel.(Model).conn != connOK {
+		t.Error("expected a successful refresh to clear the error")
+	}
+}
+
+func TestModelTabCountBadges(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.width = 160
+
+	// Tab labels are rendered uppercase
+	incidents := strings.ToUpper(i18n.T("incidents.title"))
+	alerts := strings.ToUpper(i18n.T("alerts.title"))
+	if header := ansi.Strip(m.renderHeader()); strings.Contains(header, incidents+" (") || strings.Contains(header, alerts+" (") {
+		t.Errorf("expected no badges for empty pages, got %q", header)
+	}
+
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", Status: "started"},
+		{ID: "inc_2", Status: "mitigated"},
+		{ID: "inc_3", Status: "resolved"},
+	}, api.PaginationInfo{CurrentPage: 1})
+	m.alerts.SetAlerts([]api.Alert{
+		{ID: "alert_1", Status: "triggered"},
+		{ID: "alert_2", Status: "acknowledged"},
+	}, api.PaginationInfo{CurrentPage: 1})
+	header := ansi.Strip(m.renderHeader())
+	if !strings.Contains(header, incidents+" (2)") {
+		t.Errorf("expected 2 active incidents in the tab label, got %q", header)
+	}
+	if !strings.Contains(header, alerts+" (1)") {
+		t.Errorf("expected 1 triggered alert in the tab label, got %q", header)
+	}
+
+	// A page with nothing active shows no (0)
+	m.alerts.SetAlertStatus("alert_1", "resolved")
+	if header := ansi.Strip(m.renderHeader()); strings.Contains(header, alerts+" (") {
+		t.Errorf("expected no alerts badge without triggered alerts, got %q", header)
+	}
+}
+
+func TestModelPresentMode(t *testing.T) {
+	m := New("1.2.3")
+	m.screen = ScreenMain
+	m.width = 120
+	m.height = 40
+	m.cfg = &config.Config{APIKey: "secret-key", Endpoint: "rootly.internal.example.com"}
+	m.errorMsg = "request to https://rootly.internal.example.com/v1/incidents failed"
+
+	if !strings.Contains(m.renderHeader(), "v1.2.3") {
+		t.Fatal("expected version in header outside present mode")
+	}
+
+	newModel, _ := m.Update(tea.KeyPressMsg{Code: 'H', Text: "H"})
+	model := newModel.(Model)
+	if !model.presentMode {
+		t.Fatal("expected present mode to be enabled")
+	}
+
+	if strings.Contains(model.renderHeader(), "1.2.3") {
+		t.Error("expected version to be hidden in present mode")
+	}
+	if strings.Contains(model.renderStatusBar(), "rootly.internal.example.com") {
+		t.Error("expected endpoint to be hidden in present mode")
+	}
+
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: 'H', Text: "H"})
+	model = newModel.(Model)
+	if model.presentMode {
+		t.Error("expected present mode to be disabled")
+	}
+}
+
+func TestParseTab(t *testing.T) {
+	tests := []struct {
+		name string
+		want Tab
+		ok   bool
+	}{
+		{"incidents", TabIncidents, true},
+		{"alerts", TabAlerts, true},
+		{" Services ", TabServices, true},
+		{"", TabIncidents, false},
+		{"oncall", TabIncidents, false},
+	}
+	for _, tt := range tests {
+		if got, ok := ParseTab(tt.name); got != tt.want || ok != tt.ok {
+			t.Errorf("ParseTab(%q) = %v, %v; expected %v, %v", tt.name, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestModelSetStartTab(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	if m.activeTab != TabIncidents {
+		t.Fatalf("expected incidents by default, got %v", m.activeTab)
+	}
+
+	m.SetStartTab(TabAlerts)
+	if m.activeTab != TabAlerts || m.services.Requested() {
+		t.Errorf("expected the alerts tab without loading services, got %v", m.activeTab)
+	}
+
+	m.SetStartTab(TabServices)
+	if m.activeTab != TabServices || !m.services.Requested() {
+		t.Error("expected the services tab to load services with the initial data")
+	}
+}
+
+func TestModelRelativeTimes(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.cfg = &config.Config{APIKey: "test-key", Endpoint: "api.rootly.com"}
+	if err := config.Save(m.cfg); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	m.incidents.SetDimensions(120, 40)
+	created := time.Now().Add(-12 * time.Minute)
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", SequentialID: "INC-1", Title: "Checkout latency", CreatedAt: created},
+	}, api.PaginationInfo{CurrentPage: 1})
+	// The list's time column is always relative, so look for the detail's date
+	date := created.Local().Format("Jan 2, 2006")
+
+	if !strings.Contains(m.incidents.View(), date) {
+		t.Fatal("expected absolute times by default")
+	}
+
+	newModel, _ := m.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+	model := newModel.(Model)
+	if !model.relativeTimes || !model.cfg.RelativeTimes {
+		t.Fatal("expected relative times to be enabled and recorded in config")
+	}
+	if strings.Contains(model.incidents.View(), date) {
+		t.Error("expected the incident detail to show relative times")
+	}
+	if saved, err := config.Load(); err != nil || !saved.RelativeTimes {
+		t.Errorf("expected relative times saved to config, got %+v (%v)", saved, err)
+	}
+
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+	model = newModel.(Model)
+	if model.relativeTimes || !strings.Contains(model.incidents.View(), date) {
+		t.Error("expected t to switch back to absolute times")
+	}
+}
+
+func TestModelTinyWindow(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.initialLoading = false
+
+	newModel, _ := m.Update(tea.WindowSizeMsg{Width: 10, Height: 3})
+	model := newModel.(Model)
+
+	// Must not panic and should explain why nothing is shown
+	view := model.View()
+	if !strings.Contains(view.Content, "Window too small") {
+		t.Errorf("expected window too small notice, got %q", view.Content)
+	}
+}
+
+func TestModelHideHelpBar(t *testing.T) {
+	render := func(cfg *config.Config) Model {
+		m := New("1.0.0")
+		m.screen = ScreenMain
+		m.initialLoading = false
+		m.cfg = cfg
+		newModel, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+		return newModel.(Model)
+	}
+
+	shown := render(&config.Config{})
+	hidden := false
+	hiddenModel := render(&config.Config{ShowHelpBar: &hidden})
+
+	quitHint := i18n.T("helpbar.quit")
+	if !strings.Contains(shown.View().Content, quitHint) {
+		t.Error("expected help bar by default")
+	}
+	if strings.Contains(hiddenModel.View().Content, quitHint) {
+		t.Error("expected help bar to be hidden when show_help_bar is false")
+	}
+	if hiddenModel.listHeight() <= shown.listHeight() {
+		t.Errorf("expected list to reclaim the help bar lines, got %d <= %d", hiddenModel.listHeight(), shown.listHeight())
+	}
+	if hiddenModel.incidents.FitPageSize() <= shown.incidents.FitPageSize() {
+		t.Error("expected more rows to fit without the help bar")
+	}
+}
+
+func TestModelCopyContactWithoutLead(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", SequentialID: "INC-1", DetailLoaded: true},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	newModel, _ := m.Update(tea.KeyPressMsg{Code: 'C', Text: "C"})
+	model := newModel.(Model)
+	if model.statusMsg != i18n.T("contact.none") {
+		t.Errorf("expected no-contact status, got %q", model.statusMsg)
+	}
+}
+
+func TestModelSummaryOverlay(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.cfg = &config.Config{Timezone: "UTC"}
+
+	newModel, cmd := m.Update(tea.KeyPressMsg{Code: 'D', Text: "D"})
+	model := newModel.(Model)
+	if !model.summary.Visible {
+		t.Fatal("expected summary overlay to be visible")
+	}
+	if cmd == nil {
+		t.Fatal("expected command to load the summary")
+	}
+
+	now := time.Now()
+	newModel, _ = model.Update(IncidentSummaryLoadedMsg{CreatedAt: []time.Time{now, now}})
+	model = newModel.(Model)
+	if !strings.Contains(model.summary.View(), "Total: 2") {
+		t.Error("expected summary to show loaded counts")
+	}
+
+	// Other keys are swallowed while the overlay is open
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	model = newModel.(Model)
+	if !model.summary.Visible {
+		t.Error("expected summary overlay to stay open")
+	}
+
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyEsc})
+	model = newModel.(Model)
+	if model.summary.Visible {
+		t.Error("expected Esc to close the summary overlay")
+	}
+}
+
+func TestModelAcknowledgeAllVisible(t *testing.T) {
+	var mu sync.Mutex
+	requested := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/alerts/"), "/acknowledge")
+		mu.Lock()
+		requested[id]++
+		mu.Unlock()
+		if id == "alert_3" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := api.NewClient(&config.Config{APIKey: "test", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabAlerts
+	m.apiClient = client
+	m.alerts.SetAlerts([]api.Alert{
+		{ID: "alert_1", ShortID: "AAA111", Status: "triggered"},
+		{ID: "alert_2", ShortID: "BBB222", Status: "acknowledged"},
+		{ID: "alert_3", ShortID: "CCC333", Status: "triggered"},
+		{ID: "alert_4", ShortID: "DDD444", Status: "triggered"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	newModel, _ := m.Update(tea.KeyPressMsg{Code: 'K', Text: "K"})
+	model := newModel.(Model)
+	if !model.confirm.IsVisible() {
+		t.Fatal("expected confirmation prompt")
+	}
+	newModel, cmd := model.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	model = newModel.(Model)
+	if cmd == nil {
+		t.Fatal("expected acknowledge command after confirmation")
+	}
+
+	batch, ok := cmd().(tea.BatchMsg)
+	if !ok {
+		t.Fatal("expected a batch of acknowledge requests")
+	}
+	for _, c := range batch {
+		newModel, _ = model.Update(c())
+		model = newModel.(Model)
+	}
+
+	if len(requested) != 3 || requested["alert_2"] != 0 {
+		t.Errorf("expected one request per triggered alert, got %v", requested)
+	}
+	for _, id := range []string{"alert_1", "alert_3", "alert_4"} {
+		if requested[id] != 1 {
+			t.Errorf("expected one request for %s, got %d", id, requested[id])
+		}
+	}
+
+	statuses := map[string]string{}
+	for _, alert := range model.alerts.TriggeredAlerts() {
+		statuses[alert.ID] = alert.Status
+	}
+	if len(statuses) != 1 || statuses["alert_3"] != "triggered" {
+		t.Errorf("expected only the failed alert to stay triggered, got %v", statuses)
+	}
+	if model.statusMsg != i18n.Tf("alerts.ack_all_progress", map[string]any{"Done": 2, "Total": 3}) {
+		t.Errorf("unexpected status %q", model.statusMsg)
+	}
+	if !strings.Contains(model.errorMsg, "CCC333") {
+		t.Errorf("expected failed ID in error, got %q", model.errorMsg)
+	}
+}
+
+func TestModelThemeDetection(t *testing.T) {
+	original := hasDarkBackground
+	defer func() {
+		hasDarkBackground = original
+		styles.SetTheme(styles.ThemeDark)
+	}()
+
+	hasDarkBackground = func() bool { return false }
+	New("1.0.0")
+	if styles.CurrentTheme() != styles.ThemeLight || styles.ColorText != styles.LightPalette.Text {
+		t.Errorf("expected light palette for a light background, got %s", styles.CurrentTheme())
+	}
+
+	hasDarkBackground = func() bool { return true }
+	New("1.0.0")
+	if styles.CurrentTheme() != styles.ThemeDark || styles.ColorText != styles.DarkPalette.Text {
+		t.Errorf("expected dark palette for a dark background, got %s", styles.CurrentTheme())
+	}
+}
+
+func TestModelInFlightCounter(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+
+	// A refresh starts two background loads
+	var model tea.Model = m
+	model, _ = model.Update(BackgroundStartedMsg{})
+	model, _ = model.Update(BackgroundStartedMsg{})
+	if got := model.(Model).inFlight; got != 2 {
+		t.Fatalf("expected 2 in-flight requests, got %d", got)
+	}
+	if !strings.Contains(model.(Model).renderStatusBar(), "⟳ 2") {
+		t.Error("expected activity indicator in status bar")
+	}
+
+	model, _ = model.Update(IncidentsLoadedMsg{Pagination: api.PaginationInfo{CurrentPage: 1}})
+	if got := model.(Model).inFlight; got != 1 {
+		t.Errorf("expected 1 in-flight request after incidents load, got %d", got)
+	}
+
+	model, _ = model.Update(AlertsLoadedMsg{Pagination: api.PaginationInfo{CurrentPage: 1}})
+	if got := model.(Model).inFlight; got != 0 {
+		t.Errorf("expected no in-flight requests after alerts load, got %d", got)
+	}
+	if strings.Contains(model.(Model).renderStatusBar(), "⟳") {
+		t.Error("expected activity indicator to disappear when idle")
+	}
+
+	// Results that weren't counted never drive the counter negative
+	model, _ = model.Update(TeamsLoadedMsg{})
+	if got := model.(Model).inFlight; got != 0 {
+		t.Errorf("expected counter to stay at 0, got %d", got)
+	}
+}
+
+func TestModelAutoPageSize(t *testing.T) {
+	cfg := &config.Config{APIKey: "test", Endpoint: "localhost:1", AutoPageSize: true}
+	client, err := api.NewClient(cfg)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.cfg = cfg
+	m.apiClient = client
+
+	newModel, cmd := m.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	model := newModel.(Model)
+	short := client.PageSize()
+	if short == api.DefaultPageSize {
+		t.Fatalf("expected page size fitted to the terminal, got default %d", short)
+	}
+	if cmd == nil {
+		t.Error("expected lists to be re-fetched with the fitted page size")
+	}
+
+	newModel, cmd = model.Update(tea.WindowSizeMsg{Width: 160, Height: 80})
+	model = newModel.(Model)
+	tall := client.PageSize()
+	if tall <= short {
+		t.Errorf("expected taller terminal to yield a larger page size, got %d <= %d", tall, short)
+	}
+	if cmd == nil {
+		t.Error("expected lists to be re-fetched after a significant resize")
+	}
+	if got := model.incidents.FitPageSize(); got != tall {
+		t.Errorf("expected table to fit %d rows, got %d", tall, got)
+	}
+
+	// A one-row change is not worth a re-fetch
+	_, cmd = model.Update(tea.WindowSizeMsg{Width: 160, Height: 81})
+	if cmd != nil {
+		t.Error("expected no re-fetch for a small resize")
+	}
+	if client.PageSize() != tall {
+		t.Errorf("expected page size to stay %d, got %d", tall, client.PageSize())
+	}
+}
+
+func TestModelAutoPageSizeDisabled(t *testing.T) {
+	cfg := &config.Config{APIKey: "test", Endpoint: "localhost:1"}
+	client, err := api.NewClient(cfg)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.cfg = cfg
+	m.apiClient = client
+
+	_, cmd := m.Update(tea.WindowSizeMsg{Width: 160, Height: 80})
+	if cmd != nil {
+		t.Error("expected no re-fetch without auto_page_size")
+	}
+	if client.PageSize() != api.DefaultPageSize {
+		t.Errorf("expected default page size, got %d", client.PageSize())
+	}
+}
+
+func TestSaveIncidentHTML(t *testing.T) {
+	dir := t.TempDir()
+	orig := snapshotDir
+	snapshotDir = func() string { return dir }
+	defer func() { snapshotDir = orig }()
+
+	inc := &api.Incident{ID: "inc_1", SequentialID: "INC-7", Title: "Outage"}
+	path, err := saveIncidentHTML(inc, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := filepath.Join(dir, "INC-7-20260102-030405.html"); path != want {
+		t.Errorf("expected path %q, got %q", want, path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected snapshot file: %v", err)
+	}
+	if !strings.Contains(string(data), "[INC-7] Outage") {
+		t.Error("expected snapshot to contain the incident heading")
+	}
+}
+
+func TestModelInfiniteScrollAppends(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.cfg = &config.Config{InfiniteScroll: true}
+	m.incidents.SetDimensions(120, 30)
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", SequentialID: "INC-1"},
+		{ID: "inc_2", SequentialID: "INC-2"},
+	}, api.PaginationInfo{CurrentPage: 1, HasNext: true})
+
+	down := tea.KeyPressMsg{Code: 'j', Text: "j"}
+	newModel, cmd := m.Update(down)
+	model := newModel.(Model)
+	if cmd == nil || !model.incidents.IsAppending() {
+		t.Fatal("expected reaching the last row to fetch the next page")
+	}
+	if got := model.incidents.NextAppendPage(); got != 2 {
+		t.Errorf("expected next page 2, got %d", got)
+	}
+
+	// A second press while the fetch is in flight must not queue another
+	newModel, _ = model.Update(down)
+	model = newModel.(Model)
+	if model.statusMsg != i18n.T("incidents.loading_more") || !model.incidents.IsAppending() {
+		t.Error("expected the in-flight append to be kept")
+	}
+
+	newModel, _ = model.Update(IncidentsAppendedMsg{
+		Incidents: []api.Incident{
+			{ID: "inc_2", SequentialID: "INC-2"},
+			{ID: "inc_3", SequentialID: "INC-3"},
+		},
+		Pagination: api.PaginationInfo{CurrentPage: 2, HasNext: true},
+	})
+	model = newModel.(Model)
+
+	var ids []string
+	for _, inc := range model.incidents.Incidents() {
+		ids = append(ids, inc.ID)
+	}
+	if got := strings.Join(ids, ","); got != "inc_1,inc_2,inc_3" {
+		t.Errorf("expected next page appended without duplicates, got %s", got)
+	}
+	if model.incidents.SelectedIndex() != 1 {
+		t.Errorf("expected cursor to stay on inc_2, got index %d", model.incidents.SelectedIndex())
+	}
+	if model.incidents.CurrentPage() != 1 || model.incidents.NextAppendPage() != 3 {
+		t.Errorf("expected list to start at page 1 with page 3 next, got %d/%d",
+			model.incidents.CurrentPage(), model.incidents.NextAppendPage())
+	}
+
+	// A failed fetch can be retried by scrolling down again
+	newModel, _ = model.Update(down)
+	model = newModel.(Model)
+	newModel, _ = model.Update(IncidentsAppendedMsg{Err: errors.New("timeout")})
+	model = newModel.(Model)
+	if model.incidents.IsAppending() || model.errorMsg != "timeout" {
+		t.Errorf("expected failed append to be cleared with an error, got %q", model.errorMsg)
+	}
+	if len(model.incidents.Incidents()) != 3 {
+		t.Error("expected loaded rows to be kept after a failed append")
+	}
+}
+
+func TestModelOpenRunbook(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	var opened string
+	m.urlOpener = func(url string) error { opened = url; return nil }
+	m.incidents.SetIncidents([]api.Incident{
+		{
+			ID:      "inc_1",
+			Labels:  map[string]string{"runbook_url": "https://wiki.example.com/runbooks/db"},
+			Summary: "Dashboard: https://grafana.example.com/d/db",
+		},
+		{ID: "inc_2", Summary: "No links"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	runbook := tea.KeyPressMsg{Code: 'b', Text: "b"}
+	newModel, _ := m.Update(runbook)
+	model := newModel.(Model)
+	if opened != "https://wiki.example.com/runbooks/db" {
+		t.Errorf("expected runbook label to be opened, got %q", opened)
+	}
+
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	model = newModel.(Model)
+	opened = ""
+	newModel, _ = model.Update(runbook)
+	model = newModel.(Model)
+	if opened != "" || model.statusMsg != i18n.T("incidents.no_runbook") {
+		t.Errorf("expected no-runbook notice, got opened %q, status %q", opened, model.statusMsg)
+	}
+}
+
+func TestModelFocusStartsWatchMode(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/incidents", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"id":"inc_123","attributes":{"sequential_id":123,"updated_at":"2026-01-02T03:04:05Z"}}]}`))
+	})
+	mux.HandleFunc("/v1/incidents/inc_123", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"id":"inc_123","attributes":{"sequential_id":123,"title":"Checkout outage","status":"started"}}}`))
+	})
+	mux.HandleFunc("/v1/incidents/inc_123/action_items", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := api.NewClient(&config.Config{APIKey: "test", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.apiClient = client
+	m.SetFocus("INC-123")
+	if m.screen != ScreenWatch || m.Init() == nil {
+		t.Fatal("expected focus to start in watch mode and load the incident")
+	}
+
+	msg := fetchFocusIncident(client, m.focusRef, m.requestTimeout())()
+	newModel, cmd := m.Update(msg)
+	model := newModel.(Model)
+	if cmd == nil {
+		t.Error("expected the next refresh to be scheduled")
+	}
+	inc := model.incidents.SelectedIncident()
+	if inc == nil || inc.ID != "inc_123" || !inc.DetailLoaded {
+		t.Fatalf("expected resolved incident inc_123 with detail, got %+v", inc)
+	}
+	model.width, model.height = 120, 40
+	if !strings.Contains(model.renderWatchView(), "Checkout outage") {
+		t.Error("expected the watched incident's detail on screen")
+	}
+
+	_, cmd = model.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if cmd == nil {
+		t.Fatal("expected esc to quit")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("expected esc to quit the program")
+	}
+}
+
+func TestModelRequestTimeout(t *testing.T) {
+	// The server never answers; the request is abandoned when its context expires
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client, err := api.NewClient(&config.Config{APIKey: "test", Endpoint: server.URL, MaxRetries: -1})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	start := time.Now()
+	msg := fetchFocusIncident(client, "INC-123", time.Second)().(FocusIncidentLoadedMsg)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the request to stop at its timeout, took %v", elapsed)
+	}
+	var timeoutErr timeoutError
+	if !errors.As(msg.Err, &timeoutErr) || !strings.Contains(msg.Err.Error(), "timed out after 1s") {
+		t.Fatalf("expected a timeout error, got %v", msg.Err)
+	}
+
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.loading = true
+	newModel, _ := m.Update(AlertsLoadedMsg{Err: msg.Err})
+	model := newModel.(Model)
+	if model.loading {
+		t.Error("expected loading to stop when the alerts load fails")
+	}
+	if !strings.Contains(model.errorMsg, "timed out") {
+		t.Errorf("expected the timeout in the status bar, got %q", model.errorMsg)
+	}
+}
+
+func TestRequestError(t *testing.T) {
+	if requestError(nil, time.Second) != nil {
+		t.Error("expected nil for no error")
+	}
+	other := errors.New("API returned status 500")
+	if got := requestError(other, time.Second); got != other {
+		t.Errorf("expected other errors unchanged, got %v", got)
+	}
+	wrapped := fmt.Errorf("failed to list incidents: %w", context.DeadlineExceeded)
+	got := requestError(wrapped, 15*time.Second)
+	if got.Error() != "request timed out after 15s" || !errors.Is(got, context.DeadlineExceeded) {
+		t.Errorf("expected a timeout error wrapping the deadline, got %v", got)
+	}
+}
+
+func TestModelFocusRequiresConfig(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenSetup
+	m.SetFocus("INC-123")
+	if m.screen != ScreenSetup {
+		t.Error("expected setup to run before watch mode")
+	}
+}
+
+func TestModelPermalinkCommand(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	if got := m.permalinkCommand(); got != "" {
+		t.Errorf("expected no permalink without a selection, got %q", got)
+	}
+
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", SequentialID: "INC-123", Title: "Outage"},
+	}, api.PaginationInfo{CurrentPage: 1})
+	if got := m.permalinkCommand(); got != "rootly-tui --open INC-123" {
+		t.Errorf("expected --open with the sequential ID, got %q", got)
+	}
+
+	m.activeTab = TabAlerts
+	if got := m.permalinkCommand(); got != "" {
+		t.Errorf("expected no incident permalink on the alerts tab, got %q", got)
+	}
+
+	if got := shellQuote("it's"); got != `'it'\''s'` {
+		t.Errorf("expected quoted reference, got %q", got)
+	}
+}
+
+func TestModelOpenSelectsIncidentAfterLoad(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.SetOpen("INC-9")
+
+	newModel, cmd := m.Update(IncidentsLoadedMsg{
+		Incidents:  []api.Incident{{ID: "inc_1", SequentialID: "INC-1"}},
+		Pagination: api.PaginationInfo{CurrentPage: 1},
+	})
+	model := newModel.(Model)
+	if cmd == nil || model.openRef != "" {
+		t.Fatal("expected the --open incident to be fetched once the list loaded")
+	}
+
+	newModel, _ = model.Update(OpenIncidentLoadedMsg{
+		Incident: &api.Incident{ID: "inc_9", SequentialID: "INC-9", DetailLoaded: true},
+	})
+	model = newModel.(Model)
+	if sel := model.incidents.SelectedIncident(); sel == nil || sel.ID != "inc_9" {
+		t.Errorf("expected INC-9 to be added and selected, got %+v", sel)
+	}
+}
+
+func TestModelAlertGroupHeaderOpensIncident(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabAlerts
+	m.alerts.SetAlerts([]api.Alert{
+		{ID: "a1", IncidentID: "inc_7", IncidentSeqID: "INC-7"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	newModel, _ := m.Update(tea.KeyPressMsg{Code: 'i', Text: "i"})
+	model := newModel.(Model)
+	if !model.alerts.IsGroupedByIncident() {
+		t.Fatal("expected i to group alerts by incident")
+	}
+
+	// The cursor follows a1; move up onto its incident's header
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: 'k', Text: "k"})
+	newModel, cmd := newModel.(Model).Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	model = newModel.(Model)
+	if model.activeTab != TabIncidents || cmd == nil {
+		t.Errorf("expected Enter on the header to open INC-7 on the incidents tab")
+	}
+}
+
+func TestModelConfirmWrites(t *testing.T) {
+	reopen := func(cfg *config.Config) (Model, tea.Cmd) {
+		m := New("1.0.0")
+		m.screen = ScreenMain
+		m.cfg = cfg
+		m.incidents.SetIncidents([]api.Incident{
+			{ID: "inc_1", SequentialID: "INC-1", Status: "resolved"},
+		}, api.PaginationInfo{CurrentPage: 1})
+		newModel, cmd := m.Update(tea.KeyPressMsg{Code: 'R', Text: "R"})
+		return newModel.(Model), cmd
+	}
+
+	model, cmd := reopen(&config.Config{})
+	if cmd != nil {
+		t.Error("expected the reopen command to wait for confirmation")
+	}
+	if !model.confirm.IsVisible() {
+		t.Fatal("expected confirmation prompt by default")
+	}
+	if _, cmd = model.Update(tea.KeyPressMsg{Code: 'y', Text: "y"}); cmd == nil {
+		t.Error("expected the reopen command to run after y")
+	}
+
+	off := false
+	model, cmd = reopen(&config.Config{ConfirmWrites: &off})
+	if model.confirm.IsVisible() || cmd == nil {
+		t.Error("expected confirm_writes: false to run the reopen command right away")
+	}
+}
+
+func TestModelWatchLogsChanges(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.SetFocus("INC-5")
+	m.width, m.height = 120, 40
+
+	snapshot := api.Incident{ID: "inc_5", SequentialID: "INC-5", Title: "Checkout outage", Status: "started", DetailLoaded: true,
+		Roles: []api.IncidentRole{{Name: "Commander"}}}
+	newModel, _ := m.Update(FocusIncidentLoadedMsg{Incident: &snapshot})
+	model := newModel.(Model)
+	if len(model.watchLog) != 0 {
+		t.Fatalf("expected no changes for the first snapshot, got %v", model.watchLog)
+	}
+
+	updated := snapshot
+	updated.Status = "mitigated"
+	updated.Roles = []api.IncidentRole{{Name: "Commander", UserName: "Jane"}}
+	newModel, _ = model.Update(FocusIncidentLoadedMsg{Incident: &updated})
+	model = newModel.(Model)
+
+	if len(model.watchLog) != 2 ||
+		!strings.HasSuffix(model.watchLog[0], " status → mitigated") ||
+		!strings.HasSuffix(model.watchLog[1], " Jane assigned as Commander") {
+		t.Fatalf("expected status and role changes in the log, got %v", model.watchLog)
+	}
+	if !strings.Contains(model.renderWatchView(), "Jane assigned as Commander") {
+		t.Error("expected the Updates panel to show the change")
+	}
+}
+
+func TestModelCopyServices(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", SequentialID: "INC-1", Services: []string{"api", "checkout"}},
+		{ID: "inc_2", SequentialID: "INC-2"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	if got := strings.Join(m.selectedServices(), ", "); got != "api, checkout" {
+		t.Errorf("expected the selected incident's services, got %q", got)
+	}
+
+	m.alerts.SetAlerts([]api.Alert{{ID: "a1", Services: []string{"payments"}}}, api.PaginationInfo{CurrentPage: 1})
+	m.activeTab = TabAlerts
+	if got := strings.Join(m.selectedServices(), ", "); got != "payments" {
+		t.Errorf("expected the selected alert's services, got %q", got)
+	}
+
+	m.alerts.SetAlerts([]api.Alert{{ID: "a2"}}, api.PaginationInfo{CurrentPage: 1})
+	newModel, _ := m.Update(tea.KeyPressMsg{Code: 'V', Text: "V"})
+	if got := newModel.(Model).statusMsg; got != i18n.T("services.none") {
+		t.Errorf("expected a message when there are no services, got %q", got)
+	}
+}
+
+func TestModelSearchCapturesKeys(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", Title: "Queue backlog"},
+		{ID: "inc_2", Title: "Disk full"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	newModel, _ := m.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	newModel, cmd := newModel.(Model).Update(tea.KeyPressMsg{Code: 'q', Text: "q"})
+	model := newModel.(Model)
+	if cmd != nil {
+		if _, ok := cmd().(tea.QuitMsg); ok {
+			t.Fatal("expected q to be typed into the search prompt, not quit")
+		}
+	}
+	if got := model.incidents.Incidents(); len(got) != 1 || got[0].ID != "inc_1" {
+		t.Errorf("expected the list filtered by the typed query, got %+v", got)
+	}
+}
+
+func TestModelAcknowledgeIncident(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", SequentialID: "INC-1", Status: "started"},
+		{ID: "inc_2", SequentialID: "INC-2", Status: "resolved"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	newModel, cmd := m.Update(tea.KeyPressMsg{Code: 'a', Text: "a"})
+	if cmd == nil {
+		t.Fatal("expected the acknowledge command for an open incident")
+	}
+	model := newModel.(Model)
+
+	newModel, _ = model.Update(IncidentAcknowledgedMsg{
+		ID:       "inc_1",
+		Incident: &api.Incident{ID: "inc_1", SequentialID: "INC-1", Status: "acknowledged"},
+	})
+	model = newModel.(Model)
+	if got := model.incidents.SelectedIncident().Status; got != "acknowledged" {
+		t.Errorf("expected the row status updated, got %q", got)
+	}
+	if !strings.Contains(model.statusMsg, "INC-1") {
+		t.Errorf("expected a status message naming the incident, got %q", model.statusMsg)
+	}
+
+	newModel, _ = model.Update(IncidentAcknowledgedMsg{ID: "inc_1", Err: errors.New("access denied: not a responder")})
+	if got := newModel.(Model).errorMsg; !strings.Contains(got, "not a responder") {
+		t.Errorf("expected the API error in the status bar, got %q", got)
+	}
+
+	model.incidents.SetIncidents([]api.Incident{{ID: "inc_2", Status: "resolved"}}, api.PaginationInfo{CurrentPage: 1})
+	if _, cmd = model.Update(tea.KeyPressMsg{Code: 'a', Text: "a"}); cmd != nil {
+		t.Error("expected no acknowledge for a resolved incident")
+	}
+}
+
+func TestModelAlertStatusActions(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabAlerts
+	m.alerts.SetAlerts([]api.Alert{
+		{ID: "alert_1", ShortID: "ABC1", Status: "triggered"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	if _, cmd := m.Update(tea.KeyPressMsg{Code: 'a', Text: "a"}); cmd == nil {
+		t.Fatal("expected the acknowledge command for a triggered alert")
+	}
+
+	// The row shows the new status while the request is in flight
+	newModel, _ := m.Update(AlertStatusChangingMsg{ID: "alert_1", Status: "acknowledged"})
+	model := newModel.(Model)
+	if got := model.alerts.SelectedAlert().Status; got != "acknowledged" {
+		t.Errorf("expected the optimistic status, got %q", got)
+	}
+
+	// A failure restores the previous status
+	newModel, _ = model.Update(AlertStatusUpdatedMsg{ID: "alert_1", ShortID: "ABC1", Status: "acknowledged", PrevStatus: "triggered", Err: errors.New("access denied")})
+	model = newModel.(Model)
+	if got := model.alerts.SelectedAlert().Status; got != "triggered" {
+		t.Errorf("expected the status reverted, got %q", got)
+	}
+	if !strings.Contains(model.errorMsg, "access denied") {
+		t.Errorf("expected the API error in the status bar, got %q", model.errorMsg)
+	}
+
+	// Success reloads the detail dropped from the cache
+	newModel, cmd := model.Update(AlertStatusUpdatedMsg{ID: "alert_1", ShortID: "ABC1", Status: "resolved", PrevStatus: "triggered"})
+	model = newModel.(Model)
+	if cmd == nil || !model.alerts.IsLoadingAlert("alert_1") {
+		t.Error("expected the alert detail to be reloaded")
+	}
+	if !strings.Contains(model.statusMsg, "ABC1") {
+		t.Errorf("expected a status message naming the alert, got %q", model.statusMsg)
+	}
+
+	// Actions the status doesn't allow are a no-op with a note
+	model.alerts.SetAlertStatus("alert_1", "resolved")
+	for _, k := range []rune{'a', 'R'} {
+		newModel, cmd = model.Update(tea.KeyPressMsg{Code: k, Text: string(k)})
+		if cmd != nil {
+			t.Errorf("%c: expected no request for a resolved alert", k)
+		}
+		if newModel.(Model).statusMsg == "" {
+			t.Errorf("%c: expected a status-bar note", k)
+		}
+	}
+}
+
+func TestModelAutoRefresh(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	if m.scheduleAutoRefresh() != nil {
+		t.Error("expected no auto-refresh without auto_refresh_seconds")
+	}
+
+	m.cfg = &config.Config{AutoRefreshSeconds: 30}
+	if m.scheduleAutoRefresh() == nil {
+		t.Fatal("expected an auto-refresh tick to be scheduled")
+	}
+	if got := m.renderStatusBar(); !strings.Contains(got, "auto-refresh: 30s") {
+		t.Errorf("expected the interval in the status bar, got %q", got)
+	}
+
+	// A tick reloads the active tab and schedules the next one
+	_, cmd := m.Update(AutoRefreshTickMsg{Gen: m.autoRefreshGen})
+	if cmd == nil {
+		t.Error("expected a reload and the next tick")
+	}
+
+	// Ticks from a replaced chain are dropped
+	m.restartAutoRefresh()
+	if _, cmd := m.Update(AutoRefreshTickMsg{Gen: m.autoRefreshGen - 1}); cmd != nil {
+		t.Error("expected a stale tick to be dropped")
+	}
+}
+
+func TestModelAutoRefreshPaused(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.cfg = &config.Config{AutoRefreshSeconds: 30}
+	if m.autoRefreshPaused() {
+		t.Fatal("expected auto-refresh to run on the main screen")
+	}
+
+	m.help.Visible = true
+	if !m.autoRefreshPaused() {
+		t.Error("expected auto-refresh paused while help is open")
+	}
+	m.help.Visible = false
+	m.loading = true
+	if !m.autoRefreshPaused() {
+		t.Error("expected auto-refresh paused while loading")
+	}
+	m.loading = false
+	m.logs.Visible = true
+	if !m.autoRefreshPaused() {
+		t.Error("expected auto-refresh paused while logs are open")
+	}
+}
+
+func TestModelLeaderSequence(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabIncidents
+	m.incidents.SetIncidents([]api.Incident{{ID: "inc_1"}, {ID: "inc_2"}, {ID: "inc_3"}}, api.PaginationInfo{CurrentPage: 1})
+	m.incidents.SetDimensions(160, 50)
+
+	press := func(m Model, r rune) (Model, tea.Cmd) {
+		model, cmd := m.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+		return model.(Model), cmd
+	}
+
+	m, _ = press(m, 'G')
+	if m.incidents.SelectedIndex() != 2 {
+		t.Fatalf("expected the last incident selected, got %d", m.incidents.SelectedIndex())
+	}
+
+	// g waits for the rest of the sequence
+	m, cmd := press(m, 'g')
+	if m.leaderPending != "g" || cmd == nil {
+		t.Fatalf("expected g to wait with a timeout, pending %q", m.leaderPending)
+	}
+	if m.incidents.SelectedIndex() != 2 {
+		t.Error("expected nothing to happen until the sequence completes")
+	}
+	if !strings.Contains(m.renderStatusBar(), "g…") {
+		t.Errorf("expected the pending leader in the status bar, got %q", m.renderStatusBar())
+	}
+
+	// g t goes to the top
+	m, _ = press(m, 't')
+	if m.leaderPending != "" || m.incidents.SelectedIndex() != 0 {
+		t.Errorf("expected gt to go to the top, pending %q index %d", m.leaderPending, m.incidents.SelectedIndex())
+	}
+
+	// A timeout resets the buffer and runs g on its own (go to top)
+	m, _ = press(m, 'G')
+	m, _ = press(m, ',')
+	model, _ := m.Update(LeaderTimeoutMsg{Gen: m.leaderGen})
+	m = model.(Model)
+	if m.leaderPending != "" {
+		t.Errorf("expected the timeout to reset the buffer, pending %q", m.leaderPending)
+	}
+	m, _ = press(m, 'g')
+	stale := m.leaderGen - 1
+	model, _ = m.Update(LeaderTimeoutMsg{Gen: stale})
+	if model.(Model).leaderPending != "g" {
+		t.Error("expected a stale timeout to be ignored")
+	}
+	model, _ = m.Update(LeaderTimeoutMsg{Gen: m.leaderGen})
+	m = model.(Model)
+	if m.leaderPending != "" || m.incidents.SelectedIndex() != 0 {
+		t.Errorf("expected g alone to go to the top after the timeout, pending %q index %d", m.leaderPending, m.incidents.SelectedIndex())
+	}
+}
+
+func TestLeaderSequences(t *testing.T) {
+	got := leaderSequences(map[string]string{
+		",x":  "sort",    // added
+		",o":  "",        // removed
+		"gt":  "bottom",  // remapped
+		"abc": "open",    // too long
+		",z":  "unknown", // unknown action
+	})
+	if got[",x"] != "sort" || got["gt"] != "bottom" {
+		t.Errorf("expected added and remapped sequences, got %v", got)
+	}
+	for _, seq := range []string{",o", "abc", ",z"} {
+		if _, ok := got[seq]; ok {
+			t.Errorf("expected %q to be skipped", seq)
+		}
+	}
+	if got[",c"] != "copy" {
+		t.Error("expected the defaults to be kept")
+	}
+}
+
+func TestModelJumpToIncident(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabAlerts
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", SequentialID: "INC-1", DetailLoaded: true},
+		{ID: "inc_2", SequentialID: "INC-2", DetailLoaded: true},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	// : is ignored while loading
+	m.loading = true
+	newModel, _ := m.Update(tea.KeyPressMsg{Code: ':', Text: ":"})
+	m = newModel.(Model)
+	if m.prompt.IsVisible() {
+		t.Error("expected the jump prompt to stay closed while loading")
+	}
+	m.loading = false
+
+	// Escape cancels the prompt
+	newModel, _ = m.Update(tea.KeyPressMsg{Code: ':', Text: ":"})
+	m = newModel.(Model)
+	if !m.prompt.IsVisible() {
+		t.Fatal("expected : to open the jump prompt")
+	}
+	newModel, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	m = newModel.(Model)
+	if m.prompt.IsVisible() {
+		t.Error("expected Escape to close the jump prompt")
+	}
+
+	// An incident on the page is selected without fetching it; only its
+	// event timeline is requested once the detail is focused
+	newModel, cmd := m.Update(JumpToIncidentMsg{Ref: "inc-2"})
+	m = newModel.(Model)
+	if m.activeTab != TabIncidents || m.incidents.SelectedIncident().ID != "inc_2" || !m.incidents.IsDetailFocused() {
+		t.Errorf("expected INC-2 to be selected and focused on the incidents tab")
+	}
+	if m.jumping || cmd == nil || m.incidents.NeedsEvents("inc_2") {
+		t.Error("expected only the timeline of an incident on the page to be requested")
+	}
+
+	// Other incidents are fetched
+	newModel, cmd = m.Update(JumpToIncidentMsg{Ref: "INC-99"})
+	m = newModel.(Model)
+	if !m.jumping || cmd == nil {
+		t.Fatal("expected an incident off the page to be fetched")
+	}
+
+	newModel, _ = m.Update(JumpIncidentLoadedMsg{Ref: "INC-99", Err: fmt.Errorf("%w: INC-99", api.ErrIncidentNotFound)})
+	m = newModel.(Model)
+	if m.jumping || m.statusMsg != "Incident INC-99 not found" {
+		t.Errorf("expected a not found status, got %q", m.statusMsg)
+	}
+
+	newModel, _ = m.Update(JumpIncidentLoadedMsg{Ref: "INC-99", Incident: &api.Incident{ID: "inc_99", SequentialID: "INC-99", DetailLoaded: true}})
+	m = newModel.(Model)
+	if inc := m.incidents.SelectedIncident(); inc == nil || inc.ID != "inc_99" {
+		t.Errorf("expected the fetched incident to be selected, got %+v", inc)
+	}
+}
+
+func TestModelJumpToIncidentOffPage(t *testing.T) {
+	// The server ignores filters and returns its newest incidents; the
+	// referenced one is picked out by number, not by position
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/incidents", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":"inc_130","attributes":{"sequential_id":130,"updated_at":"2026-01-03T00:00:00Z"}},
+			{"id":"inc_1234","attributes":{"sequential_id":1234,"updated_at":"2026-01-02T12:00:00Z"}},
+			{"id":"inc_123","attributes":{"sequential_id":123,"updated_at":"2026-01-02T03:04:05Z"}}
+		]}`))
+	})
+	mux.HandleFunc("/v1/incidents/inc_123", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"id":"inc_123","attributes":{"sequential_id":123,"title":"Checkout outage","status":"started"}}}`))
+	})
+	mux.HandleFunc("/v1/incidents/inc_123/action_items", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := api.NewClient(&config.Config{APIKey: "test", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.apiClient = client
+
+	newModel, _ := m.Update(fetchJumpIncident(client, "INC-123", m.requestTimeout())())
+	m = newModel.(Model)
+	if inc := m.incidents.SelectedIncident(); inc == nil || inc.ID != "inc_123" || !inc.DetailLoaded {
+		t.Fatalf("expected INC-123 fetched and selected, got %+v (error %q, status %q)", inc, m.errorMsg, m.statusMsg)
+	}
+
+	// A number the server doesn't return is reported as not found
+	newModel, _ = m.Update(fetchJumpIncident(client, "INC-77", m.requestTimeout())())
+	m = newModel.(Model)
+	if m.statusMsg != "Incident INC-77 not found" {
+		t.Errorf("expected a not found status, got %q (error %q)", m.statusMsg, m.errorMsg)
+	}
+
+	// So is an ID the server answers 404 for
+	newModel, _ = m.Update(fetchJumpIncident(client, "inc_missing", m.requestTimeout())())
+	m = newModel.(Model)
+	if m.statusMsg != "Incident inc_missing not found" || m.errorMsg != "" {
+		t.Errorf("expected a not found status, got %q (error %q)", m.statusMsg, m.errorMsg)
+	}
+}
+
+func TestModelStatusBarCachedAge(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabIncidents
+
+	newModel, _ := m.Update(IncidentsLoadedMsg{Pagination: api.PaginationInfo{CurrentPage: 1}, CachedAge: 2*time.Minute + 10*time.Second})
+	m = newModel.(Model)
+	if bar := m.renderStatusBar(); !strings.Contains(bar, "(cached 2m ago)") {
+		t.Errorf("expected the cache age in the status bar, got %q", bar)
+	}
+
+	// The alerts tab was fetched fresh
+	m.activeTab = TabAlerts
+	if bar := m.renderStatusBar(); strings.Contains(bar, "cached") {
+		t.Errorf("expected no cache age for a fresh list, got %q", bar)
+	}
+
+	m.activeTab = TabIncidents
+	newModel, _ = m.Update(IncidentsLoadedMsg{Pagination: api.PaginationInfo{CurrentPage: 1}})
+	m = newModel.(Model)
+	if bar := m.renderStatusBar(); strings.Contains(bar, "cached") {
+		t.Errorf("expected the cache age to clear after a fresh load, got %q", bar)
+	}
+}
+
+func TestModelPrefetchIncidentDetail(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabIncidents
+	m.incidents.SetDimensions(100, 30)
+	m.incidents.SetIncidents([]api.Incident{{ID: "inc_1"}, {ID: "inc_2"}, {ID: "inc_3"}}, api.PaginationInfo{CurrentPage: 1})
+
+	newModel, cmd := m.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	m = newModel.(Model)
+	if m.prefetchID != "inc_2" || cmd == nil {
+		t.Fatalf("expected a prefetch to be scheduled for inc_2, got %q", m.prefetchID)
+	}
+
+	// Moving on supersedes the pending timer
+	newModel, _ = m.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	m = newModel.(Model)
+	newModel, cmd = m.Update(PrefetchTickMsg{ID: "inc_2"})
+	m = newModel.(Model)
+	if cmd != nil || m.prefetching["inc_2"] {
+		t.Error("expected a superseded prefetch timer to be ignored")
+	}
+
+	// Switching tabs cancels the pending prefetch
+	newModel, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	m = newModel.(Model)
+	if m.prefetchID != "" {
+		t.Error("expected switching tabs to cancel the pending prefetch")
+	}
+	m.activeTab = TabIncidents
+
+	m.prefetchID = "inc_3"
+	newModel, cmd = m.Update(PrefetchTickMsg{ID: "inc_3"})
+	m = newModel.(Model)
+	if cmd == nil || !m.prefetching["inc_3"] {
+		t.Fatal("expected the idle incident's detail to be prefetched")
+	}
+
+	// Enter while the prefetch is in flight waits for it instead of fetching again
+	newModel, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = newModel.(Model)
+	if !m.incidents.IsLoadingIncident("inc_3") {
+		t.Error("expected Enter to show the detail as loading")
+	}
+
+	// The prefetch and another load are in flight; the prefetch only finishes its own
+	m.inFlight = 2
+	newModel, _ = m.Update(IncidentDetailLoadedMsg{ID: "inc_3", Index: 2, Prefetch: true, Incident: &api.Incident{ID: "inc_3", DetailLoaded: true}})
+	m = newModel.(Model)
+	if m.prefetching["inc_3"] || m.incidents.IsDetailLoading() {
+		t.Error("expected the prefetch to finish the pending load")
+	}
+	if m.inFlight != 1 {
+		t.Errorf("expected the other load to stay in flight, got %d", m.inFlight)
+	}
+	if !m.incidents.SelectedIncident().DetailLoaded || !m.incidents.IsDetailFocused() {
+		t.Error("expected the prefetched detail to be shown and focused after Enter")
+	}
+}
+
+func TestModelPrefetchedDetailStaysQuiet(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabIncidents
+	m.incidents.SetIncidents([]api.Incident{{ID: "inc_1"}, {ID: "inc_2"}}, api.PaginationInfo{CurrentPage: 1})
+	m.prefetching = map[string]bool{"inc_2": true, "inc_9": true}
+
+	// The list order changed while the request was in flight; the detail lands by ID
+	newModel, _ := m.Update(IncidentDetailLoadedMsg{ID: "inc_2", Index: 0, Prefetch: true, Incident: &api.Incident{ID: "inc_2", Title: "Loaded", DetailLoaded: true}})
+	m = newModel.(Model)
+	if m.incidents.SelectedIncident().DetailLoaded || m.incidents.IsDetailFocused() {
+		t.Error("expected the prefetched detail to leave the selected row and focus alone")
+	}
+	m.incidents.SelectIncidentRef("inc_2")
+	if inc := m.incidents.SelectedIncident(); inc.Title != "Loaded" {
+		t.Errorf("expected inc_2 to hold the prefetched detail, got %+v", inc)
+	}
+
+	newModel, _ = m.Update(IncidentDetailLoadedMsg{ID: "inc_9", Prefetch: true, Err: errors.New("boom")})
+	m = newModel.(Model)
+	if m.errorMsg != "" || m.prefetching["inc_9"] {
+		t.Errorf("expected a failed prefetch to be dropped quietly, got error %q", m.errorMsg)
+	}
+}
+
+func TestModelCopyJSONAfterPrefetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/v1/incidents/")
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = fmt.Fprintf(w, `{"data":{"id":%q,"type":"incidents","attributes":{"title":"Incident %s"}}}`, id, id)
+	}))
+	defer server.Close()
+
+	client, err := api.NewClient(&config.Config{APIKey: "test", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	debug.Enabled = true
+	defer func() { debug.Enabled = false }()
+
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabIncidents
+	m.apiClient = client
+	m.incidents.SetIncidents([]api.Incident{{ID: "inc_a"}, {ID: "inc_b"}}, api.PaginationInfo{CurrentPage: 1})
+
+	// A is viewed, then B is prefetched while the cursor rests on it
+	for _, id := range []string{"inc_a", "inc_b"} {
+		if _, _, err := client.GetIncidentDetail(context.Background(), id, time.Now()); err != nil {
+			t.Fatalf("GetIncidentDetail(%s) error = %v", id, err)
+		}
+	}
+
+	// Back on A, J copies A's response, not the prefetched one
+	if got := string(m.selectedResponseBody()); !strings.Contains(got, `"inc_a"`) {
+		t.Errorf("expected inc_a's response for the selected incident, got %s", got)
+	}
+}
+
+func TestModelRestoreState(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if err := config.Save(&config.Config{APIKey: "test-key", Endpoint: "api.rootly.com"}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	if err := state.Save(state.State{Tab: "alerts", IncidentIndex: 99, AlertIndex: 1}); err != nil {
+		t.Fatalf("failed to save state: %v", err)
+	}
+
+	m := New("1.0.0")
+	if m.screen != ScreenMain || m.activeTab != TabAlerts {
+		t.Fatalf("expected the alerts tab restored, got screen %v tab %v", m.screen, m.activeTab)
+	}
+
+	newModel, _ := m.Update(IncidentsLoadedMsg{Incidents: api.MockIncidents(), Pagination: api.PaginationInfo{CurrentPage: 1}})
+	m = newModel.(Model)
+	if got := m.incidents.SelectedIndex(); got != len(api.MockIncidents())-1 {
+		t.Errorf("expected the incident cursor clamped to the last row, got %d", got)
+	}
+	newModel, _ = m.Update(AlertsLoadedMsg{Alerts: api.MockAlerts(), Pagination: api.PaginationInfo{CurrentPage: 1}})
+	m = newModel.(Model)
+	if got := m.alerts.SelectedIndex(); got != 1 {
+		t.Errorf("expected the alert cursor restored, got %d", got)
+	}
+
+	// Later loads keep the cursor where the user moved it
+	newModel, _ = m.Update(tea.KeyPressMsg{Code: 'k', Text: "k"})
+	m = newModel.(Model)
+	newModel, _ = m.Update(AlertsLoadedMsg{Alerts: api.MockAlerts(), Pagination: api.PaginationInfo{CurrentPage: 1}})
+	m = newModel.(Model)
+	if got := m.alerts.SelectedIndex(); got != 0 {
+		t.Errorf("expected the restore to apply only once, got %d", got)
+	}
+
+	if err := m.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if got, want := state.Load(), (state.State{Tab: "alerts", IncidentIndex: 4}); got != want {
+		t.Errorf("expected %+v saved on close, got %+v", want, got)
+	}
+}
+
+func TestModelAddIncidentNote(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.incidents.SetIncidents(api.MockIncidents(), api.PaginationInfo{CurrentPage: 1})
+
+	newModel, _ := m.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	m = newModel.(Model)
+	if !m.prompt.IsVisible() {
+		t.Fatal("expected n to open the note prompt")
+	}
+	for _, r := range "Rolled back" {
+		newModel, _ = m.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+		m = newModel.(Model)
+	}
+	newModel, cmd := m.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	m = newModel.(Model)
+	if cmd == nil {
+		t.Fatal("expected ctrl+s to submit the note")
+	}
+	post, ok := cmd().(PostIncidentNoteMsg)
+	if !ok || post.ID != "inc_001" || post.SequentialID != "INC-142" || post.Text != "Rolled back" {
+		t.Fatalf("unexpected note message %+v", post)
+	}
+
+	newModel, _ = m.Update(post)
+	m = newModel.(Model)
+	if !strings.Contains(m.statusMsg, "INC-142") {
+		t.Errorf("expected a posting status, got %q", m.statusMsg)
+	}
+
+	newModel, _ = m.Update(IncidentNotePostedMsg{ID: "inc_001", SequentialID: "INC-142", Err: errors.New("access denied")})
+	m = newModel.(Model)
+	if !strings.Contains(m.errorMsg, "access denied") {
+		t.Errorf("expected the API error in the status bar, got %q", m.errorMsg)
+	}
+
+	newModel, _ = m.Update(IncidentNotePostedMsg{ID: "inc_001", SequentialID: "INC-142"})
+	m = newModel.(Model)
+	if m.errorMsg != "" || !strings.Contains(m.statusMsg, "Note added to INC-142") {
+		t.Errorf("expected a success status, got %q (error %q)", m.statusMsg, m.errorMsg)
+	}
+	if !m.incidents.IsLoadingIncident("inc_001") {
+		t.Error("expected the incident detail to be fetched again")
+	}
+
+	// Nothing to annotate on the alerts tab
+	m.activeTab = TabAlerts
+	newModel, _ = m.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	if newModel.(Model).prompt.IsVisible() {
+		t.Error("expected n to do nothing on the alerts tab")
+	}
+}
+
+func TestModelIncidentEventsLoadedOnFocus(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	incidents := api.MockIncidents()
+	incidents[0].DetailLoaded = true
+	m.incidents.SetIncidents(incidents, api.PaginationInfo{CurrentPage: 1})
+
+	newModel, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = newModel.(Model)
+	if !m.incidents.IsDetailFocused() {
+		t.Fatal("expected enter to focus the loaded detail")
+	}
+	if cmd == nil || m.incidents.NeedsEvents("inc_001") {
+		t.Fatal("expected focusing the detail to fetch its events")
+	}
+
+	newModel, _ = m.Update(IncidentEventsLoadedMsg{ID: "inc_001", Events: []api.IncidentEvent{{Message: "Paged on-call"}}})
+	m = newModel.(Model)
+
+	// Focusing again uses the fetched events
+	m.incidents.SetDetailFocused(false)
+	if _, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter}); cmd != nil {
+		t.Error("expected events to be fetched only on first focus")
+	}
+
+	// A posted note refetches them
+	newModel, _ = m.Update(IncidentNotePostedMsg{ID: "inc_001", SequentialID: "INC-142"})
+	if !newModel.(Model).incidents.NeedsEvents("inc_001") {
+		t.Error("expected posting a note to drop the fetched events")
+	}
+}
+
+func TestNewLoadsAlertSources(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	defer styles.SetAlertSources(nil)
+	if err := os.MkdirAll(config.Dir(), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(config.SourcesPath(), []byte("acme:\n  icon: \"🛰️\"\n  name: Acme\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	New("1.0.0")
+	if styles.AlertSourceIcon("acme") != "🛰️" || styles.AlertSourceName("acme") != "Acme" {
+		t.Errorf("expected sources.yaml to be merged at startup, got %q %q", styles.AlertSourceIcon("acme"), styles.AlertSourceName("acme"))
+	}
+}
+
+func TestModelRateLimitBackoff(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	var limited atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("RateLimit-Limit", "100")
+		if limited.Load() {
+			w.Header().Set("RateLimit-Remaining", "0")
+			w.Header().Set("Retry-After", "5")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("RateLimit-Remaining", "2")
+		w.Header().Set("RateLimit-Reset", "60")
+		_, _ = w.Write([]byte(`{"data":[],"meta":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := api.NewClient(&config.Config{APIKey: "test", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+	if _, err := client.ListServices(context.Background(), 1); err != nil {
+		t.Fatalf("ListServices() error = %v", err)
+	}
+
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabIncidents
+	m.apiClient = client
+	m.incidents.SetIncidents([]api.Incident{{ID: "inc_1"}}, api.PaginationInfo{CurrentPage: 1})
+
+	// Low on requests: no prefetch, and auto-refresh waits for the window to reset
+	m.prefetchID = "inc_1"
+	newModel, cmd := m.Update(PrefetchTickMsg{ID: "inc_1"})
+	if cmd != nil || newModel.(Model).prefetching["inc_1"] {
+		t.Error("expected prefetch to be skipped while the rate limit is low")
+	}
+	if got := m.throttledRefreshInterval(30 * time.Second); got < 2*time.Minute {
+		t.Errorf("expected auto-refresh to be stretched, got %s", got)
+	}
+
+	// A 429 being waited out shows in the status bar
+	limited.Store(true)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = client.ListServices(ctx, 2)
+	}()
+	deadline := time.Now().Add(5 * time.Second)
+	for client.RateLimitStatus().RetryingIn(time.Now()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	status := m.renderStatusBar()
+	cancel()
+	<-done
+	if !strings.Contains(status, i18n.Tf("common.rate_limited", map[string]any{"Seconds": 5})) {
+		t.Errorf("expected a rate-limited notice, got %q", status)
+	}
+}
+
+func TestModelTeamPickerReloadsFiltered(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabIncidents
+	m.incidents.SetIncidents(api.MockIncidents(), api.PaginationInfo{CurrentPage: 3, TotalPages: 5, HasNext: true, HasPrev: true})
+
+	newModel, _ := m.Update(tea.KeyPressMsg{Code: 'T', Text: "T"})
+	model := newModel.(Model)
+	if !model.incidents.IsTeamPickerVisible() {
+		t.Fatal("expected the team picker to open")
+	}
+	newModel, _ = model.Update(TeamsLoadedMsg{Teams: []api.Team{{ID: "team_1", Name: "Platform"}}})
+	model = newModel.(Model)
+
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	model = newModel.(Model)
+	newModel, cmd := model.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	model = newModel.(Model)
+	if model.incidents.TeamFilter() != "Platform" || cmd == nil {
+		t.Fatalf("expected picking a team to reload incidents, got filter %q", model.incidents.TeamFilter())
+	}
+	if model.incidents.CurrentPage() != 1 {
+		t.Errorf("expected the filtered list to start at page 1, got %d", model.incidents.CurrentPage())
+	}
+
+	// Clearing the filter reloads the unfiltered list
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: 'T', Text: "T"})
+	model = newModel.(Model)
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: 'g', Text: "g"})
+	model = newModel.(Model)
+	newModel, cmd = model.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	model = newModel.(Model)
+	if model.incidents.TeamFilter() != "" || cmd == nil {
+		t.Errorf("expected clearing the team to reload incidents, got filter %q", model.incidents.TeamFilter())
+	}
+}
+
+func TestModelNewIncidentBadgesExpire(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	incidents := []api.Incident{{ID: "inc_1"}, {ID: "inc_2"}}
+
+	newModel, _ := m.Update(IncidentsLoadedMsg{Incidents: incidents, Pagination: api.PaginationInfo{CurrentPage: 1}})
+	model := newModel.(Model)
+	newModel, cmd := model.Update(IncidentsLoadedMsg{Incidents: append([]api.Incident{{ID: "inc_3"}}, incidents...), Pagination: api.PaginationInfo{CurrentPage: 1}})
+	model = newModel.(Model)
+	if !model.incidents.IsNew("inc_3") || cmd == nil {
+		t.Fatal("expected the new incident to be badged with an expiry scheduled")
+	}
+
+	// The expiry tick only drops badges that have shown long enough
+	newModel, _ = model.Update(NewBadgesExpireMsg{})
+	if !newModel.(Model).incidents.IsNew("inc_3") {
+		t.Error("expected a fresh badge to survive an early tick")
+	}
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/views"
+)
+
+// autoRefreshInterval returns how often the active list is re-fetched, or 0
+// when auto-refresh is off
+func (m Model) autoRefreshInterval() time.Duration {
+	if m.cfg == nil {
+		return 0
+	}
+	return m.cfg.AutoRefreshInterval()
+}
+
+// scheduleAutoRefresh queues the next auto-refresh tick, if enabled, later
+// while the rate limit is low. Ticks carry the current generation so a
+// restarted chain (e.g. after setup) replaces the old one.
+func (m Model) scheduleAutoRefresh() tea.Cmd {
+	interval := m.autoRefreshInterval()
+	if interval <= 0 {
+		return nil
+	}
+	interval = m.throttledRefreshInterval(interval)
+	gen := m.autoRefreshGen
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return AutoRefreshTickMsg{Gen: gen}
+	})
+}
+
+// restartAutoRefresh drops any pending tick and starts a new chain with the
+// current config
+func (m *Model) restartAutoRefresh() tea.Cmd {
+	m.autoRefreshGen++
+	return m.scheduleAutoRefresh()
+}
+
+// scheduleNewBadgesExpiry drops the NEW badges of incidents a reload just
+// added once they've shown for views.NewBadgeDuration
+func scheduleNewBadgesExpiry() tea.Cmd {
+	return tea.Tick(views.NewBadgeDuration, func(time.Time) tea.Msg {
+		return NewBadgesExpireMsg{}
+	})
+}
+
+// autoRefreshPaused reports whether a tick should skip reloading: off the main
+// screen, while an overlay is open, or while a load is already showing
+func (m Model) autoRefreshPaused() bool {
+	return m.screen != ScreenMain || m.loading || m.help.Visible || m.logs.Visible
+}
+
+// handleAutoRefreshTick reloads the active tab (from cache when unchanged) and
+// schedules the next tick; paused ticks just reschedule
+func (m Model) handleAutoRefreshTick(msg AutoRefreshTickMsg) (tea.Model, tea.Cmd) {
+	if msg.Gen != m.autoRefreshGen {
+		return m, nil
+	}
+	next := m.scheduleAutoRefresh()
+	if m.autoRefreshPaused() {
+		return m, next
+	}
+	switch m.activeTab {
+	case TabAlerts:
+		return m, tea.Batch(m.loadAlerts(), next)
+	case TabServices:
+		return m, tea.Batch(m.loadServices(), next)
+	}
+	return m, tea.Batch(m.loadIncidents(), next)
+}
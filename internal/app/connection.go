@@ -0,0 +1,38 @@
+package app
+
+import (
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
+)
+
+// connState is the outcome of the most recent list request, shown as a dot
+// next to the version in the header
+type connState int
+
+const (
+	connLoading connState = iota // No list has loaded since the client was created
+	connOK
+	connError
+)
+
+// recordListResult updates the connection state from a list request's result.
+// Failures keep the dot red until a list loads again.
+func (m *Model) recordListResult(err error) {
+	if err != nil {
+		m.conn = connError
+		return
+	}
+	m.conn = connOK
+}
+
+// renderConnStatus returns the header's connection dot and label
+func (m Model) renderConnStatus() string {
+	switch m.conn {
+	case connOK:
+		return styles.DotActive.String() + " " + styles.TextDim.Render(i18n.T("app.connection.ok"))
+	case connError:
+		return styles.DotDanger.String() + " " + styles.Error.Render(i18n.T("app.connection.error"))
+	default:
+		return styles.DotMuted.String() + " " + styles.TextDim.Render(i18n.T("app.connection.loading"))
+	}
+}
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// focusIncidentDetail focuses the selected incident's detail pane and, the
+// first time, fetches its event timeline
+func (m *Model) focusIncidentDetail() tea.Cmd {
+	m.incidents.SetDetailFocused(true)
+	inc := m.incidents.SelectedIncident()
+	if inc == nil || !m.incidents.NeedsEvents(inc.ID) {
+		return nil
+	}
+	m.incidents.SetEventsLoading(inc.ID)
+	return m.loadIncidentEvents(inc.ID)
+}
+
+// loadIncidentEvents fetches the first page of an incident's timeline in the background
+func (m Model) loadIncidentEvents(id string) tea.Cmd {
+	client := m.apiClient
+	timeout := m.requestTimeout()
+	return background(func() tea.Msg {
+		if client == nil {
+			return IncidentEventsLoadedMsg{ID: id, Err: fmt.Errorf("API client not initialized")}
+		}
+
+		ctx, cancel := requestContext(timeout)
+		defer cancel()
+		result, err := client.ListIncidentEvents(ctx, id, 1)
+		if err != nil {
+			return IncidentEventsLoadedMsg{ID: id, Err: requestError(err, timeout)}
+		}
+		return IncidentEventsLoadedMsg{ID: id, Events: result.Events}
+	})
+}
+
+// handleIncidentEventsLoaded shows a fetched timeline, or why it couldn't be fetched
+func (m Model) handleIncidentEventsLoaded(msg IncidentEventsLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.Err != nil && m.handleOAuthExpired(msg.Err) {
+		m.incidents.ClearEvents(msg.ID)
+		return m, m.setup.Init()
+	}
+	m.incidents.SetEvents(msg.ID, msg.Events, msg.Err)
+	return m, nil
+}
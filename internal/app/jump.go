@@ -0,0 +1,81 @@
+package app
+
+import (
+	"errors"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/api"
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+)
+
+// askJumpToIncident prompts for an incident to jump to. Ignored while the
+// list is loading or another jump is being fetched.
+func (m *Model) askJumpToIncident() tea.Cmd {
+	if m.loading || m.jumping {
+		return nil
+	}
+	return m.prompt.Ask(i18n.T("incidents.jump_prompt"), "", func(ref string) tea.Cmd {
+		return func() tea.Msg { return JumpToIncidentMsg{Ref: ref} }
+	})
+}
+
+// jumpToIncident selects the incident referenced by ref (INC-123, 123 or an
+// incident ID) on the incidents tab. One that isn't on the current page is
+// fetched and shown with its detail focused.
+func (m Model) jumpToIncident(ref string) (tea.Model, tea.Cmd) {
+	if m.jumping {
+		return m, nil
+	}
+	m.alerts.SetDetailFocused(false)
+	m.services.SetDetailFocused(false)
+	m.activeTab = TabIncidents
+
+	if m.incidents.SelectIncidentRef(ref) {
+		inc := m.incidents.SelectedIncident()
+		if !inc.DetailLoaded {
+			m.incidents.SetDetailLoading(inc.ID)
+			return m, tea.Batch(m.spinner.Tick, m.loadIncidentDetail(inc.ID, inc.UpdatedAt, m.incidents.SelectedIndex()))
+		}
+		return m, m.focusIncidentDetail()
+	}
+
+	m.jumping = true
+	m.statusMsg = i18n.Tf("incidents.jump_loading", map[string]any{"ID": ref})
+	return m, tea.Batch(m.spinner.Tick, m.loadJumpIncident(ref))
+}
+
+// loadJumpIncident fetches an incident that isn't on the current page
+func (m Model) loadJumpIncident(ref string) tea.Cmd {
+	return background(fetchJumpIncident(m.apiClient, ref, m.requestTimeout()))
+}
+
+// fetchJumpIncident resolves ref and fetches the incident's full detail, as
+// --open and --focus do
+func fetchJumpIncident(client *api.Client, ref string, timeout time.Duration) tea.Cmd {
+	return func() tea.Msg {
+		inc, err := fetchIncidentByRef(client, ref, timeout)
+		return JumpIncidentLoadedMsg{Ref: ref, Incident: inc, Err: err}
+	}
+}
+
+// handleJumpIncidentLoaded shows the fetched incident, or reports it missing
+func (m Model) handleJumpIncidentLoaded(msg JumpIncidentLoadedMsg) (tea.Model, tea.Cmd) {
+	m.jumping = false
+	if errors.Is(msg.Err, api.ErrIncidentNotFound) {
+		m.statusMsg = i18n.Tf("incidents.jump_not_found", map[string]any{"ID": msg.Ref})
+		return m, nil
+	}
+	if msg.Err != nil {
+		if m.handleOAuthExpired(msg.Err) {
+			return m, m.setup.Init()
+		}
+		m.statusMsg = ""
+		m.errorMsg = msg.Err.Error()
+		return m, nil
+	}
+	m.statusMsg = ""
+	m.incidents.ShowIncident(*msg.Incident)
+	return m, m.focusIncidentDetail()
+}
@@ -3,23 +3,60 @@ package app
 import "charm.land/bubbles/v2/key"
 
 type KeyMap struct {
-	Up       key.Binding
-	Down     key.Binding
-	Tab      key.Binding
-	Refresh  key.Binding
-	Help     key.Binding
-	Logs     key.Binding
-	Setup    key.Binding
-	About    key.Binding
-	Quit     key.Binding
-	Enter    key.Binding
-	Open     key.Binding
-	Top      key.Binding
-	Bottom   key.Binding
-	PrevPage key.Binding
-	NextPage key.Binding
-	Sort     key.Binding
-	Copy     key.Binding
+	Up             key.Binding
+	Down           key.Binding
+	Tab            key.Binding
+	Refresh        key.Binding
+	Help           key.Binding
+	Logs           key.Binding
+	Setup          key.Binding
+	About          key.Binding
+	Quit           key.Binding
+	Enter          key.Binding
+	Open           key.Binding
+	Runbook        key.Binding
+	Top            key.Binding
+	Bottom         key.Binding
+	PrevPage       key.Binding
+	NextPage       key.Binding
+	FirstPage      key.Binding
+	LastPage       key.Binding
+	Sort           key.Binding
+	Search         key.Binding
+	JumpTo         key.Binding
+	AddNote        key.Binding
+	SaveView       key.Binding
+	Views          key.Binding
+	Profiles       key.Binding
+	Copy           key.Binding
+	CopyURL        key.Binding
+	CopyJSON       key.Binding
+	CopyContact    key.Binding
+	CopySlack      key.Binding
+	CopyDetail     key.Binding
+	CopyMarkdown   key.Binding
+	StatusUpdate   key.Binding
+	ExportHTML     key.Binding
+	CopyTable      key.Binding
+	Permalink      key.Binding
+	CopyServices   key.Binding
+	Expand         key.Binding
+	GroupAlerts    key.Binding
+	GroupServices  key.Binding
+	CollapseGroup  key.Binding
+	AckAll         key.Binding
+	Team           key.Binding
+	Reopen         key.Binding
+	AckIncident    key.Binding
+	NextNeedsAck   key.Binding
+	StatusFilter   key.Binding
+	SeverityFilter key.Binding
+	OnCall         key.Binding
+	Scope          key.Binding
+	ToggleID       key.Binding
+	Present        key.Binding
+	RelativeTimes  key.Binding
+	Summary        key.Binding
 }
 
 func DefaultKeyMap() KeyMap {
@@ -68,6 +105,10 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("o"),
 			key.WithHelp("o", "open in browser"),
 		),
+		Runbook: key.NewBinding(
+			key.WithKeys("b"),
+			key.WithHelp("b", "open runbook"),
+		),
 		Top: key.NewBinding(
 			key.WithKeys("g"),
 			key.WithHelp("g", "go to top"),
@@ -84,13 +125,157 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("]"),
 			key.WithHelp("]", "next page"),
 		),
+		FirstPage: key.NewBinding(
+			key.WithKeys("{"),
+			key.WithHelp("{", "first page"),
+		),
+		LastPage: key.NewBinding(
+			key.WithKeys("}"),
+			key.WithHelp("}", "last page"),
+		),
 		Sort: key.NewBinding(
 			key.WithKeys("S"),
 			key.WithHelp("S", "sort"),
 		),
+		Search: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "search incidents"),
+		),
+		JumpTo: key.NewBinding(
+			key.WithKeys(":"),
+			key.WithHelp(":", "jump to incident"),
+		),
+		AddNote: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "add note"),
+		),
+		SaveView: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "save current view"),
+		),
+		Views: key.NewBinding(
+			key.WithKeys("F"),
+			key.WithHelp("F", "apply saved view"),
+		),
+		Profiles: key.NewBinding(
+			key.WithKeys("P"),
+			key.WithHelp("P", "switch profile"),
+		),
 		Copy: key.NewBinding(
 			key.WithKeys("c"),
-			key.WithHelp("c", "copy detail"),
+			key.WithHelp("c", "copy summary"),
+		),
+		CopyURL: key.NewBinding(
+			key.WithKeys("y"),
+			key.WithHelp("y", "copy Rootly URL"),
+		),
+		CopyJSON: key.NewBinding(
+			key.WithKeys("J"),
+			key.WithHelp("J", "copy raw API response"),
+		),
+		CopyContact: key.NewBinding(
+			key.WithKeys("C"),
+			key.WithHelp("C", "copy commander contact"),
+		),
+		CopySlack: key.NewBinding(
+			key.WithKeys("L"),
+			key.WithHelp("L", "copy as Slack message"),
+		),
+		CopyDetail: key.NewBinding(
+			key.WithKeys("Y"),
+			key.WithHelp("Y", "copy detail pane as shown"),
+		),
+		CopyMarkdown: key.NewBinding(
+			key.WithKeys("M"),
+			key.WithHelp("M", "copy as Markdown"),
+		),
+		StatusUpdate: key.NewBinding(
+			key.WithKeys("u"),
+			key.WithHelp("u", "copy status page update"),
+		),
+		CopyTable: key.NewBinding(
+			key.WithKeys("W"),
+			key.WithHelp("W", "copy list as Markdown table"),
+		),
+		Permalink: key.NewBinding(
+			key.WithKeys("X"),
+			key.WithHelp("X", "copy permalink command"),
+		),
+		CopyServices: key.NewBinding(
+			key.WithKeys("V"),
+			key.WithHelp("V", "copy affected services"),
+		),
+		ExportHTML: key.NewBinding(
+			key.WithKeys("E"),
+			key.WithHelp("E", "save detail as HTML"),
+		),
+		Expand: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "expand long labels"),
+		),
+		GroupAlerts: key.NewBinding(
+			key.WithKeys("i"),
+			key.WithHelp("i", "group alerts by incident"),
+		),
+		GroupServices: key.NewBinding(
+			key.WithKeys("z"),
+			key.WithHelp("z", "group alerts by service"),
+		),
+		CollapseGroup: key.NewBinding(
+			key.WithKeys("Z"),
+			key.WithHelp("Z", "collapse/expand service group"),
+		),
+		AckAll: key.NewBinding(
+			key.WithKeys("K"),
+			key.WithHelp("K", "acknowledge all visible"),
+		),
+		Team: key.NewBinding(
+			key.WithKeys("T"),
+			key.WithHelp("T", "filter by team"),
+		),
+		Reopen: key.NewBinding(
+			key.WithKeys("R"),
+			key.WithHelp("R", "reopen incident / resolve alert"),
+		),
+		AckIncident: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "acknowledge incident/alert"),
+		),
+		NextNeedsAck: key.NewBinding(
+			key.WithKeys("N"),
+			key.WithHelp("N", "next unacknowledged incident"),
+		),
+		StatusFilter: key.NewBinding(
+			key.WithKeys("f"),
+			key.WithHelp("f", "filter by status"),
+		),
+		SeverityFilter: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "filter by severity"),
+		),
+		OnCall: key.NewBinding(
+			key.WithKeys("O"),
+			key.WithHelp("O", "only my on-call"),
+		),
+		Scope: key.NewBinding(
+			key.WithKeys("U"),
+			key.WithHelp("U", "cycle all / my team / mine"),
+		),
+		ToggleID: key.NewBinding(
+			key.WithKeys("I"),
+			key.WithHelp("I", "toggle opaque IDs"),
+		),
+		Present: key.NewBinding(
+			key.WithKeys("H"),
+			key.WithHelp("H", "present mode"),
+		),
+		RelativeTimes: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "relative / absolute times"),
+		),
+		Summary: key.NewBinding(
+			key.WithKeys("D"),
+			key.WithHelp("D", "incidents per day"),
 		),
 	}
 }
@@ -0,0 +1,149 @@
+package app
+
+import (
+	"maps"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+)
+
+// leaderTimeout is how long a leader key waits for the key that completes a
+// sequence; on timeout the leader runs its own action, if it has one
+const leaderTimeout = 600 * time.Millisecond
+
+// leaderActions are the action names usable in leader_keys; a sequence runs
+// the action of the returned binding
+var leaderActions = map[string]func(KeyMap) key.Binding{
+	"top":           func(k KeyMap) key.Binding { return k.Top },
+	"bottom":        func(k KeyMap) key.Binding { return k.Bottom },
+	"open":          func(k KeyMap) key.Binding { return k.Open },
+	"runbook":       func(k KeyMap) key.Binding { return k.Runbook },
+	"copy":          func(k KeyMap) key.Binding { return k.Copy },
+	"copy_url":      func(k KeyMap) key.Binding { return k.CopyURL },
+	"json":          func(k KeyMap) key.Binding { return k.CopyJSON },
+	"contact":       func(k KeyMap) key.Binding { return k.CopyContact },
+	"slack":         func(k KeyMap) key.Binding { return k.CopySlack },
+	"status_update": func(k KeyMap) key.Binding { return k.StatusUpdate },
+	"table":         func(k KeyMap) key.Binding { return k.CopyTable },
+	"permalink":     func(k KeyMap) key.Binding { return k.Permalink },
+	"services":      func(k KeyMap) key.Binding { return k.CopyServices },
+	"html":          func(k KeyMap) key.Binding { return k.ExportHTML },
+	"refresh":       func(k KeyMap) key.Binding { return k.Refresh },
+	"sort":          func(k KeyMap) key.Binding { return k.Sort },
+	"search":        func(k KeyMap) key.Binding { return k.Search },
+	"summary":       func(k KeyMap) key.Binding { return k.Summary },
+	"logs":          func(k KeyMap) key.Binding { return k.Logs },
+	"about":         func(k KeyMap) key.Binding { return k.About },
+	"setup":         func(k KeyMap) key.Binding { return k.Setup },
+}
+
+// defaultLeaderKeys are the leader sequences available without configuration
+var defaultLeaderKeys = map[string]string{
+	"gt": "top",
+	"gb": "bottom",
+	",o": "open",
+	",c": "copy",
+	",s": "slack",
+	",j": "json",
+	",p": "permalink",
+	",r": "runbook",
+	",u": "status_update",
+}
+
+// leaderSequences returns the leader sequences: the defaults overridden by the
+// leader_keys config, where an empty action removes a sequence. A sequence is a
+// leader key followed by one key; invalid entries are logged and skipped.
+func leaderSequences(overrides map[string]string) map[string]string {
+	sequences := maps.Clone(defaultLeaderKeys)
+	for seq, action := range overrides {
+		if action == "" {
+			delete(sequences, seq)
+			continue
+		}
+		if len([]rune(seq)) != 2 {
+			debug.Logger.Warn("Ignoring leader sequence, expected a leader and one key", "sequence", seq)
+			continue
+		}
+		if _, ok := leaderActions[action]; !ok {
+			debug.Logger.Warn("Ignoring leader sequence with unknown action", "sequence", seq, "action", action)
+			continue
+		}
+		sequences[seq] = action
+	}
+	return sequences
+}
+
+// isLeaderKey reports whether k starts a leader sequence
+func (m Model) isLeaderKey(k string) bool {
+	for seq := range m.leaderKeys {
+		if string([]rune(seq)[0]) == k {
+			return true
+		}
+	}
+	return false
+}
+
+// handleLeaderKey buffers a leader key and dispatches the sequence it starts.
+// It reports false for keys it leaves to the main key handling.
+func (m Model) handleLeaderKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd, bool) {
+	k := msg.String()
+	if m.leaderPending == "" {
+		if !m.isLeaderKey(k) {
+			return m, nil, false
+		}
+		m.leaderPending = k
+		m.leaderGen++
+		gen := m.leaderGen
+		return m, tea.Tick(leaderTimeout, func(time.Time) tea.Msg {
+			return LeaderTimeoutMsg{Gen: gen}
+		}), true
+	}
+
+	leader := m.leaderPending
+	m.leaderPending = ""
+	if k == "esc" {
+		return m, nil, true
+	}
+	if action, ok := m.leaderKeys[leader+k]; ok {
+		if keys := leaderActions[action](m.keys).Keys(); len(keys) > 0 {
+			model, cmd := m.dispatchKey(keyPress(keys[0]))
+			return model, cmd, true
+		}
+	}
+	// Not a sequence: the leader acts as its own key, then k is handled as usual
+	model, cmd := m.dispatchKey(keyPress(leader))
+	next, nextCmd := model.Update(msg)
+	return next, tea.Batch(cmd, nextCmd), true
+}
+
+// handleLeaderTimeout runs the pending leader's own action once no sequence
+// followed it in time
+func (m Model) handleLeaderTimeout(msg LeaderTimeoutMsg) (tea.Model, tea.Cmd) {
+	if msg.Gen != m.leaderGen || m.leaderPending == "" {
+		return m, nil
+	}
+	leader := m.leaderPending
+	m.leaderPending = ""
+	return m.dispatchKey(keyPress(leader))
+}
+
+// dispatchKey handles msg as a single key, bypassing leader handling
+func (m Model) dispatchKey(msg tea.KeyPressMsg) (Model, tea.Cmd) {
+	m.leaderBypass = true
+	model, cmd := m.Update(msg)
+	next := model.(Model)
+	next.leaderBypass = false
+	return next, cmd
+}
+
+// keyPress builds the key press for a single-character key name
+func keyPress(k string) tea.KeyPressMsg {
+	r := []rune(k)
+	if len(r) != 1 {
+		return tea.KeyPressMsg{}
+	}
+	return tea.KeyPressMsg{Code: r[0], Text: k}
+}
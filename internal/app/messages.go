@@ -1,35 +1,208 @@
 package app
 
-import "github.com/rootlyhq/rootly-tui/internal/api"
+import (
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/api"
+)
 
 // IncidentsLoadedMsg is sent when incidents are loaded from the API
 type IncidentsLoadedMsg struct {
 	Incidents  []api.Incident
 	Pagination api.PaginationInfo
+	CachedAge  time.Duration // Age of the page when served from the cache
 	Err        error
 }
 
+// IncidentsAppendedMsg is sent when the next page of incidents is loaded for infinite scroll
+type IncidentsAppendedMsg struct {
+	Incidents  []api.Incident
+	Pagination api.PaginationInfo
+	Err        error
+}
+
+// FocusIncidentLoadedMsg is sent when the watched incident (--focus) is fetched
+type FocusIncidentLoadedMsg struct {
+	Incident *api.Incident
+	Err      error
+}
+
+// OpenIncidentLoadedMsg is sent when the incident requested with --open is fetched
+type OpenIncidentLoadedMsg struct {
+	Incident *api.Incident
+	Err      error
+}
+
+// JumpToIncidentMsg is sent when an incident reference is entered in the : prompt
+type JumpToIncidentMsg struct {
+	Ref string
+}
+
+// JumpIncidentLoadedMsg is sent when an incident jumped to from another page is fetched
+type JumpIncidentLoadedMsg struct {
+	Ref      string
+	Incident *api.Incident
+	Err      error
+}
+
+// WatchTickMsg triggers a refresh of the watched incident
+type WatchTickMsg struct{}
+
+// AutoRefreshTickMsg triggers an auto-refresh of the active list; ticks from
+// an older generation are dropped
+type AutoRefreshTickMsg struct {
+	Gen int
+}
+
+// LeaderTimeoutMsg ends the wait for the second key of a leader sequence;
+// timeouts of an earlier leader press are dropped
+type LeaderTimeoutMsg struct {
+	Gen int
+}
+
 // AlertsLoadedMsg is sent when alerts are loaded from the API
 type AlertsLoadedMsg struct {
 	Alerts     []api.Alert
 	Pagination api.PaginationInfo
+	CachedAge  time.Duration // Age of the page when served from the cache
+	Err        error
+}
+
+// ServicesLoadedMsg is sent when a page of services is loaded from the API
+type ServicesLoadedMsg struct {
+	Services   []api.Service
+	Pagination api.PaginationInfo
 	Err        error
 }
 
 // IncidentDetailLoadedMsg is sent when incident detail is fetched
 type IncidentDetailLoadedMsg struct {
-	Incident *api.Incident
-	Index    int // Index in the incidents list to update
-	Err      error
+	ID        string // Requested incident ID
+	Incident  *api.Incident
+	Index     int  // Index in the incidents list to update
+	FromCache bool // Served from the cache rather than fetched
+	Prefetch  bool // Fetched in the background while the cursor rested on the incident
+	Err       error
 }
 
 // AlertDetailLoadedMsg is sent when alert detail is fetched
 type AlertDetailLoadedMsg struct {
-	Alert *api.Alert
-	Index int // Index in the alerts list to update
+	Alert     *api.Alert
+	Index     int  // Index in the alerts list to update
+	FromCache bool // Served from the cache rather than fetched
+	Err       error
+}
+
+// IncidentReopenedMsg is sent when a resolved incident has been reopened
+type IncidentReopenedMsg struct {
+	ID       string
+	Incident *api.Incident
+	Index    int // Index in the incidents list to refresh
+	Err      error
+}
+
+// IncidentAcknowledgedMsg is sent when an incident has been acknowledged
+type IncidentAcknowledgedMsg struct {
+	ID       string
+	Incident *api.Incident
+	Index    int // Index in the incidents list to refresh
+	Err      error
+}
+
+// PostIncidentNoteMsg is sent when a note for an incident has been entered
+type PostIncidentNoteMsg struct {
+	ID           string
+	SequentialID string
+	Text         string
+}
+
+// IncidentNotePostedMsg is sent when a note has been posted to an incident's timeline
+type IncidentNotePostedMsg struct {
+	ID           string
+	SequentialID string
+	Err          error
+}
+
+// IncidentEventsLoadedMsg is sent when an incident's event timeline has been fetched
+type IncidentEventsLoadedMsg struct {
+	ID     string
+	Events []api.IncidentEvent
+	Err    error
+}
+
+// AlertAcknowledgedMsg is sent for each alert in a bulk acknowledge as its request completes
+type AlertAcknowledgedMsg struct {
+	ID      string
+	ShortID string
+	Total   int // Number of alerts in the bulk action
+	Err     error
+}
+
+// AlertStatusChangingMsg is sent just before an alert acknowledge/resolve request
+// so the row shows the expected status while it's in flight
+type AlertStatusChangingMsg struct {
+	ID     string
+	Status string
+}
+
+// AlertStatusUpdatedMsg is sent when a single alert acknowledge/resolve request completes
+type AlertStatusUpdatedMsg struct {
+	ID         string
+	ShortID    string
+	UpdatedAt  time.Time
+	Index      int    // Index in the alerts list to refresh
+	Status     string // Status the action moved the alert to
+	PrevStatus string // Status to restore if the action failed
+	Err        error
+}
+
+// TeamsLoadedMsg is sent when the teams list is fetched for the team picker
+type TeamsLoadedMsg struct {
+	Teams []api.Team
 	Err   error
 }
 
+// OnCallScopesLoadedMsg is sent when the current user's on-call scopes are resolved
+type OnCallScopesLoadedMsg struct {
+	Scopes *api.OnCallScopes
+	Err    error
+}
+
+// ScopeResolvedMsg is sent when the current user and their team are resolved for the scope filter
+type ScopeResolvedMsg struct {
+	User *api.User
+	Team string // Empty if the user belongs to no team
+	Err  error
+}
+
+// IncidentSummaryLoadedMsg is sent when the creation times for the summary overlay are fetched
+type IncidentSummaryLoadedMsg struct {
+	CreatedAt []time.Time
+	Err       error
+}
+
+// BackgroundStartedMsg is sent when a background request starts, so it's counted as in flight
+type BackgroundStartedMsg struct{}
+
+// PrefetchTickMsg is sent when the cursor has rested on an incident long enough to prefetch its detail
+type PrefetchTickMsg struct {
+	ID string
+}
+
+// NewBadgesExpireMsg is sent when incidents badged NEW by a reload have shown it long enough
+type NewBadgesExpireMsg struct{}
+
+// DetailDebounceMsg is sent when the auto-load delay for a selected item elapses
+type DetailDebounceMsg struct {
+	Tab Tab
+	ID  string
+}
+
+// SaveViewMsg is sent when a name is entered to save the current view under
+type SaveViewMsg struct {
+	Name string
+}
+
 // ErrorMsg represents a generic error
 type ErrorMsg struct {
 	Err error
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+)
+
+// askIncidentNote prompts for a note to post to the selected incident's timeline
+func (m *Model) askIncidentNote() tea.Cmd {
+	if m.activeTab != TabIncidents {
+		return nil
+	}
+	inc := m.incidents.SelectedIncident()
+	if inc == nil {
+		return nil
+	}
+	id, seqID := inc.ID, inc.SequentialID
+	title := i18n.Tf("incidents.note_prompt", map[string]any{"ID": seqID})
+	return m.prompt.AskMultiline(title, func(text string) tea.Cmd {
+		return func() tea.Msg { return PostIncidentNoteMsg{ID: id, SequentialID: seqID, Text: text} }
+	})
+}
+
+// postIncidentNote posts a note to an incident in the background
+func (m Model) postIncidentNote(msg PostIncidentNoteMsg) tea.Cmd {
+	client := m.apiClient
+	timeout := m.requestTimeout()
+	return func() tea.Msg {
+		if client == nil {
+			return IncidentNotePostedMsg{ID: msg.ID, SequentialID: msg.SequentialID, Err: fmt.Errorf("API client not initialized")}
+		}
+
+		ctx, cancel := requestContext(timeout)
+		defer cancel()
+		err := client.AddIncidentNote(ctx, msg.ID, msg.Text)
+		return IncidentNotePostedMsg{ID: msg.ID, SequentialID: msg.SequentialID, Err: requestError(err, timeout)}
+	}
+}
+
+// handleIncidentNotePosted reports the result; on success the incident's
+// detail (invalidated by the client) is fetched again if it's still listed
+func (m Model) handleIncidentNotePosted(msg IncidentNotePostedMsg) (tea.Model, tea.Cmd) {
+	if msg.Err != nil {
+		if m.handleOAuthExpired(msg.Err) {
+			return m, m.setup.Init()
+		}
+		m.statusMsg = ""
+		m.errorMsg = i18n.Tf("incidents.note_failed", map[string]any{"Error": msg.Err.Error()})
+		return m, nil
+	}
+	m.errorMsg = ""
+	m.statusMsg = i18n.Tf("incidents.note_posted", map[string]any{"ID": msg.SequentialID})
+	// Fetch the timeline again the next time the detail is focused
+	m.incidents.ClearEvents(msg.ID)
+	index := m.incidents.IndexOf(msg.ID)
+	if index < 0 {
+		return m, nil
+	}
+	inc := m.incidents.Incidents()[index]
+	m.incidents.SetDetailLoading(msg.ID)
+	return m, tea.Batch(m.spinner.Tick, m.loadIncidentDetail(msg.ID, inc.UpdatedAt, index))
+}
@@ -0,0 +1,116 @@
+package app
+
+import (
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+)
+
+// maxPageWalk caps how many next-page hops } follows looking for the last
+// page when the API doesn't report a page count
+const maxPageWalk = 50
+
+// pageWalk tracks a } walk to the last page, made one next-page load at a time
+type pageWalk struct {
+	active bool
+	tab    Tab
+	hops   int
+}
+
+// pager is the paging surface shared by the incidents, alerts and services lists
+type pager interface {
+	CurrentPage() int
+	TotalPages() int
+	HasNextPage() bool
+	HasPrevPage() bool
+	NextPage()
+	SetPage(page int)
+}
+
+// pagerFor returns the list shown on a tab
+func (m *Model) pagerFor(tab Tab) pager {
+	switch tab {
+	case TabAlerts:
+		return &m.alerts
+	case TabServices:
+		return &m.services
+	default:
+		return &m.incidents
+	}
+}
+
+// loadTabPage shows a tab's list as loading and fetches its current page
+func (m *Model) loadTabPage(tab Tab) tea.Cmd {
+	m.loading = true // Keeps spinner ticking
+	switch tab {
+	case TabAlerts:
+		m.alerts.SetLoading(true)
+		return tea.Batch(m.spinner.Tick, m.loadAlerts())
+	case TabServices:
+		m.services.SetLoading(true)
+		return tea.Batch(m.spinner.Tick, m.loadServices())
+	default:
+		m.incidents.SetLoading(true)
+		return tea.Batch(m.spinner.Tick, m.loadIncidents())
+	}
+}
+
+// firstPage reloads the active list from page 1 ({)
+func (m Model) firstPage() (tea.Model, tea.Cmd) {
+	p := m.pagerFor(m.activeTab)
+	if m.loading || p.CurrentPage() <= 1 {
+		return m, nil
+	}
+	p.SetPage(1)
+	return m, m.loadTabPage(m.activeTab)
+}
+
+// lastPage moves the active list to its last page (}). When the API reports
+// a page count it jumps straight there; otherwise it follows next pages until
+// there are no more, up to maxPageWalk hops.
+func (m Model) lastPage() (tea.Model, tea.Cmd) {
+	p := m.pagerFor(m.activeTab)
+	if m.loading || !p.HasNextPage() {
+		return m, nil
+	}
+	if total := p.TotalPages(); total > p.CurrentPage() {
+		p.SetPage(total)
+		return m, m.loadTabPage(m.activeTab)
+	}
+	m.pageWalk = pageWalk{active: true, tab: m.activeTab}
+	return m.continuePageWalk()
+}
+
+// continuePageWalk loads the next page of a } walk, or ends the walk once the
+// last page is loaded or the hop cap is reached. It's called after each page
+// of the walked tab arrives.
+func (m Model) continuePageWalk() (tea.Model, tea.Cmd) {
+	p := m.pagerFor(m.pageWalk.tab)
+	if !p.HasNextPage() {
+		m.pageWalk = pageWalk{}
+		m.statusMsg = ""
+		return m, nil
+	}
+	if m.pageWalk.hops >= maxPageWalk {
+		m.pageWalk = pageWalk{}
+		m.statusMsg = i18n.Tf("common.last_page_capped", map[string]any{"Count": maxPageWalk})
+		return m, nil
+	}
+	m.pageWalk.hops++
+	p.NextPage()
+	m.statusMsg = i18n.Tf("common.last_page_walking", map[string]any{"Page": p.CurrentPage()})
+	return m, m.loadTabPage(m.pageWalk.tab)
+}
+
+// pageLoaded continues a } walk over tab once its page has loaded; a failed
+// load ends the walk
+func (m Model) pageLoaded(tab Tab, err error) (tea.Model, tea.Cmd) {
+	if !m.pageWalk.active || m.pageWalk.tab != tab {
+		return m, nil
+	}
+	if err != nil {
+		m.pageWalk = pageWalk{}
+		return m, nil
+	}
+	return m.continuePageWalk()
+}
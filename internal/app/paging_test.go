@@ -0,0 +1,87 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/api"
+)
+
+func TestModelFirstAndLastPage(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	incidents := api.MockIncidents()
+	m.incidents.SetIncidents(incidents, api.PaginationInfo{CurrentPage: 3, TotalPages: 5, HasNext: true, HasPrev: true})
+
+	// A known page count jumps straight to the last page
+	newModel, _ := m.Update(tea.KeyPressMsg{Code: '}', Text: "}"})
+	model := newModel.(Model)
+	if model.incidents.CurrentPage() != 5 || !model.loading || model.pageWalk.active {
+		t.Fatalf("expected a direct load of page 5, got page %d (loading %v)", model.incidents.CurrentPage(), model.loading)
+	}
+
+	// Paging keys wait for the load to finish
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: '{', Text: "{"})
+	model = newModel.(Model)
+	if model.incidents.CurrentPage() != 5 {
+		t.Errorf("expected { to be ignored while loading, got page %d", model.incidents.CurrentPage())
+	}
+
+	newModel, _ = model.Update(IncidentsLoadedMsg{Incidents: incidents, Pagination: api.PaginationInfo{CurrentPage: 5, TotalPages: 5, HasPrev: true}})
+	model = newModel.(Model)
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: '{', Text: "{"})
+	model = newModel.(Model)
+	if model.incidents.CurrentPage() != 1 || !model.loading {
+		t.Errorf("expected { to load page 1, got page %d", model.incidents.CurrentPage())
+	}
+}
+
+func TestModelLastPageWalk(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.activeTab = TabAlerts
+	alerts := api.MockAlerts()
+	m.alerts.SetAlerts(alerts, api.PaginationInfo{CurrentPage: 1, HasNext: true})
+
+	// Without a page count, } follows next pages one load at a time
+	newModel, _ := m.Update(tea.KeyPressMsg{Code: '}', Text: "}"})
+	model := newModel.(Model)
+	if model.alerts.CurrentPage() != 2 || !model.pageWalk.active {
+		t.Fatalf("expected the walk to load page 2, got page %d", model.alerts.CurrentPage())
+	}
+	if !strings.Contains(model.statusMsg, "page 2") {
+		t.Errorf("expected walk progress in the status bar, got %q", model.statusMsg)
+	}
+
+	// Incidents arriving meanwhile don't advance the alerts walk
+	newModel, _ = model.Update(IncidentsLoadedMsg{Pagination: api.PaginationInfo{CurrentPage: 1, HasNext: true}})
+	model = newModel.(Model)
+	if model.alerts.CurrentPage() != 2 {
+		t.Errorf("expected the walk to wait for alerts, got page %d", model.alerts.CurrentPage())
+	}
+
+	newModel, _ = model.Update(AlertsLoadedMsg{Alerts: alerts, Pagination: api.PaginationInfo{CurrentPage: 2, HasNext: true, HasPrev: true}})
+	model = newModel.(Model)
+	if model.alerts.CurrentPage() != 3 || !strings.Contains(model.statusMsg, "page 3") {
+		t.Fatalf("expected the walk to continue to page 3, got page %d (%q)", model.alerts.CurrentPage(), model.statusMsg)
+	}
+
+	newModel, _ = model.Update(AlertsLoadedMsg{Alerts: alerts, Pagination: api.PaginationInfo{CurrentPage: 3, HasPrev: true}})
+	model = newModel.(Model)
+	if model.pageWalk.active || model.statusMsg != "" || model.alerts.CurrentPage() != 3 {
+		t.Errorf("expected the walk to end on the last page, got %+v (%q)", model.pageWalk, model.statusMsg)
+	}
+
+	// The walk gives up after maxPageWalk hops
+	model.alerts.SetAlerts(alerts, api.PaginationInfo{CurrentPage: 1, HasNext: true})
+	newModel, _ = model.Update(tea.KeyPressMsg{Code: '}', Text: "}"})
+	model = newModel.(Model)
+	model.pageWalk.hops = maxPageWalk
+	newModel, _ = model.Update(AlertsLoadedMsg{Alerts: alerts, Pagination: api.PaginationInfo{CurrentPage: 2, HasNext: true, HasPrev: true}})
+	model = newModel.(Model)
+	if model.pageWalk.active || model.alerts.CurrentPage() != 2 || !strings.Contains(model.statusMsg, "Stopped after") {
+		t.Errorf("expected the walk to stop at the cap, got page %d (%q)", model.alerts.CurrentPage(), model.statusMsg)
+	}
+}
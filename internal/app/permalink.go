@@ -0,0 +1,54 @@
+package app
+
+import (
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// SetOpen makes the app select the referenced incident (INC-123, 123 or an incident ID)
+// and show its detail once the list has loaded, as produced by the permalink command
+func (m *Model) SetOpen(ref string) {
+	m.openRef = strings.TrimSpace(ref)
+}
+
+// loadOpenIncident fetches the incident requested with --open in the background
+func (m Model) loadOpenIncident(ref string) tea.Cmd {
+	client := m.apiClient
+	timeout := m.requestTimeout()
+	return background(func() tea.Msg {
+		inc, err := fetchIncidentByRef(client, ref, timeout)
+		return OpenIncidentLoadedMsg{Incident: inc, Err: err}
+	})
+}
+
+// permalinkCommand returns a rootly-tui command line that reopens the current view,
+// or "" if there is nothing selected to link to
+func (m Model) permalinkCommand() string {
+	if m.activeTab != TabIncidents {
+		return ""
+	}
+	inc := m.incidents.SelectedIncident()
+	if inc == nil {
+		return ""
+	}
+	ref := inc.SequentialID
+	if ref == "" {
+		ref = inc.ID
+	}
+	if ref == "" {
+		return ""
+	}
+	return "rootly-tui --open " + shellQuote(ref)
+}
+
+// shellQuote single-quotes s for POSIX shells unless it only holds safe characters
+func shellQuote(s string) string {
+	safe := strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_.:/", r))
+	}) == -1
+	if safe && s != "" {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
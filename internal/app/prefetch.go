@@ -0,0 +1,88 @@
+package app
+
+import (
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+)
+
+// prefetchDelay is how long the cursor must rest on an incident before its
+// detail is fetched in the background
+const prefetchDelay = 400 * time.Millisecond
+
+// scheduleDetailFetch debounces fetching the newly selected item's detail: shown
+// as it loads with auto_load_details, otherwise prefetched in the background
+func (m *Model) scheduleDetailFetch(id string) tea.Cmd {
+	if m.cfg != nil && m.cfg.AutoLoadDetails {
+		return scheduleDetailLoad(m.activeTab, id)
+	}
+	return m.schedulePrefetch()
+}
+
+// schedulePrefetch starts the idle timer for prefetching the selected incident's
+// detail, replacing any pending one
+func (m *Model) schedulePrefetch() tea.Cmd {
+	m.prefetchID = ""
+	if m.activeTab != TabIncidents {
+		return nil
+	}
+	inc := m.incidents.SelectedIncident()
+	if inc == nil || inc.DetailLoaded {
+		return nil
+	}
+	id := inc.ID
+	m.prefetchID = id
+	return tea.Tick(prefetchDelay, func(time.Time) tea.Msg {
+		return PrefetchTickMsg{ID: id}
+	})
+}
+
+// handlePrefetchTick fetches the selected incident's detail in the background if
+// the cursor is still on it and it isn't loaded or being fetched already
+func (m Model) handlePrefetchTick(msg PrefetchTickMsg) (tea.Model, tea.Cmd) {
+	// A newer timer, or a tab switch, replaced this one
+	if msg.ID != m.prefetchID {
+		return m, nil
+	}
+	m.prefetchID = ""
+	if m.activeTab != TabIncidents || m.selectedID() != msg.ID {
+		return m, nil
+	}
+	inc := m.incidents.SelectedIncident()
+	if inc.DetailLoaded || m.incidents.IsLoadingIncident(inc.ID) || m.prefetching[inc.ID] {
+		return m, nil
+	}
+	// Leave the remaining requests for ones the user asks for
+	if m.rateLimitLow() {
+		debug.Logger.Debug("Skipping prefetch, rate limit is low", "id", inc.ID)
+		return m, nil
+	}
+	if m.prefetching == nil {
+		m.prefetching = make(map[string]bool)
+	}
+	m.prefetching[inc.ID] = true
+	return m, m.fetchIncidentDetail(inc.ID, inc.UpdatedAt, m.incidents.SelectedIndex(), true)
+}
+
+// handlePrefetchedIncident stores a prefetched detail without focusing it or
+// reporting errors, unless Enter was pressed while it was in flight
+func (m Model) handlePrefetchedIncident(msg IncidentDetailLoadedMsg) (tea.Model, tea.Cmd) {
+	delete(m.prefetching, msg.ID)
+	if m.incidents.IsLoadingIncident(msg.ID) {
+		// Finish it as the load Enter asked for; Update has already counted
+		// it as done, so don't go through it again
+		return m.handleIncidentDetailLoaded(msg)
+	}
+	if msg.Err != nil {
+		debug.Logger.Debug("Prefetching incident detail failed", "id", msg.ID, "error", msg.Err)
+		return m, nil
+	}
+	// The list may have changed while the request was in flight
+	if index := m.incidents.IndexOf(msg.ID); index >= 0 && msg.Incident != nil {
+		m.incidents.SetDetailFromCache(msg.ID, msg.FromCache)
+		m.incidents.UpdateIncidentDetail(index, msg.Incident)
+	}
+	return m, nil
+}
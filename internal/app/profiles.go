@@ -0,0 +1,159 @@
+package app
+
+import (
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/components"
+	"github.com/rootlyhq/rootly-tui/internal/config"
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/state"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
+	"github.com/rootlyhq/rootly-tui/internal/views"
+)
+
+// activeProfile returns the config profile in use (empty before setup)
+func (m Model) activeProfile() string {
+	if m.cfg == nil {
+		return ""
+	}
+	return m.cfg.Profile
+}
+
+// openProfilePicker lists the config profiles, or reports that there's only one
+func (m *Model) openProfilePicker() {
+	names, active, err := config.Profiles()
+	if err != nil {
+		debug.Logger.Warn("Failed to list profiles", "error", err)
+		m.errorMsg = i18n.Tf("profiles.switch_failed", map[string]any{"Error": err.Error()})
+		return
+	}
+	if len(names) < 2 {
+		m.statusMsg = i18n.Tf("profiles.none", map[string]any{"Name": active})
+		return
+	}
+	options := make([]components.PickerOption, len(names))
+	for i, name := range names {
+		options[i] = components.PickerOption{Label: name, Value: name}
+	}
+	m.profilePicker.SetOptions(options)
+	m.profilePicker.Toggle()
+}
+
+// handleProfilePickerKey switches to the picked profile
+func (m *Model) handleProfilePickerKey(key string) tea.Cmd {
+	name, shouldApply := m.profilePicker.HandleKey(key)
+	if !shouldApply || name == m.activeProfile() {
+		return nil
+	}
+	return m.switchProfile(name)
+}
+
+// switchProfile makes name the current profile, replaces the API client with
+// one for its account and reloads from a clean slate. A profile without
+// credentials opens setup for it.
+func (m *Model) switchProfile(name string) tea.Cmd {
+	// Results still on their way belong to the old account
+	if m.inFlight > 0 {
+		m.statusMsg = i18n.T("profiles.busy")
+		return nil
+	}
+	if err := config.UseProfile(name); err != nil {
+		debug.Logger.Warn("Failed to switch profile", "profile", name, "error", err)
+		m.errorMsg = i18n.Tf("profiles.switch_failed", map[string]any{"Error": err.Error()})
+		return nil
+	}
+	cfg, resolveErr := config.Resolve()
+	if cfg == nil {
+		debug.Logger.Warn("Failed to load profile", "profile", name, "error", resolveErr)
+		m.errorMsg = i18n.Tf("profiles.switch_failed", map[string]any{"Error": resolveErr.Error()})
+		return nil
+	}
+
+	// The cache database takes one handle at a time, so the old client goes first
+	if m.apiClient != nil {
+		if err := m.apiClient.Close(); err != nil {
+			debug.Logger.Warn("Failed to close API client", "error", err)
+		}
+		m.apiClient = nil
+	}
+	m.resetViews()
+	m.setup = views.NewSetupModelWithConfig(cfg)
+	if m.width > 0 {
+		m.setup.SetDimensions(m.width, m.height)
+	}
+	m.applyProfileConfig(cfg)
+	m.errorMsg = ""
+	m.statusMsg = i18n.Tf("profiles.switched", map[string]any{"Name": name})
+
+	if resolveErr != nil {
+		debug.Logger.Info("Profile is not set up, starting setup", "profile", name, "reason", resolveErr)
+		m.screen = ScreenSetup
+		return nil
+	}
+	client, err := m.newAPIClient(cfg)
+	if err != nil {
+		debug.Logger.Warn("Failed to create API client for profile", "profile", name, "error", err)
+		m.screen = ScreenSetup
+		return nil
+	}
+	m.apiClient = client
+	// Fit the page size now; the reload below fetches with it
+	m.applyAutoPageSize()
+	m.SetStartTab(m.activeTab)
+	m.conn = connLoading
+	m.initialLoading = true
+	return tea.Batch(m.spinner.Tick, m.loadData(), m.restartAutoRefresh())
+}
+
+// resetViews replaces the lists with empty ones, dropping the previous
+// account's data, selection, filters and scope
+func (m *Model) resetViews() {
+	m.incidents = views.NewIncidentsModel()
+	m.alerts = views.NewAlertsModel()
+	m.services = views.NewServicesModel()
+	m.summary = views.NewSummaryModel()
+	m.scope = scopeAll
+	m.scopeUser = nil
+	m.scopeTeam = ""
+	m.currentView = ""
+	m.bulkAck = bulkAckState{}
+	m.pageWalk = pageWalk{}
+	m.prefetchID = ""
+	m.prefetching = nil
+	m.incidentsCachedAt = time.Time{}
+	m.alertsCachedAt = time.Time{}
+	m.restore = state.State{}
+	if m.width > 0 {
+		m.incidents.SetDimensions(m.width-4, m.listHeight())
+		m.alerts.SetDimensions(m.width-4, m.listHeight())
+		m.services.SetDimensions(m.width-4, m.listHeight())
+	}
+}
+
+// applyProfileConfig makes cfg the model's config and applies its display
+// settings; used at startup, after setup saves and on a profile switch
+func (m *Model) applyProfileConfig(cfg *config.Config) {
+	m.cfg = cfg
+	m.leaderKeys = leaderSequences(cfg.LeaderKeys)
+	if cfg.Language != "" {
+		i18n.SetLanguage(i18n.Language(cfg.Language))
+	}
+	if cfg.Layout != "" {
+		m.incidents.SetLayout(cfg.Layout)
+		m.alerts.SetLayout(cfg.Layout)
+		m.services.SetLayout(cfg.Layout)
+	}
+	styles.SetStatusMap(cfg.StatusMap)
+	styles.SetEnvironmentColors(cfg.EnvironmentColors)
+	views.SetTimeFormat(cfg.TimeLayout())
+	m.incidents.SetLocation(cfg.GetLocation())
+	m.incidents.SetShowInitials(cfg.ShowInitials)
+	m.relativeTimes = cfg.RelativeTimes
+	m.incidents.SetRelativeTimes(m.relativeTimes)
+	m.alerts.SetRelativeTimes(m.relativeTimes)
+	m.alerts.SetMaxLabelValueLen(cfg.LabelValueLimit())
+	m.alerts.SetSortConfig(cfg.AlertsSort)
+}
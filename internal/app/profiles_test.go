@@ -0,0 +1,106 @@
+package app
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/api"
+	"github.com/rootlyhq/rootly-tui/internal/config"
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/views"
+)
+
+func TestModelSwitchProfile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	for _, cfg := range []*config.Config{
+		{APIKey: "work-key", Endpoint: "api.work.example.com", Profile: "work"},
+		{APIKey: "personal-key", Endpoint: "api.personal.example.com", Profile: "personal"},
+	} {
+		if err := config.Save(cfg); err != nil {
+			t.Fatalf("failed to save config: %v", err)
+		}
+	}
+
+	m := New("1.0.0")
+	if m.screen != ScreenMain || m.activeProfile() != "work" {
+		t.Fatalf("expected to start on the current profile, got screen %v profile %q", m.screen, m.activeProfile())
+	}
+	oldClient := m.apiClient
+	defer func() { _ = m.Close() }()
+	m.incidents.SetIncidents([]api.Incident{{ID: "inc_1", Title: "Work incident"}}, api.PaginationInfo{CurrentPage: 1})
+
+	// Requests in flight hold the switch
+	m.inFlight = 1
+	m.switchProfile("personal")
+	if m.activeProfile() != "work" || m.statusMsg != i18n.T("profiles.busy") {
+		t.Errorf("expected the switch to wait for requests in flight, got profile %q status %q", m.activeProfile(), m.statusMsg)
+	}
+	m.inFlight = 0
+
+	// P lists both profiles, sorted, so Enter picks personal
+	newModel, _ := m.Update(tea.KeyPressMsg{Code: 'P', Text: "P"})
+	m = newModel.(Model)
+	if !m.profilePicker.IsVisible() || len(m.profilePicker.Options()) != 2 {
+		t.Fatalf("expected the profile picker with 2 profiles, got %+v", m.profilePicker.Options())
+	}
+	newModel, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = newModel.(Model)
+	if cmd == nil {
+		t.Fatal("expected a reload after switching profiles")
+	}
+	if m.activeProfile() != "personal" || m.cfg.APIKey != "personal-key" {
+		t.Errorf("expected the personal profile, got %q", m.activeProfile())
+	}
+	if m.apiClient == nil || m.apiClient == oldClient {
+		t.Error("expected a new API client for the personal profile")
+	}
+	if m.incidents.SelectedIncident() != nil || !m.initialLoading {
+		t.Error("expected the previous account's incidents cleared and a fresh load")
+	}
+	if m.statusMsg != i18n.Tf("profiles.switched", map[string]any{"Name": "personal"}) {
+		t.Errorf("unexpected status %q", m.statusMsg)
+	}
+
+	// The switch is remembered as the current profile
+	if _, active, err := config.Profiles(); err != nil || active != "personal" {
+		t.Errorf("expected personal saved as current, got %q (%v)", active, err)
+	}
+}
+
+func TestModelProfilePickerSingleProfile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if err := config.Save(&config.Config{APIKey: "k", Endpoint: "api.rootly.com"}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+
+	m := New("1.0.0")
+	defer func() { _ = m.Close() }()
+	newModel, _ := m.Update(tea.KeyPressMsg{Code: 'P', Text: "P"})
+	m = newModel.(Model)
+	if m.profilePicker.IsVisible() {
+		t.Error("expected no picker with a single profile")
+	}
+	if m.statusMsg != i18n.Tf("profiles.none", map[string]any{"Name": config.DefaultProfile}) {
+		t.Errorf("unexpected status %q", m.statusMsg)
+	}
+}
+
+func TestModelPreferencesSavedAppliesConfig(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := New("1.0.0")
+
+	// Settings saved from setup take effect as they would at startup
+	if err := config.Save(&config.Config{APIKey: "k", Endpoint: "api.rootly.com", RelativeTimes: true, LeaderKeys: map[string]string{",x": "sort"}}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	newModel, _ := m.Update(views.PreferencesSavedMsg{Success: true})
+	m = newModel.(Model)
+	if !m.relativeTimes {
+		t.Error("expected relative_times applied after saving preferences")
+	}
+	if m.leaderKeys[",x"] != "sort" {
+		t.Errorf("expected leader_keys applied after saving preferences, got %v", m.leaderKeys)
+	}
+}
@@ -0,0 +1,35 @@
+package app
+
+import (
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/api"
+)
+
+// rateLimitRefreshFactor stretches the auto-refresh interval while the rate
+// limit is low
+const rateLimitRefreshFactor = 4
+
+// rateLimitStatus returns the API's latest rate limit (unknown without a client)
+func (m Model) rateLimitStatus() api.RateLimitStatus {
+	if m.apiClient == nil {
+		return api.RateLimitStatus{}
+	}
+	return m.apiClient.RateLimitStatus()
+}
+
+// rateLimitLow reports whether background fetches should be skipped or spaced out
+func (m Model) rateLimitLow() bool {
+	return m.rateLimitStatus().Low(time.Now())
+}
+
+// throttledRefreshInterval stretches interval while the rate limit is low, to
+// at least the time left until the window resets
+func (m Model) throttledRefreshInterval(interval time.Duration) time.Duration {
+	status := m.rateLimitStatus()
+	now := time.Now()
+	if !status.Low(now) {
+		return interval
+	}
+	return max(interval*rateLimitRefreshFactor, status.Reset.Sub(now))
+}
@@ -0,0 +1,148 @@
+package app
+
+import (
+	"sort"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/components"
+	"github.com/rootlyhq/rootly-tui/internal/config"
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/views"
+)
+
+// Tab names used in saved views
+const (
+	viewTabIncidents = "incidents"
+	viewTabAlerts    = "alerts"
+	viewTabServices  = "services"
+)
+
+// CaptureViewState returns the current tab, filters, sorts and list toggles
+func (m Model) CaptureViewState() config.ViewState {
+	return config.ViewState{
+		Tab:             m.activeTab.String(),
+		Team:            m.incidents.TeamFilter(),
+		Search:          m.incidents.SearchQuery(),
+		Status:          m.incidents.StatusFilter().String(),
+		Severity:        m.incidents.SeverityFilter().String(),
+		IncidentsSort:   m.incidents.SortConfig(),
+		AlertsSort:      m.alerts.SortConfig(),
+		OnCallOnly:      m.incidents.IsOnCallOnly(),
+		GroupByIncident: m.alerts.IsGroupedByIncident(),
+	}
+}
+
+// ApplyViewState restores a view captured with CaptureViewState. The returned
+// command reloads incidents when the team or API sort changed, and resolves the on-call
+// scopes if the view needs them and they aren't known yet.
+func (m *Model) ApplyViewState(v config.ViewState) tea.Cmd {
+	m.incidents.SetDetailFocused(false)
+	m.alerts.SetDetailFocused(false)
+	m.services.SetDetailFocused(false)
+	// Unknown tabs fall back to incidents
+	m.activeTab, _ = ParseTab(v.Tab)
+
+	// The view's team filter replaces the U scope
+	m.scope = scopeAll
+	m.incidents.SetMineFilter(nil)
+	teamChanged := m.incidents.SetTeamFilter(v.Team)
+	m.incidents.Filter(v.Search)
+	m.incidents.SetStatusFilter(views.ParseStatusFilter(v.Status))
+	m.incidents.SetSeverityFilter(views.ParseSeverityFilter(v.Severity))
+
+	m.alerts.SetSortConfig(v.AlertsSort)
+	if m.alerts.IsGroupedByIncident() != v.GroupByIncident {
+		m.alerts.ToggleGroupByIncident()
+	}
+
+	var cmds []tea.Cmd
+	if m.activeTab == TabServices && !m.services.Requested() {
+		m.services.SetLoading(true)
+		cmds = append(cmds, m.loadServices())
+	}
+	if m.incidents.SetSortConfig(v.IncidentsSort) || teamChanged {
+		m.incidents.SetLoading(true)
+		cmds = append(cmds, m.loadIncidents())
+	}
+	switch {
+	case !v.OnCallOnly:
+		m.incidents.SetOnCallOnly(false)
+	case m.incidents.OnCallScopes() != nil:
+		m.applyOnCallFilter(m.incidents.OnCallScopes())
+	default:
+		m.incidents.SetOnCallOnly(false)
+		cmds = append(cmds, m.loadOnCallScopes())
+	}
+	return tea.Batch(cmds...)
+}
+
+// askSaveView prompts for a name to save the current view under
+func (m *Model) askSaveView() tea.Cmd {
+	return m.prompt.Ask(i18n.T("views.save_prompt"), m.currentView, func(name string) tea.Cmd {
+		return func() tea.Msg { return SaveViewMsg{Name: name} }
+	})
+}
+
+// saveView records the current view under name in config
+func (m *Model) saveView(name string) {
+	state := m.CaptureViewState()
+	cfg, err := config.Load()
+	if err != nil {
+		debug.Logger.Warn("Failed to load config to save view", "error", err)
+		m.errorMsg = i18n.Tf("views.save_failed", map[string]any{"Error": err.Error()})
+		return
+	}
+	if cfg.SavedViews == nil {
+		cfg.SavedViews = make(map[string]config.ViewState)
+	}
+	cfg.SavedViews[name] = state
+	if err := config.Save(cfg); err != nil {
+		debug.Logger.Warn("Failed to save view", "error", err)
+		m.errorMsg = i18n.Tf("views.save_failed", map[string]any{"Error": err.Error()})
+		return
+	}
+	if m.cfg != nil {
+		if m.cfg.SavedViews == nil {
+			m.cfg.SavedViews = make(map[string]config.ViewState)
+		}
+		m.cfg.SavedViews[name] = state
+	}
+	m.currentView = name
+	m.statusMsg = i18n.Tf("views.saved", map[string]any{"Name": name})
+}
+
+// openViewPicker lists the saved views, or reports that there are none
+func (m *Model) openViewPicker() {
+	if m.cfg == nil || len(m.cfg.SavedViews) == 0 {
+		m.statusMsg = i18n.T("views.none")
+		return
+	}
+	names := make([]string, 0, len(m.cfg.SavedViews))
+	for name := range m.cfg.SavedViews {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	options := make([]components.PickerOption, len(names))
+	for i, name := range names {
+		options[i] = components.PickerOption{Label: name, Value: name}
+	}
+	m.viewPicker.SetOptions(options)
+	m.viewPicker.Toggle()
+}
+
+// handleViewPickerKey applies the picked saved view
+func (m *Model) handleViewPickerKey(key string) tea.Cmd {
+	name, shouldApply := m.viewPicker.HandleKey(key)
+	if !shouldApply || m.cfg == nil {
+		return nil
+	}
+	v, ok := m.cfg.SavedViews[name]
+	if !ok {
+		return nil
+	}
+	m.currentView = name
+	m.statusMsg = i18n.Tf("views.applied", map[string]any{"Name": name})
+	return m.ApplyViewState(v)
+}
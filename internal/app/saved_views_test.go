@@ -0,0 +1,103 @@
+package app
+
+import (
+	"os"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/api"
+	"github.com/rootlyhq/rootly-tui/internal/config"
+	"github.com/rootlyhq/rootly-tui/internal/views"
+)
+
+func TestModelViewStateRoundTrip(t *testing.T) {
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.incidents.SetIncidents([]api.Incident{
+		{ID: "inc_1", Title: "Checkout latency", Status: "started", Severity: "high", Teams: []string{"Payments"}},
+		{ID: "inc_2", Title: "Checkout errors", Teams: []string{"Platform"}},
+		{ID: "inc_3", Title: "Disk full", Teams: []string{"Payments"}},
+	}, api.PaginationInfo{CurrentPage: 1})
+	m.alerts.SetAlerts([]api.Alert{{ID: "a1", Source: "datadog"}}, api.PaginationInfo{CurrentPage: 1})
+
+	m.incidents.SetTeamFilter("Payments")
+	m.incidents.Filter("checkout")
+	m.incidents.SetStatusFilter(views.StatusFilterActive)
+	m.incidents.SetSeverityFilter(views.SeverityFilterHigh)
+	m.incidents.SetSortConfig("-severity")
+	m.alerts.SetSortConfig("source")
+	m.alerts.ToggleGroupByIncident()
+	m.activeTab = TabAlerts
+
+	saved := m.CaptureViewState()
+	want := config.ViewState{
+		Tab:             "alerts",
+		Team:            "Payments",
+		Search:          "checkout",
+		Status:          "active",
+		Severity:        "high",
+		IncidentsSort:   "-severity",
+		AlertsSort:      "source",
+		GroupByIncident: true,
+	}
+	if saved != want {
+		t.Fatalf("expected captured state %+v, got %+v", want, saved)
+	}
+
+	// Reset everything, then apply the saved view
+	m.activeTab = TabIncidents
+	m.incidents.SetTeamFilter("")
+	m.incidents.Filter("")
+	m.incidents.SetStatusFilter(views.StatusFilterAll)
+	m.incidents.SetSeverityFilter(views.SeverityFilterAll)
+	m.incidents.SetSortConfig("")
+	m.alerts.SetSortConfig("")
+	m.alerts.ToggleGroupByIncident()
+
+	m.ApplyViewState(saved)
+	if got := m.CaptureViewState(); got != want {
+		t.Errorf("expected applied state %+v, got %+v", want, got)
+	}
+	if got := m.incidents.Incidents(); len(got) != 1 || got[0].ID != "inc_1" {
+		t.Errorf("expected the team, search and status filters applied, got %+v", got)
+	}
+
+	// A server-side sort change reloads incidents
+	if cmd := m.ApplyViewState(config.ViewState{IncidentsSort: "updated"}); cmd == nil {
+		t.Error("expected a reload when the API sort changes")
+	}
+}
+
+func TestModelSaveAndPickView(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", originalHome)
+
+	m := New("1.0.0")
+	m.screen = ScreenMain
+	m.cfg = &config.Config{APIKey: "k", Endpoint: "api.rootly.com"}
+	if err := config.Save(m.cfg); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	m.incidents.Filter("db")
+
+	newModel, _ := m.Update(SaveViewMsg{Name: "db incidents"})
+	m = newModel.(Model)
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if cfg.SavedViews["db incidents"].Search != "db" {
+		t.Errorf("expected the view saved to config, got %+v", cfg.SavedViews)
+	}
+
+	m.incidents.Filter("")
+	newModel, _ = m.Update(tea.KeyPressMsg{Code: 'F', Text: "F"})
+	newModel, _ = newModel.(Model).Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = newModel.(Model)
+	if m.incidents.SearchQuery() != "db" || m.currentView != "db incidents" {
+		t.Errorf("expected the picked view applied, got search %q view %q", m.incidents.SearchQuery(), m.currentView)
+	}
+}
@@ -0,0 +1,30 @@
+package app
+
+import (
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+	"github.com/rootlyhq/rootly-tui/internal/state"
+)
+
+// restoreState reopens the tab left on the last run; the saved cursors are
+// applied once the lists load, clamped to the page
+func (m *Model) restoreState() {
+	m.restore = state.Load()
+	if tab, ok := ParseTab(m.restore.Tab); ok {
+		m.SetStartTab(tab)
+	}
+}
+
+// saveState records the active tab and list cursors for the next launch
+func (m Model) saveState() {
+	if m.screen != ScreenMain {
+		return
+	}
+	s := state.State{
+		Tab:           m.activeTab.String(),
+		IncidentIndex: max(m.incidents.SelectedIndex(), 0),
+		AlertIndex:    max(m.alerts.SelectedIndex(), 0),
+	}
+	if err := state.Save(s); err != nil {
+		debug.Logger.Warn("Failed to save UI state", "error", err)
+	}
+}
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+)
+
+// requestTimeout returns how long one API command may run
+func (m Model) requestTimeout() time.Duration {
+	if m.cfg == nil {
+		return config.DefaultRequestTimeoutSeconds * time.Second
+	}
+	return m.cfg.RequestTimeout()
+}
+
+// requestContext returns the context for one API command, expiring after timeout
+func requestContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), timeout)
+}
+
+// timeoutError reports a request that ran past its deadline, naming the timeout
+// instead of the transport's "context deadline exceeded"
+type timeoutError struct {
+	after time.Duration
+	err   error
+}
+
+func (e timeoutError) Error() string {
+	return i18n.Tf("common.request_timed_out", map[string]any{"Seconds": int(e.after.Seconds())})
+}
+
+func (e timeoutError) Unwrap() error { return e.err }
+
+// requestError returns err, replaced with a timeoutError when the request timed out
+func requestError(err error, timeout time.Duration) error {
+	if err == nil {
+		return nil
+	}
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return timeoutError{after: timeout, err: err}
+	}
+	return err
+}
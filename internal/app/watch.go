@@ -0,0 +1,207 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/api"
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
+)
+
+// watchRefreshInterval is how often the focused incident is re-fetched in watch mode
+const watchRefreshInterval = 30 * time.Second
+
+// Watch mode Updates panel: entries kept, and lines shown (plus its title)
+const (
+	maxWatchLog       = 200
+	watchUpdatesLines = 6
+)
+
+// SetFocus starts the app in watch mode: a full-screen, auto-refreshing detail of
+// the referenced incident (INC-123, 123 or an incident ID). It has no effect until
+// the app is configured, in which case setup runs first and leads to the main screen.
+func (m *Model) SetFocus(ref string) {
+	if m.screen != ScreenMain || strings.TrimSpace(ref) == "" {
+		return
+	}
+	m.screen = ScreenWatch
+	m.focusRef = strings.TrimSpace(ref)
+	m.initialLoading = true
+}
+
+// loadFocusIncident fetches the watched incident in the background
+func (m Model) loadFocusIncident() tea.Cmd {
+	return background(fetchFocusIncident(m.apiClient, m.focusRef, m.requestTimeout()))
+}
+
+// fetchFocusIncident resolves ref and fetches the incident's full detail
+func fetchFocusIncident(client *api.Client, ref string, timeout time.Duration) tea.Cmd {
+	return func() tea.Msg {
+		inc, err := fetchIncidentByRef(client, ref, timeout)
+		return FocusIncidentLoadedMsg{Incident: inc, Err: err}
+	}
+}
+
+// fetchIncidentByRef resolves an incident reference (INC-123, 123 or an ID) and
+// fetches the incident's full detail, both within timeout
+func fetchIncidentByRef(client *api.Client, ref string, timeout time.Duration) (*api.Incident, error) {
+	if client == nil {
+		return nil, fmt.Errorf("API client not initialized")
+	}
+	ctx, cancel := requestContext(timeout)
+	defer cancel()
+	id, updatedAt, err := client.ResolveIncidentRef(ctx, ref)
+	if err != nil {
+		return nil, requestError(err, timeout)
+	}
+	inc, err := client.GetIncident(ctx, id, updatedAt)
+	return inc, requestError(err, timeout)
+}
+
+// scheduleWatchRefresh queues the next watch-mode refresh
+func scheduleWatchRefresh() tea.Cmd {
+	return tea.Tick(watchRefreshInterval, func(time.Time) tea.Msg {
+		return WatchTickMsg{}
+	})
+}
+
+// updateWatchKey handles keys in watch mode: r refreshes, j/k scroll the Updates
+// panel, q/esc quit (there is no list to return to)
+func (m Model) updateWatchKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Quit) || msg.String() == "esc":
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Refresh):
+		m.loading = true
+		return m, tea.Batch(m.spinner.Tick, m.loadFocusIncident())
+	case key.Matches(msg, m.keys.Up):
+		if m.watchScroll < len(m.watchLog)-watchUpdatesLines {
+			m.watchScroll++
+		}
+	case key.Matches(msg, m.keys.Down):
+		if m.watchScroll > 0 {
+			m.watchScroll--
+		}
+	}
+	return m, nil
+}
+
+// logWatchChanges appends the changes from prev to next to the watch change log
+func (m *Model) logWatchChanges(prev, next *api.Incident, at time.Time) {
+	for _, c := range api.DiffIncidents(prev, next) {
+		m.watchLog = append(m.watchLog, at.Format("15:04")+" "+formatIncidentChange(c))
+		if m.watchScroll > 0 {
+			// Keep the scrolled-to entries in view as new ones arrive
+			m.watchScroll++
+		}
+	}
+	if over := len(m.watchLog) - maxWatchLog; over > 0 {
+		m.watchLog = m.watchLog[over:]
+	}
+}
+
+// formatIncidentChange describes a change for the Updates panel
+func formatIncidentChange(c api.IncidentChange) string {
+	switch c.Kind {
+	case api.ChangeRoleAssigned:
+		return i18n.Tf("watch.change.role_assigned", map[string]any{"User": c.To, "Role": c.Name})
+	case api.ChangeRoleUnassigned:
+		return i18n.Tf("watch.change.role_unassigned", map[string]any{"User": c.From, "Role": c.Name})
+	case api.ChangeMilestone:
+		return i18n.Tf("watch.change.milestone", map[string]any{
+			"Milestone": i18n.T("incidents.timeline." + c.Name),
+			"Time":      c.At.Local().Format("15:04"),
+		})
+	case api.ChangeActionItemAdded, api.ChangeActionItemDone:
+		return i18n.Tf("watch.change."+string(c.Kind), map[string]any{"Title": c.Name})
+	default:
+		return i18n.Tf("watch.change."+string(c.Kind), map[string]any{"From": c.From, "To": c.To})
+	}
+}
+
+// handleFocusIncidentLoaded shows the fetched incident and schedules the next refresh.
+// Failed refreshes keep the last good detail on screen.
+func (m Model) handleFocusIncidentLoaded(msg FocusIncidentLoadedMsg) (tea.Model, tea.Cmd) {
+	m.loading = false
+	m.initialLoading = false
+	if msg.Err != nil {
+		if m.handleOAuthExpired(msg.Err) {
+			return m, m.setup.Init()
+		}
+		m.errorMsg = msg.Err.Error()
+		return m, scheduleWatchRefresh()
+	}
+	m.errorMsg = ""
+	now := time.Now()
+	if prev := m.incidents.SelectedIncident(); prev != nil && prev.ID == msg.Incident.ID {
+		m.logWatchChanges(prev, msg.Incident, now)
+	}
+	m.watchUpdated = now
+	m.incidents.SetIncidents([]api.Incident{*msg.Incident}, api.PaginationInfo{CurrentPage: 1})
+	return m, scheduleWatchRefresh()
+}
+
+// renderWatchView renders the full-screen detail of the watched incident
+func (m Model) renderWatchView() string {
+	var b strings.Builder
+
+	header := styles.Title.Render(i18n.T("app.title")) + "  " +
+		styles.TextBold.Render(i18n.Tf("watch.title", map[string]any{"ID": m.focusRef}))
+	if !m.watchUpdated.IsZero() {
+		header += "  " + styles.TextDim.Render(i18n.Tf("watch.updated", map[string]any{
+			"Time":    m.watchUpdated.Format("15:04:05"),
+			"Seconds": int(watchRefreshInterval.Seconds()),
+		}))
+	}
+	b.WriteString(header)
+	b.WriteString("\n\n")
+
+	// Header, blank line and status line, and the Updates panel below the detail
+	height := m.height - 4 - (watchUpdatesLines + 2)
+	if height < 3 {
+		height = 3
+	}
+	switch {
+	case m.initialLoading:
+		b.WriteString(m.spinner.View() + " " + i18n.T("common.loading"))
+	case m.incidents.SelectedIncident() == nil:
+		b.WriteString(styles.TextDim.Render(i18n.T("watch.not_found")))
+	default:
+		b.WriteString(m.incidents.RenderFullDetail(m.width, height))
+		b.WriteString("\n")
+		b.WriteString(m.renderWatchUpdates())
+	}
+
+	b.WriteString("\n")
+	if m.errorMsg != "" {
+		b.WriteString(styles.Error.Render(m.errorMsg))
+	} else {
+		b.WriteString(styles.TextDim.Render(i18n.T("watch.help")))
+	}
+	return b.String()
+}
+
+// renderWatchUpdates renders the Updates panel: the newest change log entries,
+// or older ones when scrolled back with k
+func (m Model) renderWatchUpdates() string {
+	var b strings.Builder
+	b.WriteString(styles.TextBold.Render(i18n.T("watch.updates")))
+	if len(m.watchLog) == 0 {
+		b.WriteString("\n" + styles.TextDim.Render(i18n.T("watch.no_updates")))
+		return b.String()
+	}
+	end := len(m.watchLog) - m.watchScroll
+	start := end - watchUpdatesLines
+	if start < 0 {
+		start = 0
+	}
+	for _, entry := range m.watchLog[start:end] {
+		b.WriteString("\n" + styles.Text.Render(entry))
+	}
+	return b.String()
+}
@@ -0,0 +1,61 @@
+package components
+
+import (
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
+)
+
+// ConfirmModel is a small yes/no prompt that holds a pending command
+// until the user confirms it.
+
+type ConfirmModel struct {
+	visible bool
+	message string
+	pending tea.Cmd
+}
+
+func NewConfirm() *ConfirmModel {
+	return &ConfirmModel{}
+}
+
+// Ask shows the prompt with the given message; cmd runs only on confirm
+func (m *ConfirmModel) Ask(message string, cmd tea.Cmd) {
+	m.visible = true
+	m.message = message
+	m.pending = cmd
+}
+
+func (m *ConfirmModel) IsVisible() bool {
+	return m.visible
+}
+
+// HandleKey closes the prompt and returns the pending command if confirmed.
+// Any key other than y/Y cancels.
+func (m *ConfirmModel) HandleKey(key string) tea.Cmd {
+	cmd := m.pending
+	m.visible = false
+	m.pending = nil
+	if key == "y" || key == "Y" {
+		return cmd
+	}
+	return nil
+}
+
+func (m *ConfirmModel) Render() string {
+	if !m.visible {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(styles.DialogTitle.Render(i18n.T("confirm.title")))
+	b.WriteString("\n\n")
+	b.WriteString(styles.Text.Render(m.message))
+	b.WriteString("\n\n")
+	b.WriteString(styles.TextDim.Render(i18n.T("confirm.help")))
+
+	return styles.Dialog.Render(b.String())
+}
@@ -0,0 +1,59 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+type confirmTestMsg struct{}
+
+func TestConfirmAccept(t *testing.T) {
+	confirm := NewConfirm()
+	confirm.Ask("Do it?", func() tea.Msg { return confirmTestMsg{} })
+
+	if !confirm.IsVisible() {
+		t.Fatal("expected prompt to be visible after Ask")
+	}
+
+	cmd := confirm.HandleKey("y")
+	if cmd == nil {
+		t.Fatal("expected pending command on confirm")
+	}
+	if _, ok := cmd().(confirmTestMsg); !ok {
+		t.Error("expected the pending command to be returned")
+	}
+	if confirm.IsVisible() {
+		t.Error("expected prompt to close after confirm")
+	}
+}
+
+func TestConfirmCancel(t *testing.T) {
+	confirm := NewConfirm()
+	confirm.Ask("Do it?", func() tea.Msg { return confirmTestMsg{} })
+
+	if cmd := confirm.HandleKey("n"); cmd != nil {
+		t.Error("expected no command on cancel")
+	}
+	if confirm.IsVisible() {
+		t.Error("expected prompt to close after cancel")
+	}
+
+	// Pending command is discarded
+	if cmd := confirm.HandleKey("y"); cmd != nil {
+		t.Error("expected no command after prompt was cancelled")
+	}
+}
+
+func TestConfirmRender(t *testing.T) {
+	confirm := NewConfirm()
+	if confirm.Render() != "" {
+		t.Error("expected empty render when hidden")
+	}
+
+	confirm.Ask("Reopen INC-1?", nil)
+	if !strings.Contains(confirm.Render(), "Reopen INC-1?") {
+		t.Error("expected message in render")
+	}
+}
@@ -0,0 +1,21 @@
+package components
+
+// NextMatching returns the index of the first item after from that matches,
+// wrapping around to the start of the list, or -1 when none match. The item at
+// from is checked last, so a lone match is found again.
+func NextMatching[T any](items []T, from int, match func(*T) bool) int {
+	n := len(items)
+	if n == 0 {
+		return -1
+	}
+	if from < 0 || from >= n {
+		from = n - 1
+	}
+	for i := 1; i <= n; i++ {
+		idx := (from + i) % n
+		if match(&items[idx]) {
+			return idx
+		}
+	}
+	return -1
+}
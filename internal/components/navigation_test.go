@@ -0,0 +1,33 @@
+package components
+
+import "testing"
+
+func TestNextMatching(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6}
+	even := func(v *int) bool { return *v%2 == 0 }
+
+	tests := []struct {
+		from int
+		want int
+	}{
+		{0, 1},  // 2
+		{1, 3},  // 4
+		{5, 1},  // wraps to 2
+		{-1, 1}, // no cursor starts at the top
+	}
+	for _, tt := range tests {
+		if got := NextMatching(items, tt.from, even); got != tt.want {
+			t.Errorf("NextMatching(from %d) = %d, want %d", tt.from, got, tt.want)
+		}
+	}
+
+	if got := NextMatching(items, 2, func(v *int) bool { return *v == 3 }); got != 2 {
+		t.Errorf("expected a lone match at the cursor to be found, got %d", got)
+	}
+	if got := NextMatching(items, 0, func(v *int) bool { return *v > 10 }); got != -1 {
+		t.Errorf("expected -1 without matches, got %d", got)
+	}
+	if got := NextMatching([]int(nil), 0, even); got != -1 {
+		t.Errorf("expected -1 for an empty list, got %d", got)
+	}
+}
@@ -0,0 +1,152 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
+)
+
+// PickerModel provides a reusable single-choice list overlay (e.g. team picker).
+// Options are plain strings; an empty Value represents "no selection".
+
+type PickerModel struct {
+	visible bool
+	cursor  int
+	offset  int
+	title   string
+	options []PickerOption
+}
+
+type PickerOption struct {
+	Label string
+	Value string
+}
+
+// pickerMaxVisible limits how many options are rendered at once
+const pickerMaxVisible = 12
+
+func NewPicker(title string) *PickerModel {
+	return &PickerModel{
+		title: title,
+	}
+}
+
+// SetOptions replaces the available options, keeping the cursor in range
+func (m *PickerModel) SetOptions(options []PickerOption) {
+	m.options = options
+	if m.cursor >= len(m.options) {
+		m.cursor = 0
+		m.offset = 0
+	}
+}
+
+// Options returns the current options
+func (m *PickerModel) Options() []PickerOption {
+	return m.options
+}
+
+func (m *PickerModel) Toggle() {
+	m.visible = !m.visible
+	if m.visible {
+		m.cursor = 0
+		m.offset = 0
+	}
+}
+
+func (m *PickerModel) IsVisible() bool {
+	return m.visible
+}
+
+func (m *PickerModel) Close() {
+	m.visible = false
+}
+
+func (m *PickerModel) HandleKey(key string) (selected string, shouldApply bool) {
+	switch key {
+	case "j", "down":
+		if m.cursor < len(m.options)-1 {
+			m.cursor++
+		}
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "g", "home":
+		m.cursor = 0
+	case "G", "end":
+		if len(m.options) > 0 {
+			m.cursor = len(m.options) - 1
+		}
+	case "enter":
+		m.visible = false
+		if len(m.options) == 0 {
+			return "", false
+		}
+		return m.options[m.cursor].Value, true
+	case "esc", "q":
+		m.visible = false
+		return "", false
+	}
+
+	// Keep the cursor inside the visible window
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	} else if m.cursor >= m.offset+pickerMaxVisible {
+		m.offset = m.cursor - pickerMaxVisible + 1
+	}
+	return "", false
+}
+
+func (m *PickerModel) Render(current string) string {
+	if !m.visible {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(styles.DialogTitle.Render(m.title))
+	b.WriteString("\n\n")
+
+	if len(m.options) == 0 {
+		b.WriteString(styles.TextDim.Render(i18n.T("picker.empty")))
+		b.WriteString("\n")
+	}
+
+	end := m.offset + pickerMaxVisible
+	if end > len(m.options) {
+		end = len(m.options)
+	}
+	if m.offset > 0 {
+		b.WriteString(styles.TextDim.Render("  ↑"))
+		b.WriteString("\n")
+	}
+	for i := m.offset; i < end; i++ {
+		opt := m.options[i]
+		cursor := "  "
+		if i == m.cursor {
+			cursor = "▶ "
+		}
+
+		active := ""
+		if opt.Value == current {
+			active = " ✓"
+		}
+
+		line := cursor + opt.Label + active
+		if i == m.cursor {
+			b.WriteString(styles.Primary.Render(line))
+		} else {
+			b.WriteString(styles.Text.Render(line))
+		}
+		b.WriteString("\n")
+	}
+	if end < len(m.options) {
+		b.WriteString(styles.TextDim.Render("  ↓"))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(styles.TextDim.Render(i18n.T("sort_menu_help")))
+
+	return styles.Dialog.Render(b.String())
+}
@@ -0,0 +1,85 @@
+package components
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPickerHandleKey(t *testing.T) {
+	picker := NewPicker("Pick")
+	picker.SetOptions([]PickerOption{
+		{Label: "None", Value: ""},
+		{Label: "First", Value: "first"},
+		{Label: "Second", Value: "second"},
+	})
+	picker.Toggle()
+
+	picker.HandleKey("j")
+	picker.HandleKey("j")
+	picker.HandleKey("j") // stays at last option
+	selected, apply := picker.HandleKey("enter")
+
+	if !apply {
+		t.Fatal("expected enter to apply selection")
+	}
+	if selected != "second" {
+		t.Errorf("expected 'second', got %q", selected)
+	}
+	if picker.IsVisible() {
+		t.Error("expected picker to close after selection")
+	}
+}
+
+func TestPickerEscape(t *testing.T) {
+	picker := NewPicker("Pick")
+	picker.SetOptions([]PickerOption{{Label: "First", Value: "first"}})
+	picker.Toggle()
+
+	if _, apply := picker.HandleKey("esc"); apply {
+		t.Error("expected esc not to apply selection")
+	}
+	if picker.IsVisible() {
+		t.Error("expected picker to close on esc")
+	}
+}
+
+func TestPickerEmpty(t *testing.T) {
+	picker := NewPicker("Pick")
+	picker.Toggle()
+
+	if _, apply := picker.HandleKey("enter"); apply {
+		t.Error("expected enter on empty picker not to apply")
+	}
+}
+
+func TestPickerRender(t *testing.T) {
+	picker := NewPicker("Pick a team")
+	if picker.Render("") != "" {
+		t.Error("expected empty render when hidden")
+	}
+
+	options := make([]PickerOption, 0, 20)
+	for i := 0; i < 20; i++ {
+		options = append(options, PickerOption{Label: "Team " + string(rune('A'+i)), Value: string(rune('a' + i))})
+	}
+	picker.SetOptions(options)
+	picker.Toggle()
+
+	rendered := picker.Render("b")
+	if !strings.Contains(rendered, "Pick a team") {
+		t.Error("expected title in render")
+	}
+	if !strings.Contains(rendered, "Team B ✓") {
+		t.Error("expected active option to be marked")
+	}
+	if strings.Contains(rendered, "Team T") {
+		t.Error("expected options beyond the visible window to be hidden")
+	}
+
+	// Scrolling to the end shows the last option
+	picker.HandleKey("G")
+	rendered = picker.Render("")
+	if !strings.Contains(rendered, "Team T") {
+		t.Error("expected last option visible after jumping to end")
+	}
+}
@@ -0,0 +1,129 @@
+package components
+
+import (
+	"strings"
+
+	"charm.land/bubbles/v2/textarea"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
+)
+
+// PromptModel is a text prompt that hands the entered value to a callback when
+// submitted: single-line (e.g. naming a saved view) or multiline (e.g. an
+// incident note).
+
+type PromptModel struct {
+	visible   bool
+	title     string
+	input     textinput.Model
+	multiline bool
+	area      textarea.Model
+	onSubmit  func(value string) tea.Cmd
+}
+
+func NewPrompt() *PromptModel {
+	input := textinput.New()
+	input.SetWidth(40)
+	area := textarea.New()
+	area.ShowLineNumbers = false
+	area.SetWidth(60)
+	area.SetHeight(6)
+	return &PromptModel{input: input, area: area}
+}
+
+// Ask shows the prompt pre-filled with initial; onSubmit runs with the trimmed
+// value on enter. Returns the cursor blink command.
+func (m *PromptModel) Ask(title, initial string, onSubmit func(value string) tea.Cmd) tea.Cmd {
+	m.visible = true
+	m.multiline = false
+	m.title = title
+	m.onSubmit = onSubmit
+	m.input.SetValue(initial)
+	m.input.CursorEnd()
+	return m.input.Focus()
+}
+
+// AskMultiline shows an empty multiline prompt where enter starts a new line;
+// onSubmit runs with the trimmed value on ctrl+s. Returns the cursor blink command.
+func (m *PromptModel) AskMultiline(title string, onSubmit func(value string) tea.Cmd) tea.Cmd {
+	m.visible = true
+	m.multiline = true
+	m.title = title
+	m.onSubmit = onSubmit
+	m.area.Reset()
+	return m.area.Focus()
+}
+
+func (m *PromptModel) IsVisible() bool {
+	return m.visible
+}
+
+// HandleKey edits the value; enter (ctrl+s when multiline) submits a non-empty
+// value and esc cancels, both closing the prompt
+func (m *PromptModel) HandleKey(msg tea.KeyPressMsg) tea.Cmd {
+	submit := "enter"
+	if m.multiline {
+		submit = "ctrl+s"
+	}
+	switch msg.String() {
+	case submit:
+		value := strings.TrimSpace(m.value())
+		onSubmit := m.close()
+		if value == "" || onSubmit == nil {
+			return nil
+		}
+		return onSubmit(value)
+	case "esc":
+		m.close()
+		return nil
+	}
+	var cmd tea.Cmd
+	if m.multiline {
+		m.area, cmd = m.area.Update(msg)
+	} else {
+		m.input, cmd = m.input.Update(msg)
+	}
+	return cmd
+}
+
+// value returns the text entered so far
+func (m *PromptModel) value() string {
+	if m.multiline {
+		return m.area.Value()
+	}
+	return m.input.Value()
+}
+
+// close hides the prompt and returns the pending callback
+func (m *PromptModel) close() func(string) tea.Cmd {
+	onSubmit := m.onSubmit
+	m.visible = false
+	m.onSubmit = nil
+	m.input.Blur()
+	m.area.Blur()
+	return onSubmit
+}
+
+func (m *PromptModel) Render() string {
+	if !m.visible {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(styles.DialogTitle.Render(m.title))
+	b.WriteString("\n\n")
+	if m.multiline {
+		b.WriteString(m.area.View())
+		b.WriteString("\n\n")
+		b.WriteString(styles.TextDim.Render(i18n.T("prompt.help_multiline")))
+	} else {
+		b.WriteString(m.input.View())
+		b.WriteString("\n\n")
+		b.WriteString(styles.TextDim.Render(i18n.T("prompt.help")))
+	}
+
+	return styles.Dialog.Render(b.String())
+}
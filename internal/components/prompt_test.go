@@ -0,0 +1,90 @@
+package components
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+type promptTestMsg struct{ value string }
+
+func typePrompt(p *PromptModel, text string) {
+	for _, r := range text {
+		p.HandleKey(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+}
+
+func TestPromptSubmit(t *testing.T) {
+	prompt := NewPrompt()
+	prompt.Ask("Name", "", func(value string) tea.Cmd {
+		return func() tea.Msg { return promptTestMsg{value} }
+	})
+	if !prompt.IsVisible() {
+		t.Fatal("expected prompt to be visible after Ask")
+	}
+
+	typePrompt(prompt, " triage ")
+	cmd := prompt.HandleKey(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected the submit callback's command")
+	}
+	if msg, ok := cmd().(promptTestMsg); !ok || msg.value != "triage" {
+		t.Errorf("expected the trimmed value, got %+v", cmd())
+	}
+	if prompt.IsVisible() {
+		t.Error("expected prompt to close after submit")
+	}
+}
+
+func TestPromptCancelAndEmpty(t *testing.T) {
+	called := false
+	onSubmit := func(string) tea.Cmd {
+		called = true
+		return nil
+	}
+
+	prompt := NewPrompt()
+	prompt.Ask("Name", "draft", onSubmit)
+	if cmd := prompt.HandleKey(tea.KeyPressMsg{Code: tea.KeyEscape}); cmd != nil || prompt.IsVisible() {
+		t.Error("expected esc to close the prompt without a command")
+	}
+
+	prompt.Ask("Name", "  ", onSubmit)
+	prompt.HandleKey(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if called {
+		t.Error("expected an empty value not to be submitted")
+	}
+	if prompt.Render() != "" {
+		t.Error("expected no render when hidden")
+	}
+}
+
+func TestPromptMultiline(t *testing.T) {
+	prompt := NewPrompt()
+	prompt.AskMultiline("Note", func(value string) tea.Cmd {
+		return func() tea.Msg { return promptTestMsg{value} }
+	})
+
+	typePrompt(prompt, "line one")
+	if cmd := prompt.HandleKey(tea.KeyPressMsg{Code: tea.KeyEnter}); !prompt.IsVisible() {
+		t.Fatalf("expected enter to start a new line, got %v", cmd)
+	}
+	typePrompt(prompt, "line two ")
+	cmd := prompt.HandleKey(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	if cmd == nil {
+		t.Fatal("expected ctrl+s to submit")
+	}
+	if msg, ok := cmd().(promptTestMsg); !ok || msg.value != "line one\nline two" {
+		t.Errorf("expected both lines trimmed, got %+v", cmd())
+	}
+	if prompt.IsVisible() {
+		t.Error("expected prompt to close after submit")
+	}
+
+	// A fresh multiline prompt starts empty, and a blank one isn't submitted
+	prompt.AskMultiline("Note", func(string) tea.Cmd {
+		t.Error("expected a blank note not to be submitted")
+		return nil
+	})
+	prompt.HandleKey(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+}
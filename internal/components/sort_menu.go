@@ -21,6 +21,9 @@ type SortOption struct {
 	Label       string
 	Description string
 	Value       interface{}
+	// DescLabel and AscLabel name the directions (default: Newest First / Oldest First)
+	DescLabel string
+	AscLabel  string
 }
 
 func NewSortMenu(options []SortOption) *SortMenuModel {
@@ -85,9 +88,9 @@ func (m *SortMenuModel) Render(currentSortField interface{}, sortDirection SortD
 		sortIndicator := ""
 		if currentSortField == opt.Value {
 			if sortDirection == SortDesc {
-				sortIndicator = " (" + i18n.T("sorting.newest_first") + ")"
+				sortIndicator = " (" + orDefault(opt.DescLabel, i18n.T("sorting.newest_first")) + ")"
 			} else {
-				sortIndicator = " (" + i18n.T("sorting.oldest_first") + ")"
+				sortIndicator = " (" + orDefault(opt.AscLabel, i18n.T("sorting.oldest_first")) + ")"
 			}
 		}
 
@@ -110,3 +113,11 @@ func (m *SortMenuModel) Render(currentSortField interface{}, sortDirection SortD
 
 	return styles.Dialog.Render(b.String())
 }
+
+// orDefault returns label, or fallback when label is empty
+func orDefault(label, fallback string) string {
+	if label == "" {
+		return fallback
+	}
+	return label
+}
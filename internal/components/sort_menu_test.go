@@ -337,6 +337,20 @@ func TestSortMenuRenderWithSortIndicator(t *testing.T) {
 	}
 }
 
+func TestSortMenuRenderCustomDirectionLabels(t *testing.T) {
+	menu := NewSortMenu([]SortOption{
+		{Label: "Severity", Value: 1, DescLabel: "Most Severe First", AscLabel: "Least Severe First"},
+	})
+	menu.Toggle()
+
+	if output := menu.Render(1, SortDesc); !strings.Contains(output, "Most Severe First") {
+		t.Error("expected custom descending label")
+	}
+	if output := menu.Render(1, SortAsc); !strings.Contains(output, "Least Severe First") {
+		t.Error("expected custom ascending label")
+	}
+}
+
 func TestSortMenuRenderNoSortIndicator(t *testing.T) {
 	options := []SortOption{
 		{Label: "Option 1", Description: "Description 1", Value: 1},
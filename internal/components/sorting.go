@@ -44,6 +44,20 @@ func (s *SortState) Toggle(newField interface{}) bool {
 	return true // Field changed, need to reload
 }
 
+// Set selects the field and direction directly, e.g. to restore a saved sort
+func (s *SortState) Set(field interface{}, direction SortDirection) {
+	s.Field = field
+	s.Direction = direction
+	s.enabled = true
+}
+
+// Reset disables sorting, going back to the default order
+func (s *SortState) Reset() {
+	s.Field = nil
+	s.Direction = SortDesc
+	s.enabled = false
+}
+
 func (s *SortState) IsEnabled() bool {
 	return s.enabled
 }
@@ -236,3 +236,15 @@ func TestSortStateMultipleFieldChanges(t *testing.T) {
 		}
 	}
 }
+
+func TestSortStateSet(t *testing.T) {
+	s := NewSortState()
+	s.Set("started", SortAsc)
+
+	if !s.IsEnabled() {
+		t.Error("expected sorting to be enabled after Set")
+	}
+	if s.Field != "started" || s.Direction != SortAsc {
+		t.Errorf("expected started ascending, got %v %v", s.Field, s.Direction)
+	}
+}
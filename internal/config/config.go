@@ -2,13 +2,17 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"text/template"
 	"time"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
 )
 
 const (
@@ -25,6 +29,134 @@ type Config struct {
 	Layout   string `yaml:"layout"`
 	UseOAuth bool   `yaml:"use_oauth,omitempty"`
 
+	// Profile is the name of the profile this config was loaded from or is
+	// saved to (not stored in the file)
+	Profile string `yaml:"-"`
+
+	// APIKeyFromEnv and EndpointFromEnv record that Resolve took the value
+	// from ROOTLY_API_KEY or ROOTLY_ENDPOINT (not stored in the file); Save
+	// keeps the file's own value instead
+	APIKeyFromEnv   bool `yaml:"-"`
+	EndpointFromEnv bool `yaml:"-"`
+
+	// UseKeychain stores the API key in the OS keychain, leaving only
+	// KeychainPlaceholder in this file
+	UseKeychain bool `yaml:"use_keychain,omitempty"`
+
+	// keychainKey is the API key Load read from the keychain, so Save only
+	// writes the entry (which may prompt for access) when the key changed
+	keychainKey string
+
+	// AutoLoadDetails fetches the detail of the selected item automatically
+	// after the cursor settles, instead of waiting for Enter
+	AutoLoadDetails bool `yaml:"auto_load_details,omitempty"`
+
+	// PageSize is how many incidents or alerts a list request fetches
+	// (0 uses the default, capped at MaxPageSize)
+	PageSize int `yaml:"page_size,omitempty"`
+
+	// AutoRefreshSeconds re-fetches the active list every so many seconds
+	// (0 disables, values below MinAutoRefreshSeconds are raised to it)
+	AutoRefreshSeconds int `yaml:"auto_refresh_seconds,omitempty"`
+
+	// AutoPageSize fits the list page size to the terminal height so
+	// [ and ] page by screenfuls
+	AutoPageSize bool `yaml:"auto_page_size,omitempty"`
+
+	// InfiniteScroll appends the next page of incidents when the cursor
+	// reaches the bottom of the list, instead of paging with [ and ]
+	InfiniteScroll bool `yaml:"infinite_scroll,omitempty"`
+
+	// ShowInitials shows colored initials badges next to people in the incident detail
+	ShowInitials bool `yaml:"show_initials,omitempty"`
+
+	// RelativeTimes shows detail pane timestamps relative to now ("12m ago");
+	// toggled with t and saved here
+	RelativeTimes bool `yaml:"relative_times,omitempty"`
+
+	// TimeFormat is how detail pane dates are shown: a preset (see
+	// TimeFormatPresets) or a Go time layout such as "2006-01-02 15:04"
+	TimeFormat string `yaml:"time_format,omitempty"`
+
+	// MyTeam is the team used by the "my team" scope (U); when empty the
+	// first team the current user belongs to is used
+	MyTeam string `yaml:"my_team,omitempty"`
+
+	// ShowHelpBar shows the key hints at the bottom of the main screen;
+	// nil means the default (shown). The full help stays available on ?
+	ShowHelpBar *bool `yaml:"show_help_bar,omitempty"`
+
+	// ConfirmWrites asks for a y/n confirmation before destructive write actions
+	// (reopen, acknowledge all, ...); nil means the default (ask)
+	ConfirmWrites *bool `yaml:"confirm_writes,omitempty"`
+
+	// DryRun makes write actions log the request they would send instead of
+	// calling the API (also enabled with --dry-run)
+	DryRun bool `yaml:"dry_run,omitempty"`
+
+	// Theme selects the color palette: auto (detect from the terminal
+	// background, the default), dark (alias rootly), light, solarized-dark,
+	// nord or mono
+	Theme string `yaml:"theme,omitempty"`
+
+	// StatusMap maps custom incident/alert statuses to a color bucket
+	// (active, in_progress, resolved, muted)
+	StatusMap map[string]string `yaml:"status_map,omitempty"`
+
+	// EnvironmentColors maps environment names to a color (danger, warning,
+	// success, muted or #RRGGBB) on top of the built-in production/staging/dev ones
+	EnvironmentColors map[string]string `yaml:"environment_colors,omitempty"`
+
+	// CacheMaxBytes caps the on-disk cache size; least-recently-used
+	// entries are evicted beyond it (0 uses the default)
+	CacheMaxBytes int64 `yaml:"cache_max_bytes,omitempty"`
+
+	// CacheTTLSeconds is how long API responses are cached; nil means the
+	// default and 0 disables caching
+	CacheTTLSeconds *int `yaml:"cache_ttl_seconds,omitempty"`
+
+	// RequestTimeoutSeconds bounds each API request, including its retries
+	// (0 uses the default)
+	RequestTimeoutSeconds int `yaml:"request_timeout_seconds,omitempty"`
+
+	// MaxRetries is how many times a request is retried after a rate limit,
+	// gateway error or network failure (0 uses the default, negative disables)
+	MaxRetries int `yaml:"max_retries,omitempty"`
+
+	// ProxyURL sends API requests through this proxy instead of the one in
+	// HTTP_PROXY/HTTPS_PROXY (which are honored when it's empty)
+	ProxyURL string `yaml:"proxy_url,omitempty"`
+
+	// IncidentIncludes and AlertIncludes are comma-separated related resources
+	// fetched with incident and alert details (e.g. "roles,services"); empty
+	// fetches everything the detail pane shows
+	IncidentIncludes string `yaml:"incident_includes,omitempty"`
+	AlertIncludes    string `yaml:"alert_includes,omitempty"`
+
+	// StatusUpdateTemplate is a text/template rendered with the selected incident
+	// and copied with u, for posting to external status pages (empty uses
+	// DefaultStatusUpdateTemplate)
+	StatusUpdateTemplate string `yaml:"status_update_template,omitempty"`
+
+	// LeaderKeys maps two-key sequences (a leader such as g or "," then a key)
+	// to actions, e.g. ",o": open; they add to the built-in sequences and an
+	// empty action removes one
+	LeaderKeys map[string]string `yaml:"leader_keys,omitempty"`
+
+	// MaxLabelValueLen truncates alert label values in the detail pane
+	// (0 uses the default, negative disables truncation)
+	MaxLabelValueLen int `yaml:"max_label_value_len,omitempty"`
+
+	// AlertsSort is the alerts list sort chosen in the sort menu (S): created,
+	// started, ended, source or status, prefixed with "-" for descending
+	AlertsSort string `yaml:"alerts_sort,omitempty"`
+
+	// SavedViews are named filter/sort combinations saved with w and applied with F
+	SavedViews map[string]ViewState `yaml:"saved_views,omitempty"`
+
+	// WelcomeSeen records that the first-run welcome overlay was dismissed
+	WelcomeSeen bool `yaml:"welcome_seen,omitempty"`
+
 	// OAuth2 tokens (stored in same config file)
 	OAuthAccessToken  string    `yaml:"oauth_access_token,omitempty"`
 	OAuthRefreshToken string    `yaml:"oauth_refresh_token,omitempty"`
@@ -80,8 +212,9 @@ func Exists() bool {
 	return err == nil
 }
 
+// Load reads the profile in use (see SetProfile and UseProfile) from config.yaml
 func Load() (*Config, error) {
-	data, err := os.ReadFile(Path())
+	f, err := readProfiles()
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, errors.New("config file not found")
@@ -89,10 +222,13 @@ func Load() (*Config, error) {
 		return nil, err
 	}
 
-	var cfg Config
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
-		return nil, err
+	name := f.active()
+	stored, ok := f.Profiles[name]
+	if !ok || stored == nil {
+		return nil, fmt.Errorf("profile %q not found in %s", name, Path())
 	}
+	cfg := *stored
+	cfg.Profile = name
 
 	if cfg.Endpoint == "" {
 		cfg.Endpoint = DefaultEndpoint
@@ -110,9 +246,61 @@ func Load() (*Config, error) {
 		cfg.Layout = DefaultLayout
 	}
 
+	if cfg.UseKeychain && (cfg.APIKey == "" || cfg.APIKey == KeychainPlaceholder) {
+		key, err := LoadSecret(cfg.Profile)
+		if err != nil {
+			// Leave the key empty so setup asks for it again
+			debug.Logger.Warn("Failed to read the API key from the keychain", "error", err)
+			key = ""
+		}
+		cfg.APIKey = key
+		cfg.keychainKey = key
+	}
+
+	if _, err := cfg.StatusUpdate(); err != nil {
+		return nil, err
+	}
+
 	return &cfg, nil
 }
 
+// Environment variables that override the config file (and stand in for it,
+// e.g. in CI)
+const (
+	EnvAPIKey   = "ROOTLY_API_KEY"
+	EnvEndpoint = "ROOTLY_ENDPOINT"
+)
+
+// Resolve returns the effective config: the config file when there is one,
+// overridden by ROOTLY_API_KEY and ROOTLY_ENDPOINT when they are set. Without
+// credentials it returns the config along with an error explaining where they
+// are read from. The environment values are never saved.
+func Resolve() (*Config, error) {
+	cfg, err := Load()
+	if err != nil {
+		if Exists() {
+			return nil, err
+		}
+		cfg = &Config{Endpoint: DefaultEndpoint, Timezone: DefaultTimezone, Language: DefaultLanguage, Layout: DefaultLayout, Profile: profileName("")}
+	}
+	if key := strings.TrimSpace(os.Getenv(EnvAPIKey)); key != "" {
+		cfg.APIKey = key
+		// An explicit key wins over OAuth login from the file
+		cfg.UseOAuth = false
+		cfg.APIKeyFromEnv = true
+	}
+	if endpoint := strings.TrimSpace(os.Getenv(EnvEndpoint)); endpoint != "" {
+		cfg.Endpoint = endpoint
+		cfg.EndpointFromEnv = true
+	}
+	if !cfg.IsValid() {
+		return cfg, fmt.Errorf("no API credentials: set %s (and optionally %s), or run setup to write %s; the environment takes precedence over the config file", EnvAPIKey, EnvEndpoint, Path())
+	}
+	return cfg, nil
+}
+
+// Save writes cfg to its profile in config.yaml (the profile in use when cfg
+// wasn't loaded from one), leaving the other profiles as they are
 func Save(cfg *Config) error {
 	if cfg.Endpoint == "" {
 		cfg.Endpoint = DefaultEndpoint
@@ -130,16 +318,228 @@ func Save(cfg *Config) error {
 		cfg.Layout = DefaultLayout
 	}
 
-	if err := os.MkdirAll(Dir(), 0700); err != nil {
-		return err
+	f, err := readProfiles()
+	if err != nil {
+		// A missing or unreadable file is replaced, as setup always did
+		if !os.IsNotExist(err) {
+			debug.Logger.Warn("Replacing unreadable config file", "path", Path(), "error", err)
+		}
+		f = &profilesFile{Profiles: map[string]*Config{}}
+	}
+	if cfg.Profile == "" {
+		cfg.Profile = f.active()
+	}
+	if f.Current == "" {
+		f.Current = cfg.Profile
 	}
 
-	data, err := yaml.Marshal(cfg)
+	// Values from the environment are never saved; the file keeps its own
+	toWrite := *cfg
+	stored := f.Profiles[cfg.Profile]
+	if stored == nil {
+		stored = &Config{}
+	}
+	if cfg.APIKeyFromEnv {
+		toWrite.APIKey = stored.APIKey
+		toWrite.UseOAuth = stored.UseOAuth
+	}
+	if cfg.EndpointFromEnv {
+		toWrite.Endpoint = stored.Endpoint
+		if toWrite.Endpoint == "" {
+			toWrite.Endpoint = DefaultEndpoint
+		}
+	}
+
+	// With use_keychain the key goes to the keychain and the file only gets a placeholder
+	if toWrite.UseKeychain && toWrite.APIKey != "" && toWrite.APIKey != KeychainPlaceholder {
+		if toWrite.APIKey != cfg.keychainKey {
+			if err := SaveSecret(cfg.Profile, toWrite.APIKey); err != nil {
+				return fmt.Errorf("failed to store the API key in the keychain: %w", err)
+			}
+			cfg.keychainKey = toWrite.APIKey
+		}
+		toWrite.APIKey = KeychainPlaceholder
+	}
+	f.Profiles[cfg.Profile] = &toWrite
+
+	return writeProfiles(f)
+}
+
+// redactedValue replaces secrets in RedactedYAML
+const redactedValue = "***redacted***"
+
+// RedactedYAML returns cfg as YAML with the API key and OAuth tokens replaced,
+// safe to paste into a support ticket
+func RedactedYAML(cfg *Config) (string, error) {
+	redacted := *cfg
+	for _, secret := range []*string{&redacted.APIKey, &redacted.OAuthAccessToken, &redacted.OAuthRefreshToken} {
+		if *secret != "" {
+			*secret = redactedValue
+		}
+	}
+	data, err := yaml.Marshal(&redacted)
 	if err != nil {
-		return err
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ViewState is a saved combination of tab, filters, sorts and list toggles
+type ViewState struct {
+	Tab             string `yaml:"tab,omitempty"`            // incidents or alerts
+	Team            string `yaml:"team,omitempty"`           // incidents team filter
+	Search          string `yaml:"search,omitempty"`         // incidents title/summary search
+	Status          string `yaml:"status,omitempty"`         // incidents status filter: active or resolved
+	Severity        string `yaml:"severity,omitempty"`       // incidents severity threshold: critical, high or medium
+	IncidentsSort   string `yaml:"incidents_sort,omitempty"` // created, updated, severity or status; "-" for descending
+	AlertsSort      string `yaml:"alerts_sort,omitempty"`    // see AlertsSort
+	OnCallOnly      bool   `yaml:"on_call_only,omitempty"`
+	GroupByIncident bool   `yaml:"group_by_incident,omitempty"`
+}
+
+// HelpBarEnabled reports whether the bottom help bar should be shown (default true)
+func (c *Config) HelpBarEnabled() bool {
+	return c.ShowHelpBar == nil || *c.ShowHelpBar
+}
+
+// WriteConfirmationsEnabled reports whether destructive write actions ask for confirmation (default true)
+func (c *Config) WriteConfirmationsEnabled() bool {
+	return c.ConfirmWrites == nil || *c.ConfirmWrites
+}
+
+// DefaultMaxLabelValueLen is the alert label value length shown before truncating
+const DefaultMaxLabelValueLen = 200
+
+// LabelValueLimit returns the alert label value display limit (0 = no limit)
+func (c *Config) LabelValueLimit() int {
+	switch {
+	case c.MaxLabelValueLen < 0:
+		return 0
+	case c.MaxLabelValueLen == 0:
+		return DefaultMaxLabelValueLen
+	default:
+		return c.MaxLabelValueLen
 	}
+}
 
-	return os.WriteFile(Path(), data, 0600)
+// DefaultTimeLayout is the date layout used when time_format is unset or invalid
+const DefaultTimeLayout = "Jan 2, 2006 15:04 MST"
+
+// TimeFormatPresets are the named time_format values and their Go layouts
+var TimeFormatPresets = map[string]string{
+	"default": DefaultTimeLayout,
+	"iso8601": "2006-01-02T15:04:05Z07:00",
+	"us":      "01/02/2006 3:04 PM MST",
+	"eu":      "02/01/2006 15:04 MST",
+}
+
+// TimeLayout returns the Go layout for the configured time format, falling
+// back to DefaultTimeLayout when it's neither a preset nor a usable layout
+func (c *Config) TimeLayout() string {
+	format := strings.TrimSpace(c.TimeFormat)
+	if format == "" {
+		return DefaultTimeLayout
+	}
+	if layout, ok := TimeFormatPresets[strings.ToLower(format)]; ok {
+		return layout
+	}
+	// A layout without any reference-time element formats any time to itself
+	probe := time.Date(2001, 2, 3, 4, 5, 6, 0, time.UTC)
+	if probe.Format(format) == format {
+		debug.Logger.Warn("Invalid time_format, using the default", "time_format", format)
+		return DefaultTimeLayout
+	}
+	return format
+}
+
+// DefaultPageSize is the number of incidents or alerts fetched per list request
+const DefaultPageSize = 25
+
+// MaxPageSize caps list requests
+const MaxPageSize = 100
+
+// DefaultMaxRetries is how many times a transient API failure is retried
+const DefaultMaxRetries = 3
+
+// RetryLimit returns how many times a failed request is retried (0 when disabled)
+func (c *Config) RetryLimit() int {
+	switch {
+	case c.MaxRetries < 0:
+		return 0
+	case c.MaxRetries == 0:
+		return DefaultMaxRetries
+	default:
+		return c.MaxRetries
+	}
+}
+
+// PageSizeOptions are the page sizes offered in the setup screen
+var PageSizeOptions = []int{10, 25, 50, 75, 100}
+
+// ListPageSize returns the configured list page size, clamped to MaxPageSize
+func (c *Config) ListPageSize() int {
+	switch {
+	case c.PageSize <= 0:
+		return DefaultPageSize
+	case c.PageSize > MaxPageSize:
+		return MaxPageSize
+	default:
+		return c.PageSize
+	}
+}
+
+// DefaultRequestTimeoutSeconds bounds an API request when the config doesn't
+const DefaultRequestTimeoutSeconds = 15
+
+// RequestTimeout returns how long an API request may take before it's abandoned
+func (c *Config) RequestTimeout() time.Duration {
+	if c.RequestTimeoutSeconds <= 0 {
+		return DefaultRequestTimeoutSeconds * time.Second
+	}
+	return time.Duration(c.RequestTimeoutSeconds) * time.Second
+}
+
+// DefaultCacheTTLSeconds is how long API responses are cached when the config doesn't say
+const DefaultCacheTTLSeconds = 300
+
+// CacheTTL returns how long API responses are cached (0 when caching is disabled)
+func (c *Config) CacheTTL() time.Duration {
+	if c.CacheTTLSeconds == nil {
+		return DefaultCacheTTLSeconds * time.Second
+	}
+	return time.Duration(max(*c.CacheTTLSeconds, 0)) * time.Second
+}
+
+// MinAutoRefreshSeconds is the shortest auto-refresh interval, to spare the API
+const MinAutoRefreshSeconds = 10
+
+// AutoRefreshInterval returns how often the list is auto-refreshed (0 = off)
+func (c *Config) AutoRefreshInterval() time.Duration {
+	switch {
+	case c.AutoRefreshSeconds <= 0:
+		return 0
+	case c.AutoRefreshSeconds < MinAutoRefreshSeconds:
+		return MinAutoRefreshSeconds * time.Second
+	default:
+		return time.Duration(c.AutoRefreshSeconds) * time.Second
+	}
+}
+
+// DefaultStatusUpdateTemplate is the status page update copied with u when
+// status_update_template isn't set
+const DefaultStatusUpdateTemplate = "{{.SequentialID}} [{{.Status}}] {{.Title}}{{with .Summary}}\n\n{{.}}{{end}}"
+
+// StatusUpdate parses the status update template (the default when unset)
+func (c *Config) StatusUpdate() (*template.Template, error) {
+	text := c.StatusUpdateTemplate
+	if strings.TrimSpace(text) == "" {
+		text = DefaultStatusUpdateTemplate
+	}
+	tmpl, err := template.New("status_update").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid status_update_template: %w", err)
+	}
+	return tmpl, nil
 }
 
 func (c *Config) IsValid() bool {
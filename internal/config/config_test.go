@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 )
@@ -428,6 +429,240 @@ func TestLoadInvalidYAML(t *testing.T) {
 	}
 }
 
+func TestLoadInvalidStatusUpdateTemplate(t *testing.T) {
+	_, cleanup := setupTestEnv(t)
+	defer cleanup()
+
+	if err := Save(&Config{APIKey: "key", StatusUpdateTemplate: "{{.Title"}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	_, err := Load()
+	if err == nil || !strings.Contains(err.Error(), "status_update_template") {
+		t.Errorf("expected a status_update_template error, got %v", err)
+	}
+
+	if err := Save(&Config{APIKey: "key", StatusUpdateTemplate: "{{.Title}} is {{.Status}}"}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	if _, err := Load(); err != nil {
+		t.Errorf("expected a valid template to load, got %v", err)
+	}
+}
+
+// memoryKeychain is an in-memory secretBackend
+type memoryKeychain struct {
+	secrets map[string]string
+	sets    int
+	err     error
+}
+
+func (k *memoryKeychain) Set(service, user, secret string) error {
+	if k.err != nil {
+		return k.err
+	}
+	k.sets++
+	k.secrets[service+"/"+user] = secret
+	return nil
+}
+
+func (k *memoryKeychain) Get(service, user string) (string, error) {
+	if k.err != nil {
+		return "", k.err
+	}
+	return k.secrets[service+"/"+user], nil
+}
+
+// useMemoryKeychain swaps the OS keychain for an in-memory one for the test
+func useMemoryKeychain(t *testing.T) *memoryKeychain {
+	t.Helper()
+	mem := &memoryKeychain{secrets: map[string]string{}}
+	original := keychain
+	keychain = mem
+	t.Cleanup(func() { keychain = original })
+	return mem
+}
+
+func TestSaveAndLoadWithKeychain(t *testing.T) {
+	_, cleanup := setupTestEnv(t)
+	defer cleanup()
+	mem := useMemoryKeychain(t)
+
+	if err := Save(&Config{APIKey: "secret-key", UseKeychain: true}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	data, err := os.ReadFile(Path())
+	if err != nil {
+		t.Fatalf("failed to read config: %v", err)
+	}
+	if strings.Contains(string(data), "secret-key") || !strings.Contains(string(data), KeychainPlaceholder) {
+		t.Errorf("expected only the placeholder in the file, got:\n%s", data)
+	}
+	if key, _ := LoadSecret(DefaultProfile); key != "secret-key" {
+		t.Errorf("expected the key in the keychain, got %q", key)
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if cfg.APIKey != "secret-key" || !cfg.IsValid() {
+		t.Errorf("expected the key from the keychain, got %q", cfg.APIKey)
+	}
+
+	// Saving the loaded config again keeps the placeholder in the file and,
+	// with the key unchanged, leaves the keychain alone
+	cfg.RelativeTimes = true
+	if err := Save(cfg); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	if data, _ := os.ReadFile(Path()); strings.Contains(string(data), "secret-key") {
+		t.Error("expected the key to stay out of the file")
+	}
+	if mem.sets != 1 {
+		t.Errorf("expected an unchanged key not to be written to the keychain again, got %d writes", mem.sets)
+	}
+
+	// A changed key is written
+	cfg.APIKey = "new-key"
+	if err := Save(cfg); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	if key, _ := LoadSecret(DefaultProfile); key != "new-key" || mem.sets != 2 {
+		t.Errorf("expected the changed key in the keychain, got %q after %d writes", key, mem.sets)
+	}
+
+	// An unavailable keychain fails the save rather than writing the key in cleartext
+	mem.err = ErrKeychainUnavailable
+	if err := Save(&Config{APIKey: "other-key", UseKeychain: true}); err == nil {
+		t.Error("expected an error when the keychain is unavailable")
+	}
+	cfg, err = Load()
+	if err != nil || cfg.APIKey != "" {
+		t.Errorf("expected an empty key when the keychain can't be read, got %q (%v)", cfg.APIKey, err)
+	}
+}
+
+func TestSaveWithoutKeychain(t *testing.T) {
+	_, cleanup := setupTestEnv(t)
+	defer cleanup()
+	mem := useMemoryKeychain(t)
+
+	if err := Save(&Config{APIKey: "plain-key"}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	if len(mem.secrets) != 0 {
+		t.Error("expected the keychain to be untouched without use_keychain")
+	}
+	if cfg, _ := Load(); cfg.APIKey != "plain-key" {
+		t.Errorf("expected the key from the file, got %q", cfg.APIKey)
+	}
+}
+
+func TestResolveFromEnvironment(t *testing.T) {
+	_, cleanup := setupTestEnv(t)
+	defer cleanup()
+	t.Setenv(EnvAPIKey, "env-key")
+	t.Setenv(EnvEndpoint, "")
+
+	cfg, err := Resolve()
+	if err != nil {
+		t.Fatalf("expected a config from the environment, got %v", err)
+	}
+	if !cfg.IsValid() || cfg.APIKey != "env-key" || cfg.Endpoint != DefaultEndpoint {
+		t.Errorf("unexpected config without a file: %+v", cfg)
+	}
+	if cfg.Language != DefaultLanguage || cfg.Layout != DefaultLayout {
+		t.Errorf("expected defaults without a file, got language %q layout %q", cfg.Language, cfg.Layout)
+	}
+	if Exists() {
+		t.Error("expected Resolve not to write a config file")
+	}
+}
+
+func TestResolveEnvironmentOverridesFile(t *testing.T) {
+	_, cleanup := setupTestEnv(t)
+	defer cleanup()
+
+	if err := Save(&Config{APIKey: "file-key", Endpoint: "file.rootly.com", UseOAuth: true, Language: "fr_FR"}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	t.Setenv(EnvAPIKey, "env-key")
+	t.Setenv(EnvEndpoint, "env.rootly.com")
+
+	cfg, err := Resolve()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.APIKey != "env-key" || cfg.Endpoint != "env.rootly.com" || cfg.UseOAuth {
+		t.Errorf("expected the environment to take precedence, got key %q endpoint %q oauth %v", cfg.APIKey, cfg.Endpoint, cfg.UseOAuth)
+	}
+	if cfg.Language != "fr_FR" {
+		t.Errorf("expected other settings from the file, got language %q", cfg.Language)
+	}
+}
+
+func TestSaveKeepsEnvironmentValuesOut(t *testing.T) {
+	tmpDir, cleanup := setupTestEnv(t)
+	defer cleanup()
+
+	if err := Save(&Config{APIKey: "file-key", Endpoint: "file.rootly.com", UseOAuth: true}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	t.Setenv(EnvAPIKey, "env-key")
+	t.Setenv(EnvEndpoint, "env.rootly.com")
+
+	cfg, err := Resolve()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cfg.APIKeyFromEnv || !cfg.EndpointFromEnv {
+		t.Fatalf("expected the values to be marked as from the environment, got %+v", cfg)
+	}
+
+	// A preference change saves the resolved config
+	cfg.RelativeTimes = true
+	if err := Save(cfg); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, ".rootly-tui", "config.yaml"))
+	if err != nil {
+		t.Fatalf("failed to read config: %v", err)
+	}
+	for _, secret := range []string{"env-key", "env.rootly.com"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("expected %q not to be saved, got:\n%s", secret, data)
+		}
+	}
+	saved, err := Load()
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if saved.APIKey != "file-key" || saved.Endpoint != "file.rootly.com" || !saved.UseOAuth {
+		t.Errorf("expected the file's credentials to be kept, got key %q endpoint %q oauth %v", saved.APIKey, saved.Endpoint, saved.UseOAuth)
+	}
+	if !saved.RelativeTimes {
+		t.Error("expected the preference to be saved")
+	}
+}
+
+func TestResolveWithoutCredentials(t *testing.T) {
+	_, cleanup := setupTestEnv(t)
+	defer cleanup()
+	t.Setenv(EnvAPIKey, "")
+	t.Setenv(EnvEndpoint, "")
+
+	_, err := Resolve()
+	if err == nil {
+		t.Fatal("expected an error without a file or environment")
+	}
+	for _, want := range []string{EnvAPIKey, EnvEndpoint, "precedence"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q in the error, got %q", want, err)
+		}
+	}
+}
+
 func TestSaveAndLoadWithLanguage(t *testing.T) {
 	_, cleanup := setupTestEnv(t)
 	defer cleanup()
@@ -623,3 +858,164 @@ func TestLayoutConstants(t *testing.T) {
 		t.Errorf("expected LayoutVertical to be 'vertical', got '%s'", LayoutVertical)
 	}
 }
+
+func TestLabelValueLimit(t *testing.T) {
+	tests := []struct {
+		set  int
+		want int
+	}{
+		{0, DefaultMaxLabelValueLen},
+		{50, 50},
+		{-1, 0},
+	}
+	for _, tt := range tests {
+		cfg := &Config{MaxLabelValueLen: tt.set}
+		if got := cfg.LabelValueLimit(); got != tt.want {
+			t.Errorf("LabelValueLimit() with %d = %d, want %d", tt.set, got, tt.want)
+		}
+	}
+}
+
+func TestTimeLayout(t *testing.T) {
+	tests := []struct {
+		set  string
+		want string
+	}{
+		{"", DefaultTimeLayout},
+		{"ISO8601", TimeFormatPresets["iso8601"]},
+		{"eu", TimeFormatPresets["eu"]},
+		{"2006-01-02 15:04", "2006-01-02 15:04"},
+		{"yyyy-mm-dd", DefaultTimeLayout},
+	}
+	for _, tt := range tests {
+		cfg := &Config{TimeFormat: tt.set}
+		if got := cfg.TimeLayout(); got != tt.want {
+			t.Errorf("TimeLayout() with %q = %q, want %q", tt.set, got, tt.want)
+		}
+	}
+}
+
+func TestListPageSize(t *testing.T) {
+	tests := []struct {
+		set  int
+		want int
+	}{
+		{0, DefaultPageSize},
+		{-5, DefaultPageSize},
+		{50, 50},
+		{500, MaxPageSize},
+	}
+	for _, tt := range tests {
+		cfg := &Config{PageSize: tt.set}
+		if got := cfg.ListPageSize(); got != tt.want {
+			t.Errorf("ListPageSize() with %d = %d, want %d", tt.set, got, tt.want)
+		}
+	}
+}
+
+func TestRetryLimit(t *testing.T) {
+	tests := []struct {
+		set  int
+		want int
+	}{
+		{0, DefaultMaxRetries},
+		{-1, 0},
+		{5, 5},
+	}
+	for _, tt := range tests {
+		cfg := &Config{MaxRetries: tt.set}
+		if got := cfg.RetryLimit(); got != tt.want {
+			t.Errorf("RetryLimit() with %d = %d, want %d", tt.set, got, tt.want)
+		}
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	tests := []struct {
+		set  int
+		want time.Duration
+	}{
+		{0, DefaultRequestTimeoutSeconds * time.Second},
+		{-1, DefaultRequestTimeoutSeconds * time.Second},
+		{30, 30 * time.Second},
+	}
+	for _, tt := range tests {
+		cfg := &Config{RequestTimeoutSeconds: tt.set}
+		if got := cfg.RequestTimeout(); got != tt.want {
+			t.Errorf("RequestTimeout() with %d = %v, want %v", tt.set, got, tt.want)
+		}
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	intPtr := func(n int) *int { return &n }
+	tests := []struct {
+		set  *int
+		want time.Duration
+	}{
+		{nil, DefaultCacheTTLSeconds * time.Second},
+		{intPtr(0), 0},
+		{intPtr(-5), 0},
+		{intPtr(60), time.Minute},
+	}
+	for _, tt := range tests {
+		cfg := &Config{CacheTTLSeconds: tt.set}
+		if got := cfg.CacheTTL(); got != tt.want {
+			t.Errorf("CacheTTL() with %v = %v, want %v", tt.set, got, tt.want)
+		}
+	}
+}
+
+func TestAutoRefreshInterval(t *testing.T) {
+	tests := []struct {
+		set  int
+		want time.Duration
+	}{
+		{0, 0},
+		{-1, 0},
+		{3, MinAutoRefreshSeconds * time.Second},
+		{30, 30 * time.Second},
+	}
+	for _, tt := range tests {
+		cfg := &Config{AutoRefreshSeconds: tt.set}
+		if got := cfg.AutoRefreshInterval(); got != tt.want {
+			t.Errorf("AutoRefreshInterval() with %d = %v, want %v", tt.set, got, tt.want)
+		}
+	}
+}
+
+func TestRedactedYAML(t *testing.T) {
+	cfg := &Config{
+		APIKey:           "rootly_secret_key",
+		Endpoint:         "api.rootly.com",
+		Timezone:         "Europe/Paris",
+		OAuthAccessToken: "access_secret",
+	}
+	out, err := RedactedYAML(cfg)
+	if err != nil {
+		t.Fatalf("RedactedYAML() error = %v", err)
+	}
+	for _, want := range []string{"api.rootly.com", "Europe/Paris", "***redacted***"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output:\n%s", want, out)
+		}
+	}
+	for _, secret := range []string{"rootly_secret_key", "access_secret"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("expected %q to be redacted:\n%s", secret, out)
+		}
+	}
+	if cfg.APIKey != "rootly_secret_key" {
+		t.Error("expected the config itself to be left unchanged")
+	}
+}
+
+func TestWriteConfirmationsEnabled(t *testing.T) {
+	if !(&Config{}).WriteConfirmationsEnabled() {
+		t.Error("expected write confirmations by default")
+	}
+	off := false
+	if (&Config{ConfirmWrites: &off}).WriteConfirmationsEnabled() {
+		t.Error("expected confirm_writes: false to disable confirmations")
+	}
+}
@@ -0,0 +1,134 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+)
+
+// DefaultProfile is the profile a flat, single-account config file holds
+const DefaultProfile = "default"
+
+// profileOverride is the profile chosen with --profile; empty uses the
+// file's current one
+var profileOverride string
+
+// SetProfile makes Load and Save use the named profile for this run
+// (--profile) without moving the file's current pointer
+func SetProfile(name string) {
+	profileOverride = strings.TrimSpace(name)
+}
+
+// profilesFile is config.yaml with named profiles, one full config per
+// account, and the name of the one in use
+type profilesFile struct {
+	Current  string             `yaml:"current,omitempty"`
+	Profiles map[string]*Config `yaml:"profiles,omitempty"`
+}
+
+// active returns the profile in use: --profile, else the file's current one
+func (f *profilesFile) active() string {
+	return profileName(f.Current)
+}
+
+// profileName returns the profile in use given the file's current one
+func profileName(current string) string {
+	switch {
+	case profileOverride != "":
+		return profileOverride
+	case current != "":
+		return current
+	default:
+		return DefaultProfile
+	}
+}
+
+// flat reports whether f can be written in the original single-account
+// layout, i.e. it only holds the default profile
+func (f *profilesFile) flat() bool {
+	_, ok := f.Profiles[DefaultProfile]
+	return ok && len(f.Profiles) == 1 && (f.Current == "" || f.Current == DefaultProfile)
+}
+
+// readProfiles parses config.yaml; a file without profiles is the default one
+func readProfiles() (*profilesFile, error) {
+	data, err := os.ReadFile(Path())
+	if err != nil {
+		return nil, err
+	}
+
+	var f profilesFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	if len(f.Profiles) > 0 {
+		return &f, nil
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	return &profilesFile{Current: DefaultProfile, Profiles: map[string]*Config{DefaultProfile: &cfg}}, nil
+}
+
+// writeProfiles saves f to config.yaml, keeping the flat layout while the
+// default profile is the only one
+func writeProfiles(f *profilesFile) error {
+	if err := os.MkdirAll(Dir(), 0700); err != nil {
+		return err
+	}
+
+	var doc any = f
+	if f.flat() {
+		doc = f.Profiles[DefaultProfile]
+	}
+	data, err := yaml.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(Path(), data, 0600)
+}
+
+// Profiles returns the names of the profiles in config.yaml, sorted, and the
+// one in use
+func Profiles() (names []string, active string, err error) {
+	f, err := readProfiles()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, "", errors.New("config file not found")
+		}
+		return nil, "", err
+	}
+	for name := range f.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, f.active(), nil
+}
+
+// UseProfile makes name the current profile in config.yaml and for the rest
+// of this run
+func UseProfile(name string) error {
+	f, err := readProfiles()
+	if err != nil {
+		return err
+	}
+	if _, ok := f.Profiles[name]; !ok {
+		return fmt.Errorf("profile %q not found", name)
+	}
+	f.Current = name
+	if err := writeProfiles(f); err != nil {
+		return err
+	}
+	profileOverride = ""
+	debug.Logger.Info("Switched profile", "profile", name)
+	return nil
+}
@@ -0,0 +1,166 @@
+package config
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// useProfile sets --profile for the test
+func useProfile(t *testing.T, name string) {
+	t.Helper()
+	SetProfile(name)
+	t.Cleanup(func() { SetProfile("") })
+}
+
+func writeConfigFile(t *testing.T, data string) {
+	t.Helper()
+	if err := os.MkdirAll(Dir(), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(Path(), []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadFlatConfigAsDefaultProfile(t *testing.T) {
+	_, cleanup := setupTestEnv(t)
+	defer cleanup()
+
+	writeConfigFile(t, "api_key: flat-key\nendpoint: api.flat.com\ntimezone: Europe/Paris\n")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Profile != DefaultProfile || cfg.APIKey != "flat-key" || cfg.Endpoint != "api.flat.com" {
+		t.Errorf("expected the flat file as the default profile, got %+v", cfg)
+	}
+	names, active, err := Profiles()
+	if err != nil || !reflect.DeepEqual(names, []string{DefaultProfile}) || active != DefaultProfile {
+		t.Errorf("expected only the default profile, got %v (active %q, %v)", names, active, err)
+	}
+
+	// Saving keeps the single-account layout
+	cfg.Timezone = "Asia/Tokyo"
+	if err := Save(cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	data, _ := os.ReadFile(Path())
+	if strings.Contains(string(data), "profiles:") || !strings.Contains(string(data), "timezone: Asia/Tokyo") {
+		t.Errorf("expected a flat file, got:\n%s", data)
+	}
+}
+
+func TestSaveAndLoadProfiles(t *testing.T) {
+	_, cleanup := setupTestEnv(t)
+	defer cleanup()
+
+	writeConfigFile(t, `current: work
+profiles:
+  work:
+    api_key: work-key
+    endpoint: api.rootly.com
+  personal:
+    api_key: personal-key
+    endpoint: api.personal.com
+    language: fr_FR
+`)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Profile != "work" || cfg.APIKey != "work-key" {
+		t.Errorf("expected the current profile, got %+v", cfg)
+	}
+	if cfg.Language != DefaultLanguage {
+		t.Errorf("expected defaults filled in per profile, got language %q", cfg.Language)
+	}
+
+	// --profile picks another one without moving current
+	useProfile(t, "personal")
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Profile != "personal" || cfg.APIKey != "personal-key" || cfg.Language != "fr_FR" {
+		t.Errorf("expected the personal profile, got %+v", cfg)
+	}
+	cfg.Layout = LayoutVertical
+	if err := Save(cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	SetProfile("")
+	names, active, err := Profiles()
+	if err != nil || !reflect.DeepEqual(names, []string{"personal", "work"}) || active != "work" {
+		t.Errorf("expected both profiles with work current, got %v (active %q, %v)", names, active, err)
+	}
+	if cfg, _ := Load(); cfg.APIKey != "work-key" {
+		t.Errorf("expected the work profile untouched, got %+v", cfg)
+	}
+
+	// Switching moves the current pointer, and the saved change round-trips
+	if err := UseProfile("personal"); err != nil {
+		t.Fatalf("UseProfile() error = %v", err)
+	}
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Profile != "personal" || cfg.Layout != LayoutVertical {
+		t.Errorf("expected the saved personal profile, got %+v", cfg)
+	}
+	if err := UseProfile("missing"); err == nil {
+		t.Error("expected an error switching to an unknown profile")
+	}
+}
+
+func TestSaveNewProfile(t *testing.T) {
+	_, cleanup := setupTestEnv(t)
+	defer cleanup()
+
+	writeConfigFile(t, "api_key: flat-key\n")
+
+	// An unknown --profile doesn't load, and saving it (setup) adds it
+	useProfile(t, "staging")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), `"staging"`) {
+		t.Errorf("expected a profile not found error, got %v", err)
+	}
+	if err := Save(&Config{APIKey: "staging-key"}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	SetProfile("")
+	cfg, err := Load()
+	if err != nil || cfg.Profile != DefaultProfile || cfg.APIKey != "flat-key" {
+		t.Errorf("expected the default profile to stay current, got %+v (%v)", cfg, err)
+	}
+	names, _, _ := Profiles()
+	if !reflect.DeepEqual(names, []string{DefaultProfile, "staging"}) {
+		t.Errorf("expected the new profile next to the default one, got %v", names)
+	}
+}
+
+func TestProfileKeychainAccounts(t *testing.T) {
+	_, cleanup := setupTestEnv(t)
+	defer cleanup()
+	mem := useMemoryKeychain(t)
+
+	if err := Save(&Config{APIKey: "default-key", UseKeychain: true}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if err := Save(&Config{APIKey: "work-key", UseKeychain: true, Profile: "work"}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if mem.secrets["rootly-tui/api_key"] != "default-key" || mem.secrets["rootly-tui/api_key:work"] != "work-key" {
+		t.Errorf("expected a keychain entry per profile, got %v", mem.secrets)
+	}
+
+	useProfile(t, "work")
+	if cfg, err := Load(); err != nil || cfg.APIKey != "work-key" {
+		t.Errorf("expected the work key from the keychain, got %+v (%v)", cfg, err)
+	}
+}
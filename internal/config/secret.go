@@ -0,0 +1,44 @@
+package config
+
+import "errors"
+
+// The API key's entry in the OS keychain
+const (
+	keychainService = "rootly-tui"
+	keychainUser    = "api_key"
+)
+
+// KeychainPlaceholder is written as api_key in config.yaml when use_keychain
+// is on and the key is stored in the OS keychain
+const KeychainPlaceholder = "(stored in keychain)"
+
+// ErrKeychainUnavailable is returned on platforms without a supported keychain
+var ErrKeychainUnavailable = errors.New("no OS keychain is available on this platform")
+
+// secretBackend stores secrets by service and user
+type secretBackend interface {
+	Set(service, user, secret string) error
+	Get(service, user string) (string, error)
+}
+
+// keychain is the backend behind SaveSecret and LoadSecret (replaced in tests)
+var keychain secretBackend = systemKeychain{}
+
+// keychainAccount returns the keychain user the profile's API key is kept
+// under; the default profile keeps the original, unsuffixed one
+func keychainAccount(profile string) string {
+	if profile == "" || profile == DefaultProfile {
+		return keychainUser
+	}
+	return keychainUser + ":" + profile
+}
+
+// SaveSecret stores the profile's API key in the OS keychain
+func SaveSecret(profile, key string) error {
+	return keychain.Set(keychainService, keychainAccount(profile), key)
+}
+
+// LoadSecret reads the profile's API key from the OS keychain
+func LoadSecret(profile string) (string, error) {
+	return keychain.Get(keychainService, keychainAccount(profile))
+}
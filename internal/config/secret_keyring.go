@@ -0,0 +1,17 @@
+//go:build darwin || linux || windows
+
+package config
+
+import "github.com/zalando/go-keyring"
+
+// systemKeychain uses the macOS Keychain, the Secret Service (GNOME Keyring,
+// KWallet) on Linux or the Windows Credential Manager
+type systemKeychain struct{}
+
+func (systemKeychain) Set(service, user, secret string) error {
+	return keyring.Set(service, user, secret)
+}
+
+func (systemKeychain) Get(service, user string) (string, error) {
+	return keyring.Get(service, user)
+}
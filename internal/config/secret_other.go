@@ -0,0 +1,15 @@
+//go:build !darwin && !linux && !windows
+
+package config
+
+// systemKeychain is unavailable on this platform; use_keychain reports
+// ErrKeychainUnavailable instead of storing the key
+type systemKeychain struct{}
+
+func (systemKeychain) Set(service, user, secret string) error {
+	return ErrKeychainUnavailable
+}
+
+func (systemKeychain) Get(service, user string) (string, error) {
+	return "", ErrKeychainUnavailable
+}
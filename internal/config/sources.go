@@ -0,0 +1,51 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const sourcesFile = "sources.yaml"
+
+// AlertSource overrides how an alert source is shown: its icon, the short
+// code next to it and its display name. Empty fields keep the built-in value.
+type AlertSource struct {
+	Icon string `yaml:"icon"`
+	Code string `yaml:"code"`
+	Name string `yaml:"name"`
+}
+
+// SourcesPath returns the location of the optional alert sources file
+func SourcesPath() string {
+	return filepath.Join(Dir(), sourcesFile)
+}
+
+// LoadSources reads the alert source overrides from sources.yaml, keyed by
+// lowercase source (e.g. "datadog" or a custom integration's key). A missing
+// file gives no overrides.
+func LoadSources() (map[string]AlertSource, error) {
+	data, err := os.ReadFile(SourcesPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var raw map[string]AlertSource
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	sources := make(map[string]AlertSource, len(raw))
+	for key, src := range raw {
+		sources[strings.ToLower(strings.TrimSpace(key))] = AlertSource{
+			Icon: strings.TrimSpace(src.Icon),
+			Code: strings.TrimSpace(src.Code),
+			Name: strings.TrimSpace(src.Name),
+		}
+	}
+	return sources, nil
+}
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"os"
+	"testing"
+)
+
+func TestLoadSources(t *testing.T) {
+	_, cleanup := setupTestEnv(t)
+	defer cleanup()
+
+	sources, err := LoadSources()
+	if err != nil || sources != nil {
+		t.Fatalf("expected no overrides without a file, got %v (%v)", sources, err)
+	}
+
+	if err := os.MkdirAll(Dir(), 0700); err != nil {
+		t.Fatal(err)
+	}
+	data := "Acme_Monitor:\n  icon: \"🛰️\"\n  code: AM\n  name: Acme Monitor\ndatadog:\n  name: Datadog (prod)\n"
+	if err := os.WriteFile(SourcesPath(), []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	sources, err = LoadSources()
+	if err != nil {
+		t.Fatalf("LoadSources() error = %v", err)
+	}
+	if got := sources["acme_monitor"]; got != (AlertSource{Icon: "🛰️", Code: "AM", Name: "Acme Monitor"}) {
+		t.Errorf("expected the custom source keyed in lowercase, got %+v", got)
+	}
+	if got := sources["datadog"]; got.Name != "Datadog (prod)" || got.Icon != "" {
+		t.Errorf("expected a partial override, got %+v", got)
+	}
+
+	if err := os.WriteFile(SourcesPath(), []byte("- not a map"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadSources(); err == nil {
+		t.Error("expected an error for a malformed file")
+	}
+}
@@ -0,0 +1,187 @@
+// Package doctor implements --doctor: a plain-text report of the terminal and
+// environment capabilities the TUI relies on, with advice for anything missing.
+package doctor
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"golang.design/x/clipboard"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+// Status is the outcome of a check
+type Status int
+
+const (
+	Pass Status = iota
+	Warn
+	Fail
+)
+
+func (s Status) String() string {
+	switch s {
+	case Pass:
+		return "ok"
+	case Warn:
+		return "warn"
+	default:
+		return "fail"
+	}
+}
+
+// Result is the outcome of one check, with advice when it didn't pass
+type Result struct {
+	Name   string
+	Status Status
+	Detail string
+	Advice string
+}
+
+// CheckTerminal checks that TERM names a terminal that can run a full-screen UI
+func CheckTerminal(getenv func(string) string) Result {
+	term := getenv("TERM")
+	switch {
+	case term == "" && runtime.GOOS == "windows":
+		return Result{Name: "Terminal", Status: Pass, Detail: "Windows console"}
+	case term == "":
+		return Result{Name: "Terminal", Status: Warn, Detail: "TERM is not set",
+			Advice: "Run rootly-tui in a terminal emulator, or set TERM (e.g. xterm-256color)"}
+	case term == "dumb":
+		return Result{Name: "Terminal", Status: Fail, Detail: term,
+			Advice: "This terminal can't draw a full-screen UI; use a terminal emulator"}
+	default:
+		return Result{Name: "Terminal", Status: Pass, Detail: term}
+	}
+}
+
+// CheckColor checks how many colors the terminal advertises
+func CheckColor(getenv func(string) string) Result {
+	term := getenv("TERM")
+	colorTerm := strings.ToLower(getenv("COLORTERM"))
+	switch {
+	case getenv("NO_COLOR") != "":
+		return Result{Name: "Colors", Status: Warn, Detail: "disabled by NO_COLOR",
+			Advice: "Unset NO_COLOR to see status and severity colors"}
+	case colorTerm == "truecolor" || colorTerm == "24bit":
+		return Result{Name: "Colors", Status: Pass, Detail: "truecolor"}
+	case strings.Contains(term, "256color"):
+		return Result{Name: "Colors", Status: Pass, Detail: "256 colors"}
+	case runtime.GOOS == "windows" && getenv("WT_SESSION") != "":
+		return Result{Name: "Colors", Status: Pass, Detail: "Windows Terminal"}
+	default:
+		return Result{Name: "Colors", Status: Warn, Detail: "16 colors or fewer",
+			Advice: "Set TERM=xterm-256color (or COLORTERM=truecolor) if your terminal supports it"}
+	}
+}
+
+// CheckUTF8 checks that the locale uses UTF-8, needed for icons and box drawing
+func CheckUTF8(getenv func(string) string) Result {
+	if runtime.GOOS == "windows" {
+		return Result{Name: "UTF-8", Status: Pass, Detail: "Windows console"}
+	}
+	// The first set variable wins, as in setlocale
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		value := getenv(name)
+		if value == "" {
+			continue
+		}
+		normalized := strings.ToLower(strings.ReplaceAll(value, "-", ""))
+		if strings.Contains(normalized, "utf8") {
+			return Result{Name: "UTF-8", Status: Pass, Detail: name + "=" + value}
+		}
+		return Result{Name: "UTF-8", Status: Warn, Detail: name + "=" + value,
+			Advice: "Use a UTF-8 locale (e.g. LANG=en_US.UTF-8) so icons and borders render"}
+	}
+	return Result{Name: "UTF-8", Status: Warn, Detail: "no locale set",
+		Advice: "Set LANG to a UTF-8 locale (e.g. en_US.UTF-8) so icons and borders render"}
+}
+
+// CheckClipboard checks that the system clipboard can be used by the copy keys
+func CheckClipboard(initClipboard func() error) Result {
+	if err := initClipboard(); err != nil {
+		// Some platforms return a long explanation; the first line is enough here
+		detail, _, _ := strings.Cut(err.Error(), "\n")
+		advice := "Copy keys won't work"
+		if runtime.GOOS == "linux" {
+			advice += "; make sure an X11 display is available (DISPLAY is set)"
+		}
+		return Result{Name: "Clipboard", Status: Warn, Detail: detail, Advice: advice}
+	}
+	return Result{Name: "Clipboard", Status: Pass, Detail: "available"}
+}
+
+// CheckConfig checks that the config file exists, parses and is complete, or
+// that credentials come from the environment
+func CheckConfig() Result {
+	path := config.Path()
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if _, err := config.Resolve(); err == nil {
+			return Result{Name: "Config", Status: Pass, Detail: "from " + config.EnvAPIKey + " (no config file)"}
+		}
+		return Result{Name: "Config", Status: Warn, Detail: path + " not found",
+			Advice: "Run rootly-tui to set up your endpoint and API key, or set " + config.EnvAPIKey}
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		return Result{Name: "Config", Status: Fail, Detail: err.Error(),
+			Advice: "Fix the YAML in " + path + ", or delete it and run setup again"}
+	}
+	if !cfg.IsValid() {
+		return Result{Name: "Config", Status: Warn, Detail: path + " is incomplete",
+			Advice: "Run rootly-tui and finish setup (endpoint and API key or OAuth login)"}
+	}
+	if cfg.Profile != config.DefaultProfile {
+		return Result{Name: "Config", Status: Pass, Detail: path + " (profile " + cfg.Profile + ")"}
+	}
+	return Result{Name: "Config", Status: Pass, Detail: path}
+}
+
+// CheckCacheDir checks that the cache directory can be created and written to
+func CheckCacheDir(dir string) Result {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return Result{Name: "Cache", Status: Fail, Detail: err.Error(),
+			Advice: "Make " + filepath.Dir(dir) + " writable; responses won't be cached"}
+	}
+	f, err := os.CreateTemp(dir, ".doctor-*")
+	if err != nil {
+		return Result{Name: "Cache", Status: Fail, Detail: err.Error(),
+			Advice: "Make " + dir + " writable; responses won't be cached"}
+	}
+	_ = f.Close()
+	_ = os.Remove(f.Name())
+	return Result{Name: "Cache", Status: Pass, Detail: dir + " is writable"}
+}
+
+// Checks runs every check against the current environment
+func Checks() []Result {
+	return []Result{
+		CheckTerminal(os.Getenv),
+		CheckColor(os.Getenv),
+		CheckUTF8(os.Getenv),
+		CheckClipboard(clipboard.Init),
+		CheckConfig(),
+		CheckCacheDir(config.Dir()),
+	}
+}
+
+// Report writes results to w, one line per check plus advice, and returns
+// whether any check failed
+func Report(w io.Writer, results []Result) bool {
+	failed := false
+	for _, r := range results {
+		_, _ = fmt.Fprintf(w, "[%-4s] %-10s %s\n", r.Status, r.Name, r.Detail)
+		if r.Advice != "" {
+			_, _ = fmt.Fprintf(w, "       %-10s → %s\n", "", r.Advice)
+		}
+		if r.Status == Fail {
+			failed = true
+		}
+	}
+	return failed
+}
@@ -0,0 +1,155 @@
+package doctor
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+// env returns a getenv backed by vars
+func env(vars map[string]string) func(string) string {
+	return func(name string) string { return vars[name] }
+}
+
+func TestCheckTerminal(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("TERM is optional on Windows")
+	}
+	tests := []struct {
+		term string
+		want Status
+	}{
+		{"xterm-256color", Pass},
+		{"", Warn},
+		{"dumb", Fail},
+	}
+	for _, tt := range tests {
+		if got := CheckTerminal(env(map[string]string{"TERM": tt.term})); got.Status != tt.want {
+			t.Errorf("CheckTerminal(TERM=%q) = %v, want %v", tt.term, got.Status, tt.want)
+		}
+	}
+}
+
+func TestCheckColor(t *testing.T) {
+	tests := []struct {
+		vars map[string]string
+		want Status
+	}{
+		{map[string]string{"TERM": "xterm-256color"}, Pass},
+		{map[string]string{"TERM": "xterm", "COLORTERM": "truecolor"}, Pass},
+		{map[string]string{"TERM": "xterm"}, Warn},
+		{map[string]string{"TERM": "xterm-256color", "NO_COLOR": "1"}, Warn},
+	}
+	for _, tt := range tests {
+		if got := CheckColor(env(tt.vars)); got.Status != tt.want {
+			t.Errorf("CheckColor(%v) = %v (%s), want %v", tt.vars, got.Status, got.Detail, tt.want)
+		}
+	}
+}
+
+func TestCheckUTF8(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the locale isn't checked on Windows")
+	}
+	tests := []struct {
+		vars map[string]string
+		want Status
+	}{
+		{map[string]string{"LANG": "en_US.UTF-8"}, Pass},
+		{map[string]string{"LANG": "de_DE.utf8"}, Pass},
+		{map[string]string{"LC_ALL": "C", "LANG": "en_US.UTF-8"}, Warn},
+		{map[string]string{}, Warn},
+	}
+	for _, tt := range tests {
+		got := CheckUTF8(env(tt.vars))
+		if got.Status != tt.want {
+			t.Errorf("CheckUTF8(%v) = %v (%s), want %v", tt.vars, got.Status, got.Detail, tt.want)
+		}
+		if got.Status != Pass && got.Advice == "" {
+			t.Errorf("CheckUTF8(%v): expected advice", tt.vars)
+		}
+	}
+}
+
+func TestCheckClipboard(t *testing.T) {
+	if got := CheckClipboard(func() error { return nil }); got.Status != Pass {
+		t.Errorf("expected pass, got %v", got.Status)
+	}
+	got := CheckClipboard(func() error { return errors.New("no display\nlong explanation") })
+	if got.Status != Warn || got.Detail != "no display" {
+		t.Errorf("expected a warning with the first error line, got %v %q", got.Status, got.Detail)
+	}
+}
+
+func TestCheckConfig(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+
+	t.Setenv(config.EnvAPIKey, "")
+	if got := CheckConfig(); got.Status != Warn {
+		t.Errorf("expected a warning without a config file, got %v", got.Status)
+	}
+	t.Setenv(config.EnvAPIKey, "env-key")
+	if got := CheckConfig(); got.Status != Pass {
+		t.Errorf("expected pass with %s set, got %v (%s)", config.EnvAPIKey, got.Status, got.Detail)
+	}
+	t.Setenv(config.EnvAPIKey, "")
+
+	if err := config.Save(&config.Config{APIKey: "key", Endpoint: "api.rootly.com"}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	if got := CheckConfig(); got.Status != Pass {
+		t.Errorf("expected pass for a valid config, got %v (%s)", got.Status, got.Detail)
+	}
+
+	if err := os.WriteFile(config.Path(), []byte("endpoint: [unclosed"), 0600); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	if got := CheckConfig(); got.Status != Fail {
+		t.Errorf("expected fail for invalid YAML, got %v", got.Status)
+	}
+}
+
+func TestCheckCacheDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cache")
+	if got := CheckCacheDir(dir); got.Status != Pass {
+		t.Errorf("expected pass for a writable dir, got %v (%s)", got.Status, got.Detail)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Errorf("expected the probe file to be removed, found %d entries", len(entries))
+	}
+
+	// A regular file where the directory should be
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if got := CheckCacheDir(file); got.Status != Fail {
+		t.Errorf("expected fail when the cache path isn't a directory, got %v", got.Status)
+	}
+}
+
+func TestReport(t *testing.T) {
+	var b bytes.Buffer
+	failed := Report(&b, []Result{
+		{Name: "Terminal", Status: Pass, Detail: "xterm"},
+		{Name: "Cache", Status: Fail, Detail: "read-only", Advice: "Make it writable"},
+	})
+	if !failed {
+		t.Error("expected the report to flag the failure")
+	}
+	out := b.String()
+	for _, want := range []string{"[ok  ] Terminal", "[fail] Cache", "→ Make it writable"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in report:\n%s", want, out)
+		}
+	}
+}
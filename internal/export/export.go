@@ -0,0 +1,97 @@
+// Package export implements --json: printing incidents or alerts as JSON for
+// scripts instead of starting the UI.
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/api"
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+// Kinds of data --json can print
+const (
+	KindIncidents = "incidents"
+	KindAlerts    = "alerts"
+)
+
+// ValidKind reports whether kind can be exported
+func ValidKind(kind string) bool {
+	return kind == KindIncidents || kind == KindAlerts
+}
+
+// Run resolves the config like the UI does (file, overridden by the
+// environment), fetches kind fresh from the API and writes it to w
+func Run(w io.Writer, kind string, all bool) error {
+	cfg, err := config.Resolve()
+	if err != nil {
+		return err
+	}
+	// Scripts want current data, not whatever the UI cached
+	noCache := 0
+	cfg.CacheTTLSeconds = &noCache
+
+	client, err := api.NewClient(cfg)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+	return Write(w, client, kind, all, cfg.RequestTimeout())
+}
+
+// Write fetches the first page of kind, or every page with all, and writes
+// it to w as an indented JSON array. Each request may take up to timeout.
+func Write(w io.Writer, client *api.Client, kind string, all bool, timeout time.Duration) error {
+	var items any
+	var err error
+	switch kind {
+	case KindIncidents:
+		items, err = fetchPages(all, timeout, func(ctx context.Context, page int) ([]api.Incident, api.PaginationInfo, error) {
+			result, err := client.ListIncidents(ctx, page, "", "")
+			if err != nil {
+				return nil, api.PaginationInfo{}, err
+			}
+			return result.Incidents, result.Pagination, nil
+		})
+	case KindAlerts:
+		items, err = fetchPages(all, timeout, func(ctx context.Context, page int) ([]api.Alert, api.PaginationInfo, error) {
+			result, err := client.ListAlerts(ctx, page)
+			if err != nil {
+				return nil, api.PaginationInfo{}, err
+			}
+			return result.Alerts, result.Pagination, nil
+		})
+	default:
+		return fmt.Errorf("unknown --json %q: must be %s or %s", kind, KindIncidents, KindAlerts)
+	}
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(items)
+}
+
+// fetchPages collects the items of the first page, and with all, of the pages
+// after it until the API reports no next page
+func fetchPages[T any](all bool, timeout time.Duration, fetch func(ctx context.Context, page int) ([]T, api.PaginationInfo, error)) ([]T, error) {
+	items := []T{}
+	for page := 1; ; page++ {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		pageItems, pagination, err := fetch(ctx, page)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", page, err)
+		}
+		items = append(items, pageItems...)
+		last := !pagination.HasNext || len(pageItems) == 0 || (pagination.TotalPages > 0 && page >= pagination.TotalPages)
+		if !all || last {
+			return items, nil
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/api"
+	"github.com/rootlyhq/rootly-tui/internal/config"
+)
+
+// pagedServer serves two pages of one incident or alert each
+func pagedServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	page := func(w http.ResponseWriter, r *http.Request, kind string) {
+		n, _ := strconv.Atoi(r.URL.Query().Get("page[number]"))
+		next := "null"
+		if n < 2 {
+			next = strconv.Itoa(n + 1)
+		}
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = fmt.Fprintf(w, `{"data":[{"id":"%s_%d","type":"%s","attributes":{"title":"%s %d","summary":"%s %d","status":"started"}}],"meta":{"current_page":%d,"next_page":%s,"total_pages":2,"total_count":2}}`,
+			kind, n, kind, kind, n, kind, n, n, next)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/incidents", func(w http.ResponseWriter, r *http.Request) { page(w, r, "incidents") })
+	mux.HandleFunc("/v1/alerts", func(w http.ResponseWriter, r *http.Request) { page(w, r, "alerts") })
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWrite(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := pagedServer(t)
+	client, err := api.NewClient(&config.Config{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	var out bytes.Buffer
+	if err := Write(&out, client, KindIncidents, false, 5*time.Second); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	var incidents []api.Incident
+	if err := json.Unmarshal(out.Bytes(), &incidents); err != nil {
+		t.Fatalf("expected a JSON array, got %q: %v", out.String(), err)
+	}
+	if len(incidents) != 1 || incidents[0].ID != "incidents_1" {
+		t.Errorf("expected the first page only, got %+v", incidents)
+	}
+
+	out.Reset()
+	if err := Write(&out, client, KindAlerts, true, 5*time.Second); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	var alerts []api.Alert
+	if err := json.Unmarshal(out.Bytes(), &alerts); err != nil {
+		t.Fatalf("expected a JSON array, got %q: %v", out.String(), err)
+	}
+	if len(alerts) != 2 || alerts[1].ID != "alerts_2" {
+		t.Errorf("expected both pages with --all, got %+v", alerts)
+	}
+
+	if err := Write(&out, client, "services", false, time.Second); err == nil {
+		t.Error("expected an unknown kind to fail")
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := pagedServer(t)
+
+	t.Setenv(config.EnvAPIKey, "")
+	var out bytes.Buffer
+	if err := Run(&out, KindIncidents, false); err == nil {
+		t.Error("expected missing credentials to fail")
+	}
+
+	// Credentials come from the environment, like the UI
+	t.Setenv(config.EnvAPIKey, "test-key")
+	t.Setenv(config.EnvEndpoint, server.URL)
+	if err := Run(&out, KindIncidents, true); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	var incidents []api.Incident
+	if err := json.Unmarshal(out.Bytes(), &incidents); err != nil || len(incidents) != 2 {
+		t.Errorf("expected every incident, got %q (%v)", out.String(), err)
+	}
+}
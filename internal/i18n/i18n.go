@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"golang.org/x/text/language"
 	"gopkg.in/yaml.v3"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
 )
 
 //go:embed locales/*.yaml
@@ -83,6 +86,13 @@ var bundle *i18n.Bundle
 var localizer *i18n.Localizer
 var currentLang = DefaultLanguage
 
+// fallbackLocalizer resolves keys missing from the active language in English
+var fallbackLocalizer *i18n.Localizer
+
+// warnedMissing records language/key pairs already logged as missing, since
+// views re-render every frame
+var warnedMissing sync.Map
+
 // flattenNestedYAML flattens nested YAML maps into dot-notation keys
 // e.g., {common: {error: "Error"}} -> {"common.error": {other: "Error"}}
 func flattenNestedYAML(data []byte, v interface{}) error {
@@ -152,6 +162,7 @@ func init() {
 
 	// Default to English
 	localizer = i18n.NewLocalizer(bundle, string(LangEnglish))
+	fallbackLocalizer = i18n.NewLocalizer(bundle, string(DefaultLanguage))
 }
 
 // SetLanguage sets the current language
@@ -167,27 +178,39 @@ func GetLanguage() Language {
 
 // T returns the translation for the given message ID
 func T(msgID string) string {
-	msg, err := localizer.Localize(&i18n.LocalizeConfig{
-		MessageID: msgID,
-	})
-	if err != nil {
-		return msgID
-	}
-	return msg
+	return localize(&i18n.LocalizeConfig{MessageID: msgID})
 }
 
 // Tf returns a formatted translation with template data
 func Tf(msgID string, data map[string]interface{}) string {
-	msg, err := localizer.Localize(&i18n.LocalizeConfig{
+	return localize(&i18n.LocalizeConfig{
 		MessageID:    msgID,
 		TemplateData: data,
 	})
-	if err != nil {
-		return msgID
+}
+
+// localize translates in the active language, falling back to English when the
+// key is missing there, and to the key itself when English lacks it too
+func localize(cfg *i18n.LocalizeConfig) string {
+	msg, err := localizer.Localize(cfg)
+	if err == nil {
+		return msg
+	}
+	warnMissing(cfg.MessageID)
+	if msg, err = fallbackLocalizer.Localize(cfg); err != nil {
+		return cfg.MessageID
 	}
 	return msg
 }
 
+// warnMissing logs a missing translation once per language and key
+func warnMissing(msgID string) {
+	if _, seen := warnedMissing.LoadOrStore(string(currentLang)+":"+msgID, true); seen {
+		return
+	}
+	debug.Logger.Warn("Missing translation", "lang", currentLang, "key", msgID)
+}
+
 // ListLanguages returns language codes for selector
 func ListLanguages() []string {
 	result := make([]string, len(SupportedLanguages))
@@ -3,6 +3,9 @@ package i18n
 import (
 	"os"
 	"testing"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
 )
 
 func TestSetLanguage(t *testing.T) {
@@ -255,3 +258,22 @@ func TestTranslationConsistency(t *testing.T) {
 	// Reset
 	SetLanguage(DefaultLanguage)
 }
+
+func TestTFallsBackToEnglish(t *testing.T) {
+	bundle.MustAddMessages(language.AmericanEnglish,
+		&i18n.Message{ID: "test.english_only", Other: "Only in English"},
+		&i18n.Message{ID: "test.english_only_tf", Other: "Only in {{.Lang}}"},
+	)
+	defer SetLanguage(DefaultLanguage)
+
+	SetLanguage(LangGerman)
+	if got := T("test.english_only"); got != "Only in English" {
+		t.Errorf("expected English fallback, got %q", got)
+	}
+	if got := Tf("test.english_only_tf", map[string]interface{}{"Lang": "English"}); got != "Only in English" {
+		t.Errorf("expected formatted English fallback, got %q", got)
+	}
+	if got := T("test.missing_everywhere"); got != "test.missing_everywhere" {
+		t.Errorf("expected key for a message missing in English too, got %q", got)
+	}
+}
@@ -0,0 +1,56 @@
+// Package state remembers where the UI was left between runs: the active tab
+// and the list cursors, kept in ~/.rootly-tui/state.json.
+package state
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+)
+
+const stateFile = "state.json"
+
+// State is the UI position saved on exit and restored on the next launch
+type State struct {
+	Tab           string `json:"tab,omitempty"` // incidents, alerts or services
+	IncidentIndex int    `json:"incident_index,omitempty"`
+	AlertIndex    int    `json:"alert_index,omitempty"`
+}
+
+// Path returns the state file's location
+func Path() string {
+	return filepath.Join(config.Dir(), stateFile)
+}
+
+// Load returns the saved state. A missing or corrupt file gives the zero
+// state, since losing the position is harmless.
+func Load() State {
+	var s State
+	data, err := os.ReadFile(Path())
+	if err != nil {
+		if !os.IsNotExist(err) {
+			debug.Logger.Debug("Failed to read UI state", "error", err)
+		}
+		return State{}
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		debug.Logger.Debug("Ignoring corrupt UI state", "error", err)
+		return State{}
+	}
+	return s
+}
+
+// Save writes s to the state file
+func Save(s State) error {
+	if err := os.MkdirAll(config.Dir(), 0700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(Path(), data, 0600)
+}
@@ -0,0 +1,29 @@
+package state
+
+import (
+	"os"
+	"testing"
+)
+
+func TestSaveAndLoad(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if got := Load(); got != (State{}) {
+		t.Errorf("expected the zero state without a file, got %+v", got)
+	}
+
+	want := State{Tab: "alerts", IncidentIndex: 4, AlertIndex: 2}
+	if err := Save(want); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if got := Load(); got != want {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	if err := os.WriteFile(Path(), []byte("{not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if got := Load(); got != (State{}) {
+		t.Errorf("expected a corrupt file to be ignored, got %+v", got)
+	}
+}
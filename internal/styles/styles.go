@@ -2,9 +2,13 @@ package styles
 
 import (
 	"fmt"
+	"hash/fnv"
+	"image/color"
 	"strings"
+	"unicode"
 
 	"charm.land/glamour/v2"
+	glamourstyles "charm.land/glamour/v2/styles"
 	"charm.land/lipgloss/v2"
 )
 
@@ -16,56 +20,114 @@ const (
 	SpacingLarge  = 3
 )
 
-// Color palette (Rootly brand colors)
+// Color palette, set from the active theme (see SetTheme)
 var (
-	ColorPrimary    = lipgloss.Color("#7C3AED") // Purple
-	ColorPurple     = lipgloss.Color("#7C3AED") // Alias for Primary
-	ColorSecondary  = lipgloss.Color("#6366F1") // Indigo
-	ColorSuccess    = lipgloss.Color("#10B981") // Green
-	ColorWarning    = lipgloss.Color("#F59E0B") // Amber
-	ColorDanger     = lipgloss.Color("#EF4444") // Red
-	ColorInfo       = lipgloss.Color("#4D96FF") // Blue
-	ColorMuted      = lipgloss.Color("#6B7280") // Gray
-	ColorDisabled   = lipgloss.Color("#4B5563") // Darker gray
-	ColorText       = lipgloss.Color("#F9FAFB") // Light
-	ColorTextDim    = lipgloss.Color("#9CA3AF") // Dimmed
-	ColorBackground = lipgloss.Color("#1F2937") // Dark
-	ColorBorder     = lipgloss.Color("#374151") // Border gray
-	ColorHighlight  = lipgloss.Color("#8B5CF6") // Lighter purple
+	ColorPrimary    color.Color
+	ColorPurple     color.Color // Alias for Primary
+	ColorSecondary  color.Color
+	ColorSuccess    color.Color
+	ColorWarning    color.Color
+	ColorDanger     color.Color
+	ColorInfo       color.Color
+	ColorMuted      color.Color
+	ColorDisabled   color.Color
+	ColorText       color.Color
+	ColorTextDim    color.Color
+	ColorOnAccent   color.Color // Text on colored backgrounds (badges, buttons)
+	ColorBackground color.Color
+	ColorBorder     color.Color
+	ColorHighlight  color.Color
 )
 
 // Pastel colors for status text
 var (
-	ColorPastelRed    = lipgloss.Color("#F87171") // Soft red
-	ColorPastelYellow = lipgloss.Color("#FBBF24") // Soft amber
-	ColorPastelGreen  = lipgloss.Color("#34D399") // Soft green
-	ColorPastelGray   = lipgloss.Color("#9CA3AF") // Soft gray
+	ColorPastelRed    color.Color
+	ColorPastelYellow color.Color
+	ColorPastelGreen  color.Color
+	ColorPastelGray   color.Color
 )
 
 // Severity colors
 var (
-	ColorCritical = lipgloss.Color("#DC2626") // Dark red
-	ColorHigh     = lipgloss.Color("#EA580C") // Orange
-	ColorMedium   = lipgloss.Color("#CA8A04") // Yellow
-	ColorLow      = lipgloss.Color("#2563EB") // Blue
+	ColorCritical color.Color
+	ColorHigh     color.Color
+	ColorMedium   color.Color
+	ColorLow      color.Color
 )
 
-// Text styles
+// Styles, rebuilt from the color palette whenever the theme changes
 var (
-	Primary   = lipgloss.NewStyle().Foreground(ColorPrimary)
-	Secondary = lipgloss.NewStyle().Foreground(ColorSecondary)
-	Success   = lipgloss.NewStyle().Foreground(ColorSuccess)
-	Warning   = lipgloss.NewStyle().Foreground(ColorWarning)
-	Danger    = lipgloss.NewStyle().Foreground(ColorDanger)
-	Info      = lipgloss.NewStyle().Foreground(ColorInfo)
-	Muted     = lipgloss.NewStyle().Foreground(ColorMuted)
-	Text      = lipgloss.NewStyle().Foreground(ColorText)
-	TextDim   = lipgloss.NewStyle().Foreground(ColorTextDim)
-	TextBold  = lipgloss.NewStyle().Foreground(ColorText).Bold(true)
+	Primary                lipgloss.Style
+	Secondary              lipgloss.Style
+	Success                lipgloss.Style
+	Warning                lipgloss.Style
+	Danger                 lipgloss.Style
+	Info                   lipgloss.Style
+	Muted                  lipgloss.Style
+	Text                   lipgloss.Style
+	TextDim                lipgloss.Style
+	TextBold               lipgloss.Style
+	App                    lipgloss.Style
+	Header                 lipgloss.Style
+	Title                  lipgloss.Style
+	TabActive              lipgloss.Style
+	TabInactive            lipgloss.Style
+	ListContainer          lipgloss.Style
+	ListItem               lipgloss.Style
+	ListItemSelected       lipgloss.Style
+	DetailContainer        lipgloss.Style
+	DetailContainerFocused lipgloss.Style
+	DetailTitle            lipgloss.Style
+	DetailLabel            lipgloss.Style
+	DetailValue            lipgloss.Style
+	StatusActive           lipgloss.Style
+	StatusInProgress       lipgloss.Style
+	StatusResolved         lipgloss.Style
+	StatusMuted            lipgloss.Style
+	SeverityCritical       lipgloss.Style
+	SeverityHigh           lipgloss.Style
+	SeverityMedium         lipgloss.Style
+	SeverityLow            lipgloss.Style
+	InputLabel             lipgloss.Style
+	InputField             lipgloss.Style
+	InputFieldFocused      lipgloss.Style
+	Button                 lipgloss.Style
+	ButtonFocused          lipgloss.Style
+	ButtonDisabled         lipgloss.Style
+	Dialog                 lipgloss.Style
+	DialogTitle            lipgloss.Style
+	HelpBar                lipgloss.Style
+	HelpKey                lipgloss.Style
+	HelpDesc               lipgloss.Style
+	StatusBar              lipgloss.Style
+	Error                  lipgloss.Style
+	SuccessMsg             lipgloss.Style
+	Spinner                lipgloss.Style
+	DotActive              lipgloss.Style
+	DotWarning             lipgloss.Style
+	DotDanger              lipgloss.Style
+	DotMuted               lipgloss.Style
+	SignalCritical         lipgloss.Style
+	SignalHigh             lipgloss.Style
+	SignalMedium           lipgloss.Style
+	SignalLow              lipgloss.Style
 )
 
-// Layout styles
-var (
+// buildStyles derives every style from the current color palette
+func buildStyles() {
+	// Text styles
+	Primary = lipgloss.NewStyle().Foreground(ColorPrimary)
+	Secondary = lipgloss.NewStyle().Foreground(ColorSecondary)
+	Success = lipgloss.NewStyle().Foreground(ColorSuccess)
+	Warning = lipgloss.NewStyle().Foreground(ColorWarning)
+	Danger = lipgloss.NewStyle().Foreground(ColorDanger)
+	Info = lipgloss.NewStyle().Foreground(ColorInfo)
+	Muted = lipgloss.NewStyle().Foreground(ColorMuted)
+	Text = lipgloss.NewStyle().Foreground(ColorText)
+	TextDim = lipgloss.NewStyle().Foreground(ColorTextDim)
+	TextBold = lipgloss.NewStyle().Foreground(ColorText).Bold(true)
+
+	// Layout styles
 	App = lipgloss.NewStyle().
 		Padding(SpacingSmall, SpacingMedium)
 
@@ -81,127 +143,127 @@ var (
 
 	// Tab styles
 	TabActive = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(ColorPrimary).
-			Underline(true).
-			Padding(SpacingNone, SpacingMedium)
+		Bold(true).
+		Foreground(ColorPrimary).
+		Underline(true).
+		Padding(SpacingNone, SpacingMedium)
 
 	TabInactive = lipgloss.NewStyle().
-			Foreground(ColorTextDim).
-			Padding(SpacingNone, SpacingMedium)
+		Foreground(ColorTextDim).
+		Padding(SpacingNone, SpacingMedium)
 
 	// List styles
 	ListContainer = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(ColorBorder).
-			Padding(SpacingNone, SpacingSmall)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorBorder).
+		Padding(SpacingNone, SpacingSmall)
 
 	ListItem = lipgloss.NewStyle().
-			Foreground(ColorText).
-			Padding(SpacingNone, SpacingSmall)
+		Foreground(ColorText).
+		Padding(SpacingNone, SpacingSmall)
 
 	ListItemSelected = lipgloss.NewStyle().
-				Foreground(ColorHighlight).
-				Bold(true).
-				Padding(SpacingNone, SpacingSmall)
+		Foreground(ColorHighlight).
+		Bold(true).
+		Padding(SpacingNone, SpacingSmall)
 
 	// Detail pane styles
 	DetailContainer = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(ColorBorder).
-			Padding(SpacingSmall, SpacingMedium)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorBorder).
+		Padding(SpacingSmall, SpacingMedium)
 
 	DetailContainerFocused = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(ColorPrimary).
-				Padding(SpacingSmall, SpacingMedium)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(SpacingSmall, SpacingMedium)
 
 	DetailTitle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(ColorPrimary).
-			MarginBottom(SpacingSmall)
+		Bold(true).
+		Foreground(ColorPrimary).
+		MarginBottom(SpacingSmall)
 
 	DetailLabel = lipgloss.NewStyle().
-			Foreground(ColorTextDim).
-			Width(15)
+		Foreground(ColorTextDim).
+		Width(15)
 
 	DetailValue = lipgloss.NewStyle().
-			Foreground(ColorText)
+		Foreground(ColorText)
 
 	// Status styles (pastel text colors, no background)
 	StatusActive = lipgloss.NewStyle().
-			Foreground(ColorPastelRed)
+		Foreground(ColorPastelRed)
 
 	StatusInProgress = lipgloss.NewStyle().
-				Foreground(ColorPastelYellow)
+		Foreground(ColorPastelYellow)
 
 	StatusResolved = lipgloss.NewStyle().
-			Foreground(ColorPastelGreen)
+		Foreground(ColorPastelGreen)
 
 	StatusMuted = lipgloss.NewStyle().
-			Foreground(ColorPastelGray)
+		Foreground(ColorPastelGray)
 
 	// Severity badges
 	SeverityCritical = lipgloss.NewStyle().
-				Foreground(ColorText).
-				Background(ColorCritical).
-				Padding(SpacingNone, SpacingSmall).
-				Bold(true)
+		Foreground(ColorText).
+		Background(ColorCritical).
+		Padding(SpacingNone, SpacingSmall).
+		Bold(true)
 
 	SeverityHigh = lipgloss.NewStyle().
-			Foreground(ColorText).
-			Background(ColorHigh).
-			Padding(SpacingNone, SpacingSmall).
-			Bold(true)
+		Foreground(ColorOnAccent).
+		Background(ColorHigh).
+		Padding(SpacingNone, SpacingSmall).
+		Bold(true)
 
 	SeverityMedium = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#000000")).
-			Background(ColorMedium).
-			Padding(SpacingNone, SpacingSmall).
-			Bold(true)
+		Foreground(lipgloss.Color("#000000")).
+		Background(ColorMedium).
+		Padding(SpacingNone, SpacingSmall).
+		Bold(true)
 
 	SeverityLow = lipgloss.NewStyle().
-			Foreground(ColorText).
-			Background(ColorLow).
-			Padding(SpacingNone, SpacingSmall).
-			Bold(true)
+		Foreground(ColorOnAccent).
+		Background(ColorLow).
+		Padding(SpacingNone, SpacingSmall).
+		Bold(true)
 
 	// Input styles
 	InputLabel = lipgloss.NewStyle().
-			Foreground(ColorText).
-			Bold(true).
-			MarginBottom(SpacingSmall)
+		Foreground(ColorText).
+		Bold(true).
+		MarginBottom(SpacingSmall)
 
 	InputField = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(ColorBorder).
-			Padding(SpacingNone, SpacingSmall).
-			Width(46)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorBorder).
+		Padding(SpacingNone, SpacingSmall).
+		Width(46)
 
 	InputFieldFocused = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(ColorPrimary).
-				Padding(SpacingNone, SpacingSmall).
-				Width(46)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(SpacingNone, SpacingSmall).
+		Width(46)
 
 	// Button styles
 	Button = lipgloss.NewStyle().
-		Foreground(ColorText).
+		Foreground(ColorOnAccent).
 		Background(ColorMuted).
 		Padding(SpacingNone, SpacingMedium).
 		MarginRight(SpacingSmall)
 
 	ButtonFocused = lipgloss.NewStyle().
-			Foreground(ColorText).
-			Background(ColorPrimary).
-			Padding(SpacingNone, SpacingMedium).
-			MarginRight(SpacingSmall)
+		Foreground(ColorOnAccent).
+		Background(ColorPrimary).
+		Padding(SpacingNone, SpacingMedium).
+		MarginRight(SpacingSmall)
 
 	ButtonDisabled = lipgloss.NewStyle().
-			Foreground(ColorTextDim).
-			Background(ColorDisabled).
-			Padding(SpacingNone, SpacingMedium).
-			MarginRight(SpacingSmall)
+		Foreground(ColorTextDim).
+		Background(ColorDisabled).
+		Padding(SpacingNone, SpacingMedium).
+		MarginRight(SpacingSmall)
 
 	// Dialog styles
 	Dialog = lipgloss.NewStyle().
@@ -211,9 +273,9 @@ var (
 		Width(60)
 
 	DialogTitle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(ColorPrimary).
-			MarginBottom(SpacingSmall)
+		Bold(true).
+		Foreground(ColorPrimary).
+		MarginBottom(SpacingSmall)
 
 	// Help bar
 	HelpBar = lipgloss.NewStyle().
@@ -225,12 +287,12 @@ var (
 		Bold(true)
 
 	HelpDesc = lipgloss.NewStyle().
-			Foreground(ColorTextDim)
+		Foreground(ColorTextDim)
 
 	// Status bar
 	StatusBar = lipgloss.NewStyle().
-			Foreground(ColorTextDim).
-			MarginTop(SpacingSmall)
+		Foreground(ColorTextDim).
+		MarginTop(SpacingSmall)
 
 	// Error/Success messages
 	Error = lipgloss.NewStyle().
@@ -238,8 +300,8 @@ var (
 		Bold(true)
 
 	SuccessMsg = lipgloss.NewStyle().
-			Foreground(ColorSuccess).
-			Bold(true)
+		Foreground(ColorSuccess).
+		Bold(true)
 
 	// Spinner
 	Spinner = lipgloss.NewStyle().
@@ -247,85 +309,201 @@ var (
 
 	// Status indicators
 	DotActive = lipgloss.NewStyle().
-			Foreground(ColorSuccess).
-			SetString("●")
+		Foreground(ColorSuccess).
+		SetString("●")
 
 	DotWarning = lipgloss.NewStyle().
-			Foreground(ColorWarning).
-			SetString("●")
+		Foreground(ColorWarning).
+		SetString("●")
 
 	DotDanger = lipgloss.NewStyle().
-			Foreground(ColorDanger).
-			SetString("●")
+		Foreground(ColorDanger).
+		SetString("●")
 
 	DotMuted = lipgloss.NewStyle().
-			Foreground(ColorMuted).
-			SetString("○")
-)
-
-// Helper functions
+		Foreground(ColorMuted).
+		SetString("○")
 
-// Signal bar severity indicators
-var (
+	// Signal bar severity indicators
 	SignalCritical = lipgloss.NewStyle().Foreground(ColorCritical).Bold(true)
-	SignalHigh     = lipgloss.NewStyle().Foreground(ColorHigh).Bold(true)
-	SignalMedium   = lipgloss.NewStyle().Foreground(ColorMedium).Bold(true)
-	SignalLow      = lipgloss.NewStyle().Foreground(ColorLow).Bold(true)
-)
+	SignalHigh = lipgloss.NewStyle().Foreground(ColorHigh).Bold(true)
+	SignalMedium = lipgloss.NewStyle().Foreground(ColorMedium).Bold(true)
+	SignalLow = lipgloss.NewStyle().Foreground(ColorLow).Bold(true)
+
+	MetricLabel = lipgloss.NewStyle().
+		Foreground(ColorTextDim)
+}
+
+// Helper functions
 
 func RenderSeverity(severity string) string {
 	switch severity {
 	case "critical", "Critical", "CRITICAL", "sev0", "SEV0":
-		return SeverityCritical.Render("CRIT")
+		return renderStyled(SeverityCritical, "CRIT")
 	case "high", "High", "HIGH", "sev1", "SEV1":
-		return SeverityHigh.Render("HIGH")
+		return renderStyled(SeverityHigh, "HIGH")
 	case "medium", "Medium", "MEDIUM", "sev2", "SEV2":
-		return SeverityMedium.Render("MED")
+		return renderStyled(SeverityMedium, "MED")
 	case "low", "Low", "LOW", "sev3", "SEV3":
-		return SeverityLow.Render("LOW")
+		return renderStyled(SeverityLow, "LOW")
 	default:
-		return Muted.Render(severity)
+		return renderStyled(Muted, severity)
 	}
 }
 
+// renderStyled renders text with style, or as plain text when color is disabled
+func renderStyled(style lipgloss.Style, text string) string {
+	if colorDisabled {
+		return text
+	}
+	return style.Render(text)
+}
+
 // RenderSeveritySignal renders severity as signal bars (▁▃▅▇)
 func RenderSeveritySignal(severity string) string {
 	switch severity {
 	case "critical", "Critical", "CRITICAL", "sev0", "SEV0":
-		return SignalCritical.Render("▁▃▅▇")
+		return renderStyled(SignalCritical, "▁▃▅▇")
 	case "high", "High", "HIGH", "sev1", "SEV1":
-		return SignalHigh.Render("▁▃▅░")
+		return renderStyled(SignalHigh, "▁▃▅░")
 	case "medium", "Medium", "MEDIUM", "sev2", "SEV2":
-		return SignalMedium.Render("▁▃░░")
+		return renderStyled(SignalMedium, "▁▃░░")
 	case "low", "Low", "LOW", "sev3", "SEV3":
-		return SignalLow.Render("▁░░░")
+		return renderStyled(SignalLow, "▁░░░")
 	default:
-		return Muted.Render("░░░░")
+		return renderStyled(Muted, "░░░░")
+	}
+}
+
+// Status buckets that custom statuses can be mapped to
+const (
+	StatusBucketActive     = "active"
+	StatusBucketInProgress = "in_progress"
+	StatusBucketResolved   = "resolved"
+	StatusBucketMuted      = "muted"
+)
+
+// customStatusBuckets maps organization-specific statuses to a bucket (set from config)
+var customStatusBuckets map[string]string
+
+// SetStatusMap configures custom status → bucket mappings.
+// Keys are matched case-insensitively; unknown buckets are ignored.
+func SetStatusMap(statusMap map[string]string) {
+	customStatusBuckets = make(map[string]string, len(statusMap))
+	for status, bucket := range statusMap {
+		bucket = strings.ToLower(strings.TrimSpace(bucket))
+		switch bucket {
+		case StatusBucketActive, StatusBucketInProgress, StatusBucketResolved, StatusBucketMuted:
+			customStatusBuckets[strings.ToLower(strings.TrimSpace(status))] = bucket
+		}
+	}
+}
+
+// CustomStatusBucket returns the configured bucket for a status, if any
+func CustomStatusBucket(status string) (string, bool) {
+	bucket, ok := customStatusBuckets[strings.ToLower(strings.TrimSpace(status))]
+	return bucket, ok
+}
+
+// Environment color levels that environments can be mapped to (or a #RRGGBB color)
+const (
+	EnvColorDanger  = "danger"
+	EnvColorWarning = "warning"
+	EnvColorSuccess = "success"
+	EnvColorMuted   = "muted"
+)
+
+// defaultEnvironmentColors colors the common environment names by risk
+var defaultEnvironmentColors = map[string]string{
+	"production":  EnvColorDanger,
+	"prod":        EnvColorDanger,
+	"staging":     EnvColorWarning,
+	"stage":       EnvColorWarning,
+	"development": EnvColorMuted,
+	"dev":         EnvColorMuted,
+}
+
+// customEnvironmentColors overrides the defaults (set from config)
+var customEnvironmentColors map[string]string
+
+// SetEnvironmentColors configures environment → color mappings on top of the defaults.
+// Keys are matched case-insensitively; values are a color level or a #RRGGBB color.
+func SetEnvironmentColors(colors map[string]string) {
+	customEnvironmentColors = make(map[string]string, len(colors))
+	for env, c := range colors {
+		customEnvironmentColors[strings.ToLower(strings.TrimSpace(env))] = strings.ToLower(strings.TrimSpace(c))
+	}
+}
+
+// EnvironmentStyle returns the style for an environment name: production red,
+// staging yellow, anything else muted unless configured otherwise
+func EnvironmentStyle(name string) lipgloss.Style {
+	env := strings.ToLower(strings.TrimSpace(name))
+	level, ok := customEnvironmentColors[env]
+	if !ok {
+		level = defaultEnvironmentColors[env]
+	}
+	switch {
+	case level == EnvColorDanger:
+		return lipgloss.NewStyle().Foreground(ColorDanger)
+	case level == EnvColorWarning:
+		return lipgloss.NewStyle().Foreground(ColorWarning)
+	case level == EnvColorSuccess:
+		return lipgloss.NewStyle().Foreground(ColorSuccess)
+	case strings.HasPrefix(level, "#"):
+		return lipgloss.NewStyle().Foreground(lipgloss.Color(level))
+	default:
+		return lipgloss.NewStyle().Foreground(ColorMuted)
 	}
 }
 
 func RenderStatus(status string) string {
-	// Normalize status for comparison
+	switch StatusBucket(status) {
+	case StatusBucketActive:
+		return StatusActive.Render(status)
+	case StatusBucketInProgress:
+		return StatusInProgress.Render(status)
+	case StatusBucketResolved:
+		return StatusResolved.Render(status)
+	default:
+		return StatusMuted.Render(status)
+	}
+}
+
+// StatusBucket normalizes a status to the bucket it's rendered with, honoring
+// the configured status map
+func StatusBucket(status string) string {
 	s := strings.ToLower(strings.TrimSpace(status))
+	if bucket, ok := CustomStatusBucket(s); ok {
+		return bucket
+	}
 	switch s {
 	// Active/urgent - needs attention (red)
 	case "open", "triggered", "firing", "critical":
-		return StatusActive.Render(status)
+		return StatusBucketActive
 	// In progress/mitigated - being worked on (yellow)
 	case "started", "in_progress", "acknowledged", "investigating", "identified", "monitoring", "mitigated":
-		return StatusInProgress.Render(status)
+		return StatusBucketInProgress
 	// Resolved - completed successfully (green)
 	case "resolved", "fixed":
-		return StatusResolved.Render(status)
+		return StatusBucketResolved
 	// Closed/cancelled - done but neutral (gray)
-	case "closed", "cancelled", "canceled", "suppressed":
-		return StatusMuted.Render(status)
 	default:
-		return StatusMuted.Render(status)
+		return StatusBucketMuted
 	}
 }
 
 func RenderStatusDot(status string) string {
+	if bucket, ok := CustomStatusBucket(status); ok {
+		switch bucket {
+		case StatusBucketActive:
+			return DotDanger.String()
+		case StatusBucketInProgress:
+			return DotWarning.String()
+		default:
+			return DotMuted.String()
+		}
+	}
 	switch status {
 	case "resolved", "closed", "mitigated":
 		return DotMuted.String()
@@ -338,8 +516,36 @@ func RenderStatusDot(status string) string {
 	}
 }
 
+// AlertSource overrides how an alert source is shown; empty fields keep the
+// built-in icon, short code or name
+type AlertSource struct {
+	Icon string
+	Code string
+	Name string
+}
+
+// customAlertSources maps lowercase source keys to overrides (set from sources.yaml)
+var customAlertSources map[string]AlertSource
+
+// SetAlertSources configures alert source overrides on top of the built-in
+// icons, codes and names. Keys are matched case-insensitively.
+func SetAlertSources(sources map[string]AlertSource) {
+	customAlertSources = make(map[string]AlertSource, len(sources))
+	for key, src := range sources {
+		customAlertSources[strings.ToLower(strings.TrimSpace(key))] = src
+	}
+}
+
+// customAlertSource returns the configured override for a source, if any
+func customAlertSource(source string) AlertSource {
+	return customAlertSources[strings.ToLower(source)]
+}
+
 // AlertSourceIcon returns just the emoji icon for an alert source
 func AlertSourceIcon(source string) string {
+	if icon := customAlertSource(source).Icon; icon != "" {
+		return icon
+	}
 	switch source {
 	case "datadog":
 		return "🐶"
@@ -392,6 +598,9 @@ func AlertSourceIcon(source string) string {
 
 func RenderAlertSource(source string) string {
 	icon := AlertSourceIcon(source)
+	if code := customAlertSource(source).Code; code != "" {
+		return Muted.Render(icon + code)
+	}
 	switch source {
 	// Major monitoring platforms
 	case "datadog":
@@ -456,6 +665,9 @@ func RenderAlertSource(source string) string {
 
 // AlertSourceName returns the human-readable name for an alert source
 func AlertSourceName(source string) string {
+	if name := customAlertSource(source).Name; name != "" {
+		return name
+	}
 	switch source {
 	case "datadog":
 		return "Datadog"
@@ -519,7 +731,8 @@ func RenderLink(url, text string) string {
 		text = url
 	}
 	// OSC 8 hyperlink format: \x1b]8;;URL\x1b\\TEXT\x1b]8;;\x1b\\
-	return "\x1b]8;;" + url + "\x1b\\" + Info.Underline(true).Render(text) + "\x1b]8;;\x1b\\"
+	// (kept without color: terminals show only TEXT)
+	return "\x1b]8;;" + url + "\x1b\\" + renderStyled(Info.Underline(true), text) + "\x1b]8;;\x1b\\"
 }
 
 // RenderURL renders a URL as a clickable link (URL is both the link and display text)
@@ -540,7 +753,54 @@ func RenderNameWithEmail(name, email string) string {
 	return name + " [" + RenderEmail(email) + "]"
 }
 
-// markdownRenderer is a cached glamour renderer for dark terminals
+// avatarColors are the badge backgrounds NameColor picks from
+var avatarColors = []color.Color{
+	lipgloss.Color("#7C3AED"), // Purple
+	lipgloss.Color("#2563EB"), // Blue
+	lipgloss.Color("#0891B2"), // Cyan
+	lipgloss.Color("#059669"), // Green
+	lipgloss.Color("#CA8A04"), // Yellow
+	lipgloss.Color("#EA580C"), // Orange
+	lipgloss.Color("#DC2626"), // Red
+	lipgloss.Color("#DB2777"), // Pink
+}
+
+// NameColor hashes a name to a badge color, so the same person always gets the same color
+func NameColor(name string) color.Color {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(strings.ToLower(strings.TrimSpace(name))))
+	return avatarColors[h.Sum32()%uint32(len(avatarColors))]
+}
+
+// Initials returns up to two uppercase initials: the first letters of the first and last words
+func Initials(name string) string {
+	words := strings.Fields(name)
+	if len(words) == 0 {
+		return ""
+	}
+	first := []rune(words[0])
+	initials := string(unicode.ToUpper(first[0]))
+	if len(words) > 1 {
+		last := []rune(words[len(words)-1])
+		initials += string(unicode.ToUpper(last[0]))
+	}
+	return initials
+}
+
+// RenderInitialsBadge renders the name's initials on its hashed color
+func RenderInitialsBadge(name string) string {
+	initials := Initials(name)
+	if initials == "" {
+		return ""
+	}
+	return lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#FFFFFF")).
+		Background(NameColor(name)).
+		Bold(true).
+		Render(initials)
+}
+
+// markdownRenderer is a cached glamour renderer (reset when the theme changes)
 var markdownRenderer *glamour.TermRenderer
 
 // getMarkdownRenderer returns a cached glamour renderer
@@ -554,11 +814,18 @@ func getMarkdownRenderer(width int) *glamour.TermRenderer {
 			"link_text": {"color": "%s", "underline": true}
 		}`, ColorInfo, ColorInfo)
 
-		r, err := glamour.NewTermRenderer(
+		opts := []glamour.TermRendererOption{
 			glamour.WithEnvironmentConfig(),
 			glamour.WithWordWrap(width),
 			glamour.WithStylesFromJSONBytes([]byte(styleJSON)),
-		)
+		}
+		if colorDisabled {
+			opts = []glamour.TermRendererOption{
+				glamour.WithStandardStyle(glamourstyles.AsciiStyle),
+				glamour.WithWordWrap(width),
+			}
+		}
+		r, err := glamour.NewTermRenderer(opts...)
 		if err != nil {
 			return nil
 		}
@@ -611,8 +878,8 @@ var (
 			Foreground(lipgloss.Color("#10B981")). // Green
 			Bold(true)
 
-	MetricLabel = lipgloss.NewStyle().
-			Foreground(ColorTextDim)
+	// MetricLabel depends on the palette and is set in buildStyles
+	MetricLabel lipgloss.Style
 )
 
 // RenderMetric renders a metric value with optional styling
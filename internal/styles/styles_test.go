@@ -4,6 +4,8 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+
+	"charm.land/lipgloss/v2"
 )
 
 var ansiRe = regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]|\x1b\][^\x1b]*\x1b\\`)
@@ -124,6 +126,61 @@ func TestRenderStatusDot(t *testing.T) {
 	}
 }
 
+func TestStatusMap(t *testing.T) {
+	SetStatusMap(map[string]string{
+		"Triaging":   "in_progress",
+		"postmortem": "resolved",
+		"bogus":      "not_a_bucket",
+	})
+	defer SetStatusMap(nil)
+
+	if got := RenderStatus("triaging"); got != StatusInProgress.Render("triaging") {
+		t.Errorf("RenderStatus(triaging) = %q, expected in-progress (yellow) style", got)
+	}
+	if got := RenderStatusDot("triaging"); got != DotWarning.String() {
+		t.Errorf("RenderStatusDot(triaging) = %q, expected warning dot", got)
+	}
+	if got := RenderStatus("postmortem"); got != StatusResolved.Render("postmortem") {
+		t.Errorf("RenderStatus(postmortem) = %q, expected resolved style", got)
+	}
+	if got := RenderStatusDot("postmortem"); got != DotMuted.String() {
+		t.Errorf("RenderStatusDot(postmortem) = %q, expected muted dot", got)
+	}
+
+	// Unknown buckets are ignored and fall back to default behavior
+	if _, ok := CustomStatusBucket("bogus"); ok {
+		t.Error("expected unknown bucket to be ignored")
+	}
+	if got := RenderStatus("bogus"); got != StatusMuted.Render("bogus") {
+		t.Errorf("RenderStatus(bogus) = %q, expected muted style", got)
+	}
+
+	// Built-in statuses are unaffected
+	if got := RenderStatus("started"); got != StatusInProgress.Render("started") {
+		t.Errorf("RenderStatus(started) = %q, expected in-progress style", got)
+	}
+}
+
+func TestStatusBucket(t *testing.T) {
+	SetStatusMap(map[string]string{"paged": "active"})
+	defer SetStatusMap(nil)
+
+	tests := map[string]string{
+		"triggered":  StatusBucketActive,
+		" Started ":  StatusBucketInProgress,
+		"mitigated":  StatusBucketInProgress,
+		"fixed":      StatusBucketResolved,
+		"cancelled":  StatusBucketMuted,
+		"paged":      StatusBucketActive,
+		"in_triage?": StatusBucketMuted,
+	}
+	for status, want := range tests {
+		if got := StatusBucket(status); got != want {
+			t.Errorf("StatusBucket(%q) = %q, want %q", status, got, want)
+		}
+	}
+}
+
 func TestRenderAlertSource(t *testing.T) {
 	tests := []struct {
 		source   string
@@ -403,6 +460,35 @@ func TestRenderAlertSourceExtended(t *testing.T) {
 	}
 }
 
+func TestSetAlertSources(t *testing.T) {
+	defer SetAlertSources(nil)
+
+	SetAlertSources(map[string]AlertSource{
+		"Acme_Monitor": {Icon: "🛰️", Code: "AM", Name: "Acme Monitor"},
+		"datadog":      {Name: "Datadog (prod)"},
+	})
+
+	if AlertSourceIcon("acme_monitor") != "🛰️" || AlertSourceName("acme_monitor") != "Acme Monitor" {
+		t.Errorf("expected the custom source, got %q %q", AlertSourceIcon("acme_monitor"), AlertSourceName("acme_monitor"))
+	}
+	if got := RenderAlertSource("acme_monitor"); !strings.Contains(got, "🛰️AM") {
+		t.Errorf("expected the custom short code, got %q", got)
+	}
+
+	// Partial overrides keep the built-in fields
+	if AlertSourceName("datadog") != "Datadog (prod)" || AlertSourceIcon("datadog") != "🐶" {
+		t.Errorf("expected only the datadog name to change, got %q %q", AlertSourceName("datadog"), AlertSourceIcon("datadog"))
+	}
+	if got := RenderAlertSource("datadog"); !strings.Contains(got, "DD") {
+		t.Errorf("expected the built-in datadog code, got %q", got)
+	}
+
+	// Unknown sources fall back to the defaults
+	if AlertSourceIcon("other") != "📡" || AlertSourceName("other") != "other" {
+		t.Error("expected unknown sources to use the defaults")
+	}
+}
+
 func TestRenderStatusDotDefault(t *testing.T) {
 	// Test default case returns DotActive
 	result := RenderStatusDot("some_other_status")
@@ -598,3 +684,154 @@ func TestRenderMarkdownMultiline(t *testing.T) {
 		t.Errorf("RenderMarkdown should contain second line, got %q", result)
 	}
 }
+
+func TestResolveTheme(t *testing.T) {
+	dark := func() bool { return true }
+	light := func() bool { return false }
+
+	tests := []struct {
+		setting  string
+		hasDark  func() bool
+		expected string
+	}{
+		{"", dark, ThemeDark},
+		{"", light, ThemeLight},
+		{"auto", light, ThemeLight},
+		{"dark", light, ThemeDark},
+		{"Light", dark, ThemeLight},
+		{"", nil, ThemeDark},
+		{"rootly", light, ThemeDark},
+		{"Solarized-Dark", light, ThemeSolarizedDark},
+		{"nord", light, ThemeNord},
+		{"mono", dark, ThemeMono},
+		{"dracula", light, ThemeDark},
+	}
+
+	for _, tt := range tests {
+		if got := ResolveTheme(tt.setting, tt.hasDark); got != tt.expected {
+			t.Errorf("ResolveTheme(%q) = %q, expected %q", tt.setting, got, tt.expected)
+		}
+	}
+}
+
+func TestSetThemeRebuildsStyles(t *testing.T) {
+	defer SetTheme(ThemeDark)
+
+	SetTheme(ThemeLight)
+	if ColorText != LightPalette.Text {
+		t.Error("expected light text color")
+	}
+	if Text.GetForeground() != LightPalette.Text {
+		t.Error("expected Text style to use the light palette")
+	}
+
+	SetTheme(ThemeNord)
+	if CurrentTheme() != ThemeNord || Primary.GetForeground() != NordPalette.Primary || SeverityCritical.GetBackground() != NordPalette.Critical {
+		t.Error("expected the nord palette to be applied")
+	}
+
+	SetTheme("unknown")
+	if CurrentTheme() != ThemeDark || Text.GetForeground() != DarkPalette.Text {
+		t.Error("expected unknown themes to fall back to dark")
+	}
+}
+
+func TestDisableColor(t *testing.T) {
+	defer func() {
+		colorDisabled = false
+		SetTheme(ThemeDark)
+	}()
+
+	if !strings.Contains(RenderSeverity("critical"), "\x1b[") {
+		t.Fatal("expected severity to be styled while colors are on")
+	}
+
+	DisableColor()
+	if !IsColorDisabled() {
+		t.Error("expected colors to be reported as disabled")
+	}
+	for _, sev := range []string{"critical", "high", "medium", "low", "unknown"} {
+		if got := RenderSeverity(sev); strings.Contains(got, "\x1b") {
+			t.Errorf("RenderSeverity(%q) = %q, expected no escape codes", sev, got)
+		}
+		if got := RenderSeveritySignal(sev); strings.Contains(got, "\x1b") {
+			t.Errorf("RenderSeveritySignal(%q) = %q, expected no escape codes", sev, got)
+		}
+	}
+	if got := RenderSeverity("critical"); got != "CRIT" {
+		t.Errorf("expected the plain severity label, got %q", got)
+	}
+
+	// Hyperlinks keep their OSC 8 sequence but lose color
+	if got := RenderLink("https://rootly.com", "Rootly"); got != "\x1b]8;;https://rootly.com\x1b\\Rootly\x1b]8;;\x1b\\" {
+		t.Errorf("expected an uncolored OSC 8 link, got %q", got)
+	}
+	if got := RenderMarkdown("Some **bold** text", 80); strings.Contains(got, "\x1b[") {
+		t.Errorf("expected plain markdown, got %q", got)
+	}
+
+	// Themes applied later stay colorless
+	SetTheme(ThemeNord)
+	if _, ok := ColorPrimary.(lipgloss.NoColor); !ok {
+		t.Errorf("expected no color after a theme change, got %v", ColorPrimary)
+	}
+}
+
+func TestInitials(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"John Doe", "JD"},
+		{"jane", "J"},
+		{"Mary Ann van Dyke", "MD"},
+		{"  ", ""},
+		{"élodie durand", "ÉD"},
+	}
+	for _, tt := range tests {
+		if got := Initials(tt.name); got != tt.want {
+			t.Errorf("Initials(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNameColorStable(t *testing.T) {
+	if NameColor("John Doe") != NameColor("John Doe") {
+		t.Error("expected the same name to always get the same color")
+	}
+	if NameColor("John Doe") != NameColor(" john doe ") {
+		t.Error("expected case and surrounding space to be ignored")
+	}
+	if got := stripANSI(RenderInitialsBadge("John Doe")); got != "JD" {
+		t.Errorf("expected badge text JD, got %q", got)
+	}
+	if RenderInitialsBadge("") != "" {
+		t.Error("expected no badge for an empty name")
+	}
+}
+
+func TestEnvironmentStyle(t *testing.T) {
+	SetEnvironmentColors(map[string]string{
+		"Production": "#FF0000",
+		"qa":         "warning",
+	})
+	defer SetEnvironmentColors(nil)
+
+	if got := EnvironmentStyle("production").GetForeground(); got != lipgloss.Color("#FF0000") {
+		t.Errorf("EnvironmentStyle(production) foreground = %v, expected the configured color", got)
+	}
+	if got := EnvironmentStyle("QA").GetForeground(); got != ColorWarning {
+		t.Errorf("EnvironmentStyle(QA) foreground = %v, expected warning", got)
+	}
+	if got := EnvironmentStyle("staging").GetForeground(); got != ColorWarning {
+		t.Errorf("EnvironmentStyle(staging) foreground = %v, expected the default warning", got)
+	}
+	if got := EnvironmentStyle("sandbox-eu").GetForeground(); got != ColorMuted {
+		t.Errorf("EnvironmentStyle(sandbox-eu) foreground = %v, expected muted", got)
+	}
+
+	SetEnvironmentColors(nil)
+	if got := EnvironmentStyle("prod").GetForeground(); got != ColorDanger {
+		t.Errorf("EnvironmentStyle(prod) foreground = %v, expected danger by default", got)
+	}
+}
@@ -0,0 +1,283 @@
+package styles
+
+import (
+	"image/color"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/debug"
+)
+
+// Theme names accepted by the theme config option
+const (
+	ThemeAuto          = "auto" // Detect from the terminal background
+	ThemeDark          = "dark"
+	ThemeLight         = "light"
+	ThemeRootly        = "rootly" // Alias for dark, the Rootly brand palette
+	ThemeSolarizedDark = "solarized-dark"
+	ThemeNord          = "nord"
+	ThemeMono          = "mono"
+)
+
+// ThemeNames lists the selectable themes, auto first
+var ThemeNames = []string{ThemeAuto, ThemeDark, ThemeLight, ThemeSolarizedDark, ThemeNord, ThemeMono}
+
+// Palette is the set of colors a theme assigns to the Color* variables
+type Palette struct {
+	Primary, Secondary                   color.Color
+	Success, Warning, Danger, Info       color.Color
+	Muted, Disabled                      color.Color
+	Text, TextDim, OnAccent              color.Color
+	Background, Border, Highlight        color.Color
+	PastelRed, PastelYellow, PastelGreen color.Color
+	PastelGray                           color.Color
+	Critical, High, Medium, Low          color.Color
+}
+
+// DarkPalette suits dark terminal backgrounds (Rootly brand colors)
+var DarkPalette = Palette{
+	Primary:      lipgloss.Color("#7C3AED"), // Purple
+	Secondary:    lipgloss.Color("#6366F1"), // Indigo
+	Success:      lipgloss.Color("#10B981"), // Green
+	Warning:      lipgloss.Color("#F59E0B"), // Amber
+	Danger:       lipgloss.Color("#EF4444"), // Red
+	Info:         lipgloss.Color("#4D96FF"), // Blue
+	Muted:        lipgloss.Color("#6B7280"), // Gray
+	Disabled:     lipgloss.Color("#4B5563"), // Darker gray
+	Text:         lipgloss.Color("#F9FAFB"), // Light
+	TextDim:      lipgloss.Color("#9CA3AF"), // Dimmed
+	OnAccent:     lipgloss.Color("#F9FAFB"), // Light
+	Background:   lipgloss.Color("#1F2937"), // Dark
+	Border:       lipgloss.Color("#374151"), // Border gray
+	Highlight:    lipgloss.Color("#8B5CF6"), // Lighter purple
+	PastelRed:    lipgloss.Color("#F87171"), // Soft red
+	PastelYellow: lipgloss.Color("#FBBF24"), // Soft amber
+	PastelGreen:  lipgloss.Color("#34D399"), // Soft green
+	PastelGray:   lipgloss.Color("#9CA3AF"), // Soft gray
+	Critical:     lipgloss.Color("#DC2626"), // Dark red
+	High:         lipgloss.Color("#EA580C"), // Orange
+	Medium:       lipgloss.Color("#CA8A04"), // Yellow
+	Low:          lipgloss.Color("#2563EB"), // Blue
+}
+
+// LightPalette uses darker shades so text stays readable on light backgrounds
+var LightPalette = Palette{
+	Primary:      lipgloss.Color("#6D28D9"), // Deep purple
+	Secondary:    lipgloss.Color("#4F46E5"), // Indigo
+	Success:      lipgloss.Color("#047857"), // Dark green
+	Warning:      lipgloss.Color("#B45309"), // Dark amber
+	Danger:       lipgloss.Color("#DC2626"), // Red
+	Info:         lipgloss.Color("#1D4ED8"), // Dark blue
+	Muted:        lipgloss.Color("#6B7280"), // Gray
+	Disabled:     lipgloss.Color("#E5E7EB"), // Pale gray
+	Text:         lipgloss.Color("#111827"), // Near black
+	TextDim:      lipgloss.Color("#4B5563"), // Dark gray
+	OnAccent:     lipgloss.Color("#FFFFFF"), // White
+	Background:   lipgloss.Color("#F9FAFB"), // Light
+	Border:       lipgloss.Color("#D1D5DB"), // Border gray
+	Highlight:    lipgloss.Color("#7C3AED"), // Purple
+	PastelRed:    lipgloss.Color("#DC2626"), // Red
+	PastelYellow: lipgloss.Color("#B45309"), // Amber
+	PastelGreen:  lipgloss.Color("#059669"), // Green
+	PastelGray:   lipgloss.Color("#6B7280"), // Gray
+	Critical:     lipgloss.Color("#B91C1C"), // Dark red
+	High:         lipgloss.Color("#C2410C"), // Orange
+	Medium:       lipgloss.Color("#A16207"), // Dark yellow
+	Low:          lipgloss.Color("#1D4ED8"), // Blue
+}
+
+// SolarizedDarkPalette follows Ethan Schoonover's Solarized dark scheme
+var SolarizedDarkPalette = Palette{
+	Primary:      lipgloss.Color("#268BD2"), // Blue
+	Secondary:    lipgloss.Color("#6C71C4"), // Violet
+	Success:      lipgloss.Color("#859900"), // Green
+	Warning:      lipgloss.Color("#B58900"), // Yellow
+	Danger:       lipgloss.Color("#DC322F"), // Red
+	Info:         lipgloss.Color("#2AA198"), // Cyan
+	Muted:        lipgloss.Color("#586E75"), // base01
+	Disabled:     lipgloss.Color("#073642"), // base02
+	Text:         lipgloss.Color("#EEE8D5"), // base2
+	TextDim:      lipgloss.Color("#93A1A1"), // base1
+	OnAccent:     lipgloss.Color("#FDF6E3"), // base3
+	Background:   lipgloss.Color("#002B36"), // base03
+	Border:       lipgloss.Color("#073642"), // base02
+	Highlight:    lipgloss.Color("#2AA198"), // Cyan
+	PastelRed:    lipgloss.Color("#DC322F"), // Red
+	PastelYellow: lipgloss.Color("#B58900"), // Yellow
+	PastelGreen:  lipgloss.Color("#859900"), // Green
+	PastelGray:   lipgloss.Color("#93A1A1"), // base1
+	Critical:     lipgloss.Color("#DC322F"), // Red
+	High:         lipgloss.Color("#CB4B16"), // Orange
+	Medium:       lipgloss.Color("#B58900"), // Yellow
+	Low:          lipgloss.Color("#268BD2"), // Blue
+}
+
+// NordPalette follows the Nord arctic color scheme
+var NordPalette = Palette{
+	Primary:      lipgloss.Color("#88C0D0"), // nord8
+	Secondary:    lipgloss.Color("#81A1C1"), // nord9
+	Success:      lipgloss.Color("#A3BE8C"), // nord14
+	Warning:      lipgloss.Color("#EBCB8B"), // nord13
+	Danger:       lipgloss.Color("#BF616A"), // nord11
+	Info:         lipgloss.Color("#5E81AC"), // nord10
+	Muted:        lipgloss.Color("#616E88"), // Comment gray
+	Disabled:     lipgloss.Color("#4C566A"), // nord3
+	Text:         lipgloss.Color("#ECEFF4"), // nord6
+	TextDim:      lipgloss.Color("#D8DEE9"), // nord4
+	OnAccent:     lipgloss.Color("#2E3440"), // nord0
+	Background:   lipgloss.Color("#2E3440"), // nord0
+	Border:       lipgloss.Color("#434C5E"), // nord2
+	Highlight:    lipgloss.Color("#8FBCBB"), // nord7
+	PastelRed:    lipgloss.Color("#BF616A"), // nord11
+	PastelYellow: lipgloss.Color("#EBCB8B"), // nord13
+	PastelGreen:  lipgloss.Color("#A3BE8C"), // nord14
+	PastelGray:   lipgloss.Color("#D8DEE9"), // nord4
+	Critical:     lipgloss.Color("#BF616A"), // nord11
+	High:         lipgloss.Color("#D08770"), // nord12
+	Medium:       lipgloss.Color("#EBCB8B"), // nord13
+	Low:          lipgloss.Color("#5E81AC"), // nord10
+}
+
+// MonoPalette uses shades of gray only, for terminals where color distracts
+var MonoPalette = Palette{
+	Primary:      lipgloss.Color("#FFFFFF"),
+	Secondary:    lipgloss.Color("#D4D4D4"),
+	Success:      lipgloss.Color("#D4D4D4"),
+	Warning:      lipgloss.Color("#E5E5E5"),
+	Danger:       lipgloss.Color("#FFFFFF"),
+	Info:         lipgloss.Color("#D4D4D4"),
+	Muted:        lipgloss.Color("#8A8A8A"),
+	Disabled:     lipgloss.Color("#4A4A4A"),
+	Text:         lipgloss.Color("#F5F5F5"),
+	TextDim:      lipgloss.Color("#A3A3A3"),
+	OnAccent:     lipgloss.Color("#000000"),
+	Background:   lipgloss.Color("#1A1A1A"),
+	Border:       lipgloss.Color("#525252"),
+	Highlight:    lipgloss.Color("#E5E5E5"),
+	PastelRed:    lipgloss.Color("#FFFFFF"),
+	PastelYellow: lipgloss.Color("#D4D4D4"),
+	PastelGreen:  lipgloss.Color("#A3A3A3"),
+	PastelGray:   lipgloss.Color("#8A8A8A"),
+	Critical:     lipgloss.Color("#FFFFFF"),
+	High:         lipgloss.Color("#D4D4D4"),
+	Medium:       lipgloss.Color("#A3A3A3"),
+	Low:          lipgloss.Color("#8A8A8A"),
+}
+
+// palettes maps each concrete theme to its palette
+var palettes = map[string]Palette{
+	ThemeDark:          DarkPalette,
+	ThemeLight:         LightPalette,
+	ThemeSolarizedDark: SolarizedDarkPalette,
+	ThemeNord:          NordPalette,
+	ThemeMono:          MonoPalette,
+}
+
+// currentTheme is the theme last applied by SetTheme
+var currentTheme = ThemeDark
+
+// colorDisabled is set by DisableColor and outlasts theme changes
+var colorDisabled bool
+
+// DisableColor drops all color (for NO_COLOR and --no-color): every palette
+// color becomes NoColor, and severity, link and markdown rendering emit plain
+// text. Themes applied afterwards stay colorless.
+func DisableColor() {
+	colorDisabled = true
+	applyPalette(noColorPalette())
+}
+
+// IsColorDisabled returns whether DisableColor was called
+func IsColorDisabled() bool {
+	return colorDisabled
+}
+
+// noColorPalette returns a palette with every color set to NoColor
+func noColorPalette() Palette {
+	none := lipgloss.NoColor{}
+	return Palette{
+		Primary: none, Secondary: none,
+		Success: none, Warning: none, Danger: none, Info: none,
+		Muted: none, Disabled: none,
+		Text: none, TextDim: none, OnAccent: none,
+		Background: none, Border: none, Highlight: none,
+		PastelRed: none, PastelYellow: none, PastelGreen: none, PastelGray: none,
+		Critical: none, High: none, Medium: none, Low: none,
+	}
+}
+
+func init() {
+	applyPalette(DarkPalette)
+}
+
+// ResolveTheme turns a configured theme into a concrete one. Auto (or no
+// setting) picks dark or light via hasDark; unknown names fall back to dark.
+func ResolveTheme(setting string, hasDark func() bool) string {
+	theme := strings.ToLower(strings.TrimSpace(setting))
+	switch theme {
+	case "", ThemeAuto:
+		if hasDark != nil && !hasDark() {
+			return ThemeLight
+		}
+		return ThemeDark
+	case ThemeRootly:
+		return ThemeDark
+	}
+	if _, ok := palettes[theme]; !ok {
+		debug.Logger.Debug("Unknown theme, using rootly", "theme", setting)
+		return ThemeDark
+	}
+	return theme
+}
+
+// SetTheme switches to the palette of a concrete theme and rebuilds every
+// style; unknown themes fall back to dark
+func SetTheme(theme string) {
+	palette, ok := palettes[theme]
+	if !ok {
+		debug.Logger.Debug("Unknown theme, using rootly", "theme", theme)
+		theme, palette = ThemeDark, DarkPalette
+	}
+	currentTheme = theme
+	if colorDisabled {
+		palette = noColorPalette()
+	}
+	applyPalette(palette)
+}
+
+// CurrentTheme returns the concrete theme last applied
+func CurrentTheme() string {
+	return currentTheme
+}
+
+// applyPalette assigns the palette's colors and derives the styles from them
+func applyPalette(p Palette) {
+	ColorPrimary = p.Primary
+	ColorPurple = p.Primary
+	ColorSecondary = p.Secondary
+	ColorSuccess = p.Success
+	ColorWarning = p.Warning
+	ColorDanger = p.Danger
+	ColorInfo = p.Info
+	ColorMuted = p.Muted
+	ColorDisabled = p.Disabled
+	ColorText = p.Text
+	ColorTextDim = p.TextDim
+	ColorOnAccent = p.OnAccent
+	ColorBackground = p.Background
+	ColorBorder = p.Border
+	ColorHighlight = p.Highlight
+	ColorPastelRed = p.PastelRed
+	ColorPastelYellow = p.PastelYellow
+	ColorPastelGreen = p.PastelGreen
+	ColorPastelGray = p.PastelGray
+	ColorCritical = p.Critical
+	ColorHigh = p.High
+	ColorMedium = p.Medium
+	ColorLow = p.Low
+
+	buildStyles()
+	// Link colors are baked into the cached markdown renderer
+	markdownRenderer = nil
+}
@@ -68,7 +68,9 @@ func (m AboutModel) View() string {
 	b.WriteString(styles.TextDim.Render("Thanks Claude Opus 4.5"))
 	b.WriteString("\n\n")
 
-	// Close hint
+	// Close and copy-config hints
+	b.WriteString(styles.TextDim.Render(i18n.T("about.copy_config_hint")))
+	b.WriteString("\n")
 	b.WriteString(styles.TextDim.Render(i18n.T("about.press_to_close")))
 
 	return styles.Dialog.Render(b.String())
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"charm.land/bubbles/v2/viewport"
 	tea "charm.land/bubbletea/v2"
@@ -13,6 +15,7 @@ import (
 	"github.com/evertras/bubble-table/table"
 
 	"github.com/rootlyhq/rootly-tui/internal/api"
+	"github.com/rootlyhq/rootly-tui/internal/components"
 	"github.com/rootlyhq/rootly-tui/internal/config"
 	"github.com/rootlyhq/rootly-tui/internal/i18n"
 	"github.com/rootlyhq/rootly-tui/internal/styles"
@@ -52,6 +55,9 @@ const (
 // Row indicator for selected row (same as incidents)
 const alertRowIndicator = "▶"
 
+// flappingIndicator marks alerts that keep firing and resolving
+const flappingIndicator = "↯"
+
 type AlertsModel struct {
 	alerts       []api.Alert
 	width        int
@@ -61,6 +67,7 @@ type AlertsModel struct {
 	listHeight   int
 	detailHeight int
 	layout       string // "horizontal" or "vertical"
+	narrow       bool   // width below narrowWidth: panes stack whatever the layout
 	loading      bool
 	error        string
 	// Pagination state
@@ -73,12 +80,44 @@ type AlertsModel struct {
 	spinnerView string
 	// Detail loading state - tracks which alert ID is currently loading (empty = not loading)
 	detailLoadingID string
+	// IDs whose loaded detail was served from the cache (marked ⚡cached)
+	cachedDetails map[string]bool
 	// Detail viewport for scrollable content
 	detailViewport      viewport.Model
 	detailViewportReady bool
 	detailFocused       bool // Whether detail pane has focus (for scrolling)
 	// Table for list view
 	table table.Model
+	// Mask emails and links in the detail pane (for screensharing)
+	presentMode bool
+	// relativeTimes shows detail timestamps as "12m ago" instead of dates
+	relativeTimes bool
+	// Label values longer than maxLabelLen runes are truncated (0 = no limit),
+	// unless expanded for the alert with ID labelsExpandedID
+	maxLabelLen      int
+	labelsExpandedID string
+	// Alert ID -> occurrences within the flap window, for alerts that are flapping
+	flapping map[string]int
+	// Table rows that fit on screen, and the page size used by list requests
+	fitRows  int
+	pageSize int
+	// Group the list under a header row per incident; rows maps table rows to alerts
+	groupByIncident bool
+	rows            []alertListRow
+	// Group the list under a header row per service instead; the cursor skips
+	// the headers of expanded groups. collapsed holds the services whose alerts
+	// are hidden ("" for ungrouped alerts).
+	groupByService bool
+	collapsed      map[string]bool
+	// Client-side sort of the loaded page
+	sortState *components.SortState
+	sortMenu  *components.SortMenuModel
+}
+
+// alertListRow is a row of the alerts table: an alert, or a group header when alert is -1
+type alertListRow struct {
+	alert int
+	group api.AlertGroup
 }
 
 func NewAlertsModel() AlertsModel {
@@ -103,6 +142,9 @@ func NewAlertsModel() AlertsModel {
 		alerts:      []api.Alert{},
 		currentPage: 1,
 		table:       t,
+		maxLabelLen: config.DefaultMaxLabelValueLen,
+		sortState:   components.NewSortState(),
+		sortMenu:    components.NewSortMenu(alertSortOptions()),
 	}
 }
 
@@ -168,31 +210,31 @@ func (m AlertsModel) Update(msg tea.Msg) (AlertsModel, tea.Cmd) {
 		// Handle navigation keys ourselves to prevent table's wrap-around behavior
 		switch msg.String() {
 		case "j", "down":
-			cursor := m.table.GetHighlightedRowIndex()
-			if cursor < len(m.alerts)-1 {
-				m.table = m.table.WithHighlightedRow(cursor + 1)
+			if next := m.nextSelectableRow(m.table.GetHighlightedRowIndex()+1, 1); next >= 0 {
+				m.table = m.table.WithHighlightedRow(next)
 				m.updateRowIndicators()
 				m.updateViewportContent()
 			}
 			return m, nil
 		case "k", "up":
-			cursor := m.table.GetHighlightedRowIndex()
-			if cursor > 0 {
-				m.table = m.table.WithHighlightedRow(cursor - 1)
+			if prev := m.nextSelectableRow(m.table.GetHighlightedRowIndex()-1, -1); prev >= 0 {
+				m.table = m.table.WithHighlightedRow(prev)
 				m.updateRowIndicators()
 				m.updateViewportContent()
 			}
 			return m, nil
 		case "g":
 			// Go to first row
-			m.table = m.table.WithHighlightedRow(0)
-			m.updateRowIndicators()
-			m.updateViewportContent()
+			if first := m.nextSelectableRow(0, 1); first >= 0 {
+				m.table = m.table.WithHighlightedRow(first)
+				m.updateRowIndicators()
+				m.updateViewportContent()
+			}
 			return m, nil
 		case "G":
 			// Go to last row
-			if len(m.alerts) > 0 {
-				m.table = m.table.WithHighlightedRow(len(m.alerts) - 1)
+			if last := m.nextSelectableRow(len(m.rows)-1, -1); last >= 0 {
+				m.table = m.table.WithHighlightedRow(last)
 				m.updateRowIndicators()
 				m.updateViewportContent()
 			}
@@ -224,6 +266,67 @@ func (m AlertsModel) Update(msg tea.Msg) (AlertsModel, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// SetPresentMode masks emails and links in the detail pane
+func (m *AlertsModel) SetPresentMode(enabled bool) {
+	m.presentMode = enabled
+	m.updateViewportContent()
+}
+
+// SetRelativeTimes shows detail pane timestamps relative to now ("12m ago")
+// instead of as dates
+func (m *AlertsModel) SetRelativeTimes(enabled bool) {
+	m.relativeTimes = enabled
+	m.updateViewportContent()
+}
+
+// formatDetailTime formats a detail pane timestamp as a date, or relative to
+// now when relative times are on
+func (m AlertsModel) formatDetailTime(t time.Time) string {
+	if m.relativeTimes {
+		return formatRelative(t, time.Now())
+	}
+	return formatAlertTime(t)
+}
+
+// SetMaxLabelValueLen sets how many runes of a label value the detail pane shows (0 = no limit)
+func (m *AlertsModel) SetMaxLabelValueLen(n int) {
+	m.maxLabelLen = n
+	m.updateViewportContent()
+}
+
+// ToggleLabelsExpanded shows or re-truncates the full label values of the selected alert.
+// Returns false if the selected alert has no truncated labels.
+func (m *AlertsModel) ToggleLabelsExpanded() bool {
+	alert := m.SelectedAlert()
+	if alert == nil {
+		return false
+	}
+	if m.labelsExpandedID == alert.ID {
+		m.labelsExpandedID = ""
+		m.updateViewportContent()
+		return true
+	}
+	if !m.hasTruncatedLabels(alert) {
+		return false
+	}
+	m.labelsExpandedID = alert.ID
+	m.updateViewportContent()
+	return true
+}
+
+// hasTruncatedLabels reports whether any of the alert's label values exceed the limit
+func (m AlertsModel) hasTruncatedLabels(alert *api.Alert) bool {
+	if m.maxLabelLen <= 0 {
+		return false
+	}
+	for _, v := range alert.Labels {
+		if !isURL(v) && utf8.RuneCountInString(v) > m.maxLabelLen {
+			return true
+		}
+	}
+	return false
+}
+
 // updateViewportContent updates the viewport content when data changes
 func (m *AlertsModel) updateViewportContent() {
 	if !m.detailViewportReady {
@@ -238,14 +341,67 @@ func (m *AlertsModel) updateViewportContent() {
 	m.detailViewport.GotoTop()
 }
 
+// alertTitle returns the alert's single-line summary for the list, flagged when flapping
+func (m AlertsModel) alertTitle(alert *api.Alert) string {
+	summary := alert.DisplayTitle()
+	if _, ok := m.flapping[alert.ID]; ok {
+		summary = flappingIndicator + " " + summary
+	}
+	return summary
+}
+
 // updateRowIndicators updates the arrow indicator to show on the current row
 func (m *AlertsModel) updateRowIndicators() {
-	if len(m.alerts) == 0 {
+	if len(m.rows) == 0 {
 		return
 	}
-	cursor := m.table.GetHighlightedRowIndex()
-	rows := make([]table.Row, len(m.alerts))
-	for i, alert := range m.alerts {
+	m.table = m.table.WithRows(m.tableRows(m.table.GetHighlightedRowIndex()))
+}
+
+// buildRows lays out the table rows: the alerts in order, or each incident's
+// (or service's) header followed by its alerts when grouping
+func (m *AlertsModel) buildRows() {
+	m.rows = make([]alertListRow, 0, len(m.alerts))
+	if m.groupByService {
+		for _, g := range api.GroupAlertsByService(m.alerts) {
+			m.rows = append(m.rows, alertListRow{alert: -1, group: g})
+			if m.collapsed[g.Service] {
+				continue
+			}
+			for _, i := range g.Alerts {
+				m.rows = append(m.rows, alertListRow{alert: i})
+			}
+		}
+		return
+	}
+	if !m.groupByIncident {
+		for i := range m.alerts {
+			m.rows = append(m.rows, alertListRow{alert: i})
+		}
+		return
+	}
+	for _, g := range api.GroupAlertsByIncident(m.alerts) {
+		m.rows = append(m.rows, alertListRow{alert: -1, group: g})
+		for _, i := range g.Alerts {
+			m.rows = append(m.rows, alertListRow{alert: i})
+		}
+	}
+}
+
+// tableRows renders the table rows with the indicator on the cursor row
+func (m AlertsModel) tableRows(cursor int) []table.Row {
+	rows := make([]table.Row, len(m.rows))
+	for i, row := range m.rows {
+		indicator := ""
+		if i == cursor {
+			indicator = alertRowIndicator
+		}
+		if row.alert < 0 {
+			rows[i] = m.groupHeaderRow(row.group, indicator)
+			continue
+		}
+
+		alert := m.alerts[row.alert]
 		shortID := alert.ShortID
 		if shortID == "" {
 			shortID = "---"
@@ -254,9 +410,9 @@ func (m *AlertsModel) updateRowIndicators() {
 		if len(status) > 10 {
 			status = status[:10]
 		}
-		summary := strings.ReplaceAll(alert.Summary, "\n", " ")
-		summary = strings.ReplaceAll(summary, "\r", "")
+		summary := m.alertTitle(&alert)
 
+		// Create styled cells using evertras/bubble-table
 		statusCell := table.NewStyledCell(status, statusStyle(status))
 
 		// Use StartedAt if available, otherwise CreatedAt
@@ -266,12 +422,7 @@ func (m *AlertsModel) updateRowIndicators() {
 		} else if !alert.CreatedAt.IsZero() {
 			timeStr = formatRelativeTime(alert.CreatedAt)
 		}
-		timeCell := table.NewStyledCell(timeStr, styles.TextDim)
-
-		indicator := ""
-		if i == cursor {
-			indicator = alertRowIndicator
-		}
+		timeCell := table.NewStyledCell(timeStr, alertAgeStyle(&alert, time.Now()))
 
 		rows[i] = table.NewRow(table.RowData{
 			alertColKeyIndicator: indicator,
@@ -282,7 +433,190 @@ func (m *AlertsModel) updateRowIndicators() {
 			alertColKeyTitle:     summary,
 		})
 	}
-	m.table = m.table.WithRows(rows)
+	return rows
+}
+
+// Ages after which an unresolved alert's time turns yellow, then red
+const (
+	alertAgeWarn     = 15 * time.Minute
+	alertAgeCritical = 60 * time.Minute
+)
+
+// alertAgeStyle styles an alert's time cell by how long it has been open, so
+// stale unresolved alerts stand out
+func alertAgeStyle(alert *api.Alert, now time.Time) lipgloss.Style {
+	status := strings.ToLower(strings.TrimSpace(alert.Status))
+	if bucket, ok := styles.CustomStatusBucket(status); ok {
+		status = bucket
+	}
+	if alert.CreatedAt.IsZero() || status == "resolved" || status == "fixed" {
+		return styles.TextDim
+	}
+	switch age := now.Sub(alert.CreatedAt); {
+	case age >= alertAgeCritical:
+		return lipgloss.NewStyle().Foreground(styles.ColorPastelRed)
+	case age >= alertAgeWarn:
+		return lipgloss.NewStyle().Foreground(styles.ColorPastelYellow)
+	}
+	return styles.TextDim
+}
+
+// groupHeaderRow renders an incident's (or service's) header row with its alert count
+func (m AlertsModel) groupHeaderRow(g api.AlertGroup, indicator string) table.Row {
+	if m.groupByService {
+		return m.serviceHeaderRow(g, indicator)
+	}
+	id := g.IncidentRef()
+	title := g.IncidentTitle
+	if g.IncidentID == "" {
+		id = ""
+		title = i18n.T("alerts.group.no_incident")
+	} else if title == "" {
+		title = id
+	}
+	title = i18n.Tf("alerts.group.header", map[string]any{"Title": title, "Count": len(g.Alerts)})
+	return table.NewRow(table.RowData{
+		alertColKeyIndicator: indicator,
+		alertColKeySource:    "",
+		alertColKeyID:        table.NewStyledCell(id, styles.Primary),
+		alertColKeyStatus:    "",
+		alertColKeyTime:      "",
+		alertColKeyTitle:     table.NewStyledCell(title, styles.TextBold),
+	})
+}
+
+// serviceHeaderRow renders a service's header row with its alert count, marked
+// ▸ when collapsed and ▾ when expanded
+func (m AlertsModel) serviceHeaderRow(g api.AlertGroup, indicator string) table.Row {
+	title := g.Service
+	if title == "" {
+		title = i18n.T("alerts.group.ungrouped")
+	}
+	marker := "▾"
+	if m.collapsed[g.Service] {
+		marker = "▸"
+	}
+	title = i18n.Tf("alerts.group.header", map[string]any{"Title": title, "Count": len(g.Alerts)})
+	return table.NewRow(table.RowData{
+		alertColKeyIndicator: indicator,
+		alertColKeySource:    "",
+		alertColKeyID:        table.NewStyledCell(marker, styles.Primary),
+		alertColKeyStatus:    "",
+		alertColKeyTime:      "",
+		alertColKeyTitle:     table.NewStyledCell(title, styles.Primary.Bold(true)),
+	})
+}
+
+// selectableRow returns whether the cursor may rest on row: any alert, any
+// incident header, and only collapsed service headers
+func (m AlertsModel) selectableRow(row int) bool {
+	r := m.rows[row]
+	return r.alert >= 0 || !m.groupByService || m.collapsed[r.group.Service]
+}
+
+// nextSelectableRow returns the first selectable row from row on, stepping by
+// step (1 or -1), or -1 when there is none
+func (m AlertsModel) nextSelectableRow(row, step int) int {
+	for ; row >= 0 && row < len(m.rows); row += step {
+		if m.selectableRow(row) {
+			return row
+		}
+	}
+	return -1
+}
+
+// regroup rebuilds the rows after a grouping change, keeping the selected
+// alert selected, or else the row at fallback
+func (m *AlertsModel) regroup(fallback int) {
+	selected := m.SelectedIndex()
+	m.buildRows()
+	cursor := -1
+	for i, row := range m.rows {
+		if row.alert == selected && selected >= 0 {
+			cursor = i
+			break
+		}
+	}
+	if cursor < 0 {
+		cursor = m.nextSelectableRow(min(max(fallback, 0), len(m.rows)-1), 1)
+	}
+	m.table = m.table.WithRows(m.tableRows(max(cursor, 0))).WithHighlightedRow(max(cursor, 0))
+	m.updateViewportContent()
+}
+
+// ToggleGroupByIncident switches between the flat list and grouping alerts under
+// their incidents, keeping the selected alert selected. Returns the new state.
+func (m *AlertsModel) ToggleGroupByIncident() bool {
+	m.groupByIncident = !m.groupByIncident
+	m.groupByService = false
+	m.regroup(0)
+	return m.groupByIncident
+}
+
+// ToggleGroupByService switches between the flat list and grouping alerts under
+// their first service, keeping the selected alert selected. Returns the new state.
+func (m *AlertsModel) ToggleGroupByService() bool {
+	m.groupByService = !m.groupByService
+	m.groupByIncident = false
+	m.regroup(0)
+	return m.groupByService
+}
+
+// IsGroupedByService returns whether alerts are grouped under their services
+func (m AlertsModel) IsGroupedByService() bool {
+	return m.groupByService
+}
+
+// ToggleGroupCollapsed collapses the service group of the selected row,
+// moving the cursor to its header, or expands it when it is collapsed. Returns
+// false when alerts aren't grouped by service.
+func (m *AlertsModel) ToggleGroupCollapsed() bool {
+	cursor := m.table.GetHighlightedRowIndex()
+	if !m.groupByService || cursor < 0 || cursor >= len(m.rows) {
+		return false
+	}
+	header := cursor
+	for header > 0 && m.rows[header].alert >= 0 {
+		header--
+	}
+	service := m.rows[header].group.Service
+	if m.collapsed == nil {
+		m.collapsed = make(map[string]bool)
+	}
+	m.collapsed[service] = !m.collapsed[service]
+	if m.collapsed[service] {
+		// Select the header, which stands in for the hidden alerts
+		m.table = m.table.WithHighlightedRow(header)
+	} else {
+		header++ // land on the group's first alert
+	}
+	m.regroup(header)
+	return true
+}
+
+// SelectedGroupService returns the service of the selected collapsed group
+// header, and whether one is selected
+func (m AlertsModel) SelectedGroupService() (string, bool) {
+	cursor := m.table.GetHighlightedRowIndex()
+	if !m.groupByService || cursor < 0 || cursor >= len(m.rows) || m.rows[cursor].alert >= 0 {
+		return "", false
+	}
+	return m.rows[cursor].group.Service, true
+}
+
+// IsGroupedByIncident returns whether alerts are grouped under their incidents
+func (m AlertsModel) IsGroupedByIncident() bool {
+	return m.groupByIncident
+}
+
+// SelectedGroupIncident returns the incident reference of the selected group
+// header, or "" when an alert or the "No incident" header is selected
+func (m AlertsModel) SelectedGroupIncident() string {
+	cursor := m.table.GetHighlightedRowIndex()
+	if cursor < 0 || cursor >= len(m.rows) || m.rows[cursor].alert >= 0 {
+		return ""
+	}
+	return m.rows[cursor].group.IncidentRef()
 }
 
 // SetDetailFocused sets focus on the detail pane for scrolling
@@ -305,9 +639,11 @@ func (m *AlertsModel) updateDimensions() {
 		m.layout = config.LayoutHorizontal
 	}
 
+	m.narrow = isNarrow(m.width)
+
 	var tableWidth, tableHeight, viewportWidth, viewportHeight int
 
-	if m.layout == config.LayoutVertical {
+	if m.stacked() {
 		// Vertical layout: use full height, minimal overhead
 		// App already subtracts 10 for header/help bar, so we only need minimal adjustment
 		totalContentHeight := m.height - 2 // Just account for spacing between panes
@@ -354,7 +690,16 @@ func (m *AlertsModel) updateDimensions() {
 		viewportHeight = totalContentHeight - 4
 	}
 
-	// Ensure minimum dimensions
+	// Ensure minimum dimensions (tiny terminals can drive these to zero or below)
+	if m.listWidth < 1 {
+		m.listWidth = 1
+	}
+	if m.detailWidth < 1 {
+		m.detailWidth = 1
+	}
+	if tableWidth < 10 {
+		tableWidth = 10
+	}
 	if viewportHeight < 1 {
 		viewportHeight = 1
 	}
@@ -364,12 +709,13 @@ func (m *AlertsModel) updateDimensions() {
 
 	// Calculate page size based on available table height
 	// Account for header row (1 line) and some padding
-	pageSize := tableHeight - 2
-	if pageSize < 3 {
-		pageSize = 3
+	m.fitRows = tableHeight - 2
+	if m.fitRows < 3 {
+		m.fitRows = 3
 	}
-	if pageSize > 25 {
-		pageSize = 25 // Cap at API page size
+	pageSize := m.fitRows
+	if pageSize > m.apiPageSize() {
+		pageSize = m.apiPageSize() // Cap at API page size
 	}
 
 	// Update table dimensions and page size
@@ -387,6 +733,12 @@ func (m *AlertsModel) updateDimensions() {
 
 func (m *AlertsModel) SetAlerts(alerts []api.Alert, pagination api.PaginationInfo) {
 	m.alerts = alerts
+	if field := m.sortField(); field != AlertSortByNone {
+		m.alerts = make([]api.Alert, len(alerts))
+		copy(m.alerts, alerts)
+		sortAlerts(m.alerts, field, m.sortState.Direction)
+	}
+	m.flapping = api.DetectFlapping(alerts)
 	m.loading = false
 	m.error = ""
 	m.currentPage = pagination.CurrentPage
@@ -396,47 +748,9 @@ func (m *AlertsModel) SetAlerts(alerts []api.Alert, pagination api.PaginationInf
 	m.hasPrev = pagination.HasPrev
 
 	// Build table rows from alerts with styled cells
-	rows := make([]table.Row, len(alerts))
+	m.buildRows()
 	cursor := m.table.GetHighlightedRowIndex()
-	for i, alert := range alerts {
-		shortID := alert.ShortID
-		if shortID == "" {
-			shortID = "---"
-		}
-		status := alert.Status
-		if len(status) > 10 {
-			status = status[:10]
-		}
-		summary := strings.ReplaceAll(alert.Summary, "\n", " ")
-		summary = strings.ReplaceAll(summary, "\r", "")
-
-		// Create styled cells using evertras/bubble-table
-		statusCell := table.NewStyledCell(status, statusStyle(status))
-
-		// Use StartedAt if available, otherwise CreatedAt
-		timeStr := "-"
-		if alert.StartedAt != nil {
-			timeStr = formatRelativeTime(*alert.StartedAt)
-		} else if !alert.CreatedAt.IsZero() {
-			timeStr = formatRelativeTime(alert.CreatedAt)
-		}
-		timeCell := table.NewStyledCell(timeStr, styles.TextDim)
-
-		// Show indicator for highlighted row
-		indicator := ""
-		if i == cursor {
-			indicator = alertRowIndicator
-		}
-
-		rows[i] = table.NewRow(table.RowData{
-			alertColKeyIndicator: indicator,
-			alertColKeySource:    styles.AlertSourceIcon(alert.Source),
-			alertColKeyID:        shortID,
-			alertColKeyStatus:    statusCell,
-			alertColKeyTime:      timeCell,
-			alertColKeyTitle:     summary,
-		})
-	}
+	rows := m.tableRows(cursor)
 	m.table = m.table.WithRows(rows)
 
 	// Set custom footer with pagination info
@@ -444,8 +758,19 @@ func (m *AlertsModel) SetAlerts(alerts []api.Alert, pagination api.PaginationInf
 	m.table = m.table.WithStaticFooter(footer)
 
 	// Adjust cursor if needed
-	if cursor >= len(alerts) && len(alerts) > 0 {
-		m.table = m.table.WithHighlightedRow(len(alerts) - 1)
+	if cursor >= len(m.rows) && len(m.rows) > 0 {
+		cursor = len(m.rows) - 1
+		m.table = m.table.WithHighlightedRow(cursor)
+	}
+	// Keep the cursor off expanded service headers
+	if cursor >= 0 && cursor < len(m.rows) && !m.selectableRow(cursor) {
+		next := m.nextSelectableRow(cursor, 1)
+		if next < 0 {
+			next = m.nextSelectableRow(cursor, -1)
+		}
+		if next >= 0 {
+			m.table = m.table.WithRows(m.tableRows(next)).WithHighlightedRow(next)
+		}
 	}
 	m.updateViewportContent()
 }
@@ -474,6 +799,25 @@ func (m *AlertsModel) SetError(err string) {
 	m.loading = false
 }
 
+// FitPageSize returns how many rows fit in the table at the current height
+func (m AlertsModel) FitPageSize() int {
+	return m.fitRows
+}
+
+// SetAPIPageSize sets the page size used by list requests so the table never pages within it
+func (m *AlertsModel) SetAPIPageSize(size int) {
+	m.pageSize = size
+	m.updateDimensions()
+}
+
+// apiPageSize returns the page size used by list requests
+func (m AlertsModel) apiPageSize() int {
+	if m.pageSize > 0 {
+		return m.pageSize
+	}
+	return api.DefaultPageSize
+}
+
 func (m *AlertsModel) SetDimensions(width, height int) {
 	m.width = width
 	m.height = height
@@ -522,22 +866,58 @@ func (m *AlertsModel) PrevPage() {
 	}
 }
 
+// SetPage moves to a page (at least 1) for the caller to load
+func (m *AlertsModel) SetPage(page int) {
+	m.currentPage = max(page, 1)
+	m.table = m.table.WithHighlightedRow(0)
+}
+
 func (m AlertsModel) SelectedAlert() *api.Alert {
-	cursor := m.table.GetHighlightedRowIndex()
-	if cursor >= 0 && cursor < len(m.alerts) {
-		return &m.alerts[cursor]
+	if i := m.SelectedIndex(); i >= 0 && i < len(m.alerts) {
+		return &m.alerts[i]
 	}
 	return nil
 }
 
+// SelectedIndex returns the index of the selected alert, or -1 when a group header is selected
 func (m AlertsModel) SelectedIndex() int {
-	return m.table.GetHighlightedRowIndex()
+	cursor := m.table.GetHighlightedRowIndex()
+	if cursor >= 0 && cursor < len(m.rows) {
+		return m.rows[cursor].alert
+	}
+	return cursor
+}
+
+// SelectIndex moves the cursor to alert i (as returned by SelectedIndex),
+// clamped to the listed alerts
+func (m *AlertsModel) SelectIndex(i int) {
+	if len(m.alerts) == 0 {
+		return
+	}
+	i = min(max(i, 0), len(m.alerts)-1)
+	for row := range m.rows {
+		if m.rows[row].alert == i {
+			m.table = m.table.WithHighlightedRow(row)
+			m.updateRowIndicators()
+			m.updateViewportContent()
+			return
+		}
+	}
 }
 
 func (m *AlertsModel) SetDetailLoading(id string) {
 	m.detailLoadingID = id
 }
 
+// SetDetailFromCache records whether the detail of the alert with id was served
+// from the cache rather than fetched, marked under the detail
+func (m *AlertsModel) SetDetailFromCache(id string, fromCache bool) {
+	if m.cachedDetails == nil {
+		m.cachedDetails = make(map[string]bool)
+	}
+	m.cachedDetails[id] = fromCache
+}
+
 func (m *AlertsModel) ClearDetailLoading() {
 	m.detailLoadingID = ""
 }
@@ -555,20 +935,58 @@ func (m *AlertsModel) UpdateAlertDetail(index int, alert *api.Alert) {
 	if index >= 0 && index < len(m.alerts) && alert != nil {
 		m.alerts[index] = *alert
 		// Update viewport content without resetting scroll (detail just loaded)
-		if m.detailViewportReady && index == m.table.GetHighlightedRowIndex() {
+		if m.detailViewportReady && index == m.SelectedIndex() {
 			content := m.generateDetailContent(alert)
 			m.detailViewport.SetContent(content)
 		}
 	}
 }
 
+// TriggeredAlerts returns the triggered alerts on the current page
+func (m AlertsModel) TriggeredAlerts() []api.Alert {
+	var triggered []api.Alert
+	for _, alert := range m.alerts {
+		if alert.Status == "triggered" {
+			triggered = append(triggered, alert)
+		}
+	}
+	return triggered
+}
+
+// TriggeredCount returns how many alerts on the current page are triggered
+func (m AlertsModel) TriggeredCount() int {
+	return len(m.TriggeredAlerts())
+}
+
+// SetAlertStatus updates the status of a listed alert and refreshes its row
+func (m *AlertsModel) SetAlertStatus(id, status string) {
+	for i := range m.alerts {
+		if m.alerts[i].ID != id {
+			continue
+		}
+		m.alerts[i].Status = status
+		m.SetAlerts(m.alerts, api.PaginationInfo{
+			CurrentPage: m.currentPage,
+			TotalPages:  m.totalPages,
+			TotalCount:  m.totalCount,
+			HasNext:     m.hasNext,
+			HasPrev:     m.hasPrev,
+		})
+		return
+	}
+}
+
 func (m AlertsModel) View() string {
+	if windowTooSmall(m.width, m.height) {
+		return renderWindowTooSmall()
+	}
+
 	if m.loading {
 		// Show loading within the layout structure to prevent jarring shift
 		loadingMsg := fmt.Sprintf("%s %s", m.spinnerView, i18n.Tf("incidents.loading_page", map[string]any{"Page": m.currentPage}))
 		listContent := styles.TextBold.Render(i18n.T("alerts.title")) + "\n\n" + styles.TextDim.Render(loadingMsg)
 		listView := styles.ListContainer.Width(m.listWidth).Height(m.listHeight).Render(listContent)
-		detailView := styles.DetailContainer.Width(m.detailWidth).Height(m.detailHeight).Render("")
+		detailView := styles.DetailContainer.Width(m.detailWidth).Height(m.detailHeight).Render(renderDetailSkeleton(m.detailWidth))
 		return m.joinPanes(listView, detailView)
 	}
 
@@ -586,9 +1004,15 @@ func (m AlertsModel) View() string {
 	return m.joinPanes(listView, detailView)
 }
 
+// stacked reports whether the list sits above the detail pane: with the
+// vertical layout, or on a narrow terminal where side by side doesn't fit
+func (m AlertsModel) stacked() bool {
+	return m.layout == config.LayoutVertical || m.narrow
+}
+
 // joinPanes joins the list and detail panes based on the current layout
 func (m AlertsModel) joinPanes(listView, detailView string) string {
-	if m.layout == config.LayoutVertical {
+	if m.stacked() {
 		return lipgloss.JoinVertical(lipgloss.Left, listView, detailView)
 	}
 	return lipgloss.JoinHorizontal(lipgloss.Top, listView, "  ", detailView)
@@ -619,8 +1043,13 @@ func (m AlertsModel) renderList(height int) string {
 	}
 
 	// Item count
-	if len(m.alerts) > 0 {
-		footer.WriteString(styles.TextDim.Render(fmt.Sprintf("  (%d-%d)", m.table.GetHighlightedRowIndex()+1, len(m.alerts))))
+	if len(m.rows) > 0 {
+		footer.WriteString(styles.TextDim.Render(fmt.Sprintf("  (%d-%d)", m.table.GetHighlightedRowIndex()+1, len(m.rows))))
+	}
+
+	// Sort indicator
+	if sortInfo := m.GetSortInfo(); sortInfo != "" {
+		footer.WriteString(styles.TextDim.Render("  " + sortInfo))
 	}
 
 	b.WriteString(footer.String())
@@ -632,8 +1061,17 @@ func (m AlertsModel) renderList(height int) string {
 func (m AlertsModel) renderDetail(height int) string {
 	alert := m.SelectedAlert()
 	if alert == nil {
+		prompt := i18n.T("alerts.select_prompt")
+		if ref := m.SelectedGroupIncident(); ref != "" {
+			prompt = i18n.Tf("alerts.group.open_hint", map[string]any{"ID": ref})
+		} else if service, ok := m.SelectedGroupService(); ok {
+			if service == "" {
+				service = i18n.T("alerts.group.ungrouped")
+			}
+			prompt = i18n.Tf("alerts.group.expand_hint", map[string]any{"Title": service})
+		}
 		return styles.DetailContainer.Width(m.detailWidth).Height(height).Render(
-			styles.TextDim.Render(i18n.T("alerts.select_prompt")),
+			styles.TextDim.Render(prompt),
 		)
 	}
 
@@ -672,9 +1110,8 @@ func (m AlertsModel) renderDetail(height int) string {
 func (m AlertsModel) generateDetailContent(alert *api.Alert) string {
 	var b strings.Builder
 
-	// Title line: [SHORT_ID] Summary (strip newlines for single-line display)
-	summaryClean := strings.ReplaceAll(alert.Summary, "\n", " ")
-	summaryClean = strings.ReplaceAll(summaryClean, "\r", "")
+	// Title line: [SHORT_ID] Summary (single line, with fallbacks for a missing summary)
+	summaryClean := alert.DisplayTitle()
 	if alert.ShortID != "" {
 		b.WriteString(styles.Primary.Bold(true).Render("[" + alert.ShortID + "]"))
 		b.WriteString(" ")
@@ -693,13 +1130,17 @@ func (m AlertsModel) generateDetailContent(alert *api.Alert) string {
 		relTime := formatRelativeTime(alert.CreatedAt)
 		fmt.Fprintf(&b, "  Triggered %s", relTime)
 	}
+	if n, ok := m.flapping[alert.ID]; ok {
+		b.WriteString("\n")
+		b.WriteString(styles.Warning.Render(flappingIndicator + " " + i18n.Tf("alerts.flapping", map[string]any{
+			"Count":   n,
+			"Minutes": int(api.FlapWindow.Minutes()),
+		})))
+	}
 	b.WriteString("\n\n")
 
 	// Links section (high up for quick access)
-	rootlyURL := alert.URL // Use URL from API if available
-	if rootlyURL == "" && alert.ShortID != "" {
-		rootlyURL = fmt.Sprintf("https://rootly.com/account/alerts/%s", alert.ShortID)
-	}
+	rootlyURL := api.ResolveAlertURL(alert)
 	if rootlyURL != "" || alert.ExternalURL != "" {
 		b.WriteString(styles.TextBold.Render("🔗 " + i18n.T("alerts.detail.links")))
 		b.WriteString("\n")
@@ -721,7 +1162,7 @@ func (m AlertsModel) generateDetailContent(alert *api.Alert) string {
 		if descWidth < 40 {
 			descWidth = 40
 		}
-		b.WriteString(styles.RenderMarkdown(alert.Description, descWidth))
+		b.WriteString(renderMarkdown(alert.Description, descWidth, m.presentMode))
 		b.WriteString("\n\n")
 	}
 
@@ -730,19 +1171,19 @@ func (m AlertsModel) generateDetailContent(alert *api.Alert) string {
 	b.WriteString("\n")
 
 	if !alert.CreatedAt.IsZero() {
-		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.created"), formatAlertTime(alert.CreatedAt)))
+		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.created"), m.formatDetailTime(alert.CreatedAt)))
 	}
 	if alert.StartedAt != nil {
-		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.started"), formatAlertTime(*alert.StartedAt)))
+		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.started"), m.formatDetailTime(*alert.StartedAt)))
 	}
 	if alert.EndedAt != nil {
-		b.WriteString(m.renderDetailRow(i18n.T("alerts.detail.ended"), formatAlertTime(*alert.EndedAt)))
+		b.WriteString(m.renderDetailRow(i18n.T("alerts.detail.ended"), m.formatDetailTime(*alert.EndedAt)))
 	}
 	b.WriteString("\n")
 
 	// Services, Environments, Teams
 	b.WriteString(renderAlertBulletList("🛠 ", i18n.T("incidents.detail.services"), alert.Services))
-	b.WriteString(renderAlertBulletList("🌐 ", i18n.T("incidents.detail.environments"), alert.Environments))
+	b.WriteString(renderEnvironmentList("🌐 ", i18n.T("incidents.detail.environments"), alert.Environments))
 	b.WriteString(renderAlertBulletList("👥 ", i18n.T("incidents.detail.teams"), alert.Groups))
 
 	// Extended info (populated when DetailLoaded is true)
@@ -752,6 +1193,19 @@ func (m AlertsModel) generateDetailContent(alert *api.Alert) string {
 			b.WriteString(m.renderDetailRow(i18n.T("alerts.detail.urgency"), alert.Urgency))
 		}
 
+		// Escalation
+		if alert.EscalationPolicy != "" || alert.EscalationLevel > 0 {
+			b.WriteString("\n")
+			b.WriteString(styles.TextBold.Render("📶 " + i18n.T("alerts.detail.escalation")))
+			b.WriteString("\n")
+			if alert.EscalationPolicy != "" {
+				b.WriteString(m.renderDetailRow(i18n.T("alerts.detail.escalation_policy"), alert.EscalationPolicy))
+			}
+			if alert.EscalationLevel > 0 {
+				b.WriteString(m.renderDetailRow(i18n.T("alerts.detail.escalation_level"), fmt.Sprintf("%d", alert.EscalationLevel)))
+			}
+		}
+
 		// Responders
 		if len(alert.Responders) > 0 {
 			b.WriteString("\n")
@@ -762,6 +1216,16 @@ func (m AlertsModel) generateDetailContent(alert *api.Alert) string {
 			}
 		}
 
+		// On call
+		if len(alert.OnCall) > 0 {
+			b.WriteString("\n")
+			b.WriteString(styles.TextBold.Render("📟 " + i18n.T("alerts.detail.on_call")))
+			b.WriteString("\n")
+			for _, user := range alert.OnCall {
+				b.WriteString(styles.Text.Render("• " + user + "\n"))
+			}
+		}
+
 		// Notified users
 		if len(alert.NotifiedUsers) > 0 {
 			b.WriteString("\n")
@@ -769,7 +1233,7 @@ func (m AlertsModel) generateDetailContent(alert *api.Alert) string {
 			b.WriteString("\n")
 			for _, user := range alert.NotifiedUsers {
 				b.WriteString(styles.Text.Render("• "))
-				b.WriteString(styles.RenderNameWithEmail(user.Name, user.Email))
+				b.WriteString(renderNameWithEmail(user.Name, user.Email, m.presentMode))
 				b.WriteString("\n")
 			}
 		}
@@ -825,10 +1289,22 @@ func (m AlertsModel) generateDetailContent(alert *api.Alert) string {
 			keys = append(keys, k)
 		}
 		sort.Strings(keys)
+		expanded := m.labelsExpandedID == alert.ID
+		truncated := false
 		for _, k := range keys {
+			value := alert.Labels[k]
+			// URLs are shortened for display by renderLabelValue and must stay openable
+			if !expanded && m.maxLabelLen > 0 && !isURL(value) && utf8.RuneCountInString(value) > m.maxLabelLen {
+				value = string([]rune(value)[:m.maxLabelLen]) + "…"
+				truncated = true
+			}
 			b.WriteString(styles.DetailLabel.Render(k + ":"))
 			b.WriteString(" ")
-			b.WriteString(m.renderLabelValue(alert.Labels[k]))
+			b.WriteString(m.renderLabelValue(value))
+			b.WriteString("\n")
+		}
+		if truncated {
+			b.WriteString(styles.TextDim.Render(i18n.T("alerts.detail.labels_truncated")))
 			b.WriteString("\n")
 		}
 	}
@@ -852,6 +1328,9 @@ func (m AlertsModel) generateDetailContent(alert *api.Alert) string {
 	} else if !alert.DetailLoaded {
 		b.WriteString("\n")
 		b.WriteString(styles.TextDim.Render(i18n.T("incidents.press_enter")))
+	} else if m.cachedDetails[alert.ID] {
+		b.WriteString("\n")
+		b.WriteString(renderCachedMarker())
 	}
 
 	return b.String()
@@ -874,7 +1353,7 @@ func (m AlertsModel) renderLinkRow(label, url string) string {
 		displayURL = displayURL[:maxURLLen-3] + "..."
 	}
 
-	return styles.DetailLabel.Render(label+":") + " " + styles.RenderLink(url, displayURL) + "\n"
+	return styles.DetailLabel.Render(label+":") + " " + renderLink(url, displayURL, m.presentMode) + "\n"
 }
 
 // isURL checks if a string looks like a URL
@@ -894,7 +1373,7 @@ func (m AlertsModel) renderLabelValue(value string) string {
 		if len(displayURL) > maxLen {
 			displayURL = displayURL[:maxLen-3] + "..."
 		}
-		return styles.RenderLink(value, displayURL)
+		return renderLink(value, displayURL, m.presentMode)
 	}
 	return styles.DetailValue.Render(value)
 }
@@ -927,8 +1406,7 @@ func (m AlertsModel) generatePlainTextDetail(alert *api.Alert) string {
 	var b strings.Builder
 
 	// Title line
-	summaryClean := strings.ReplaceAll(alert.Summary, "\n", " ")
-	summaryClean = strings.ReplaceAll(summaryClean, "\r", "")
+	summaryClean := alert.DisplayTitle()
 	if alert.ShortID != "" {
 		b.WriteString("[" + alert.ShortID + "] ")
 	}
@@ -944,10 +1422,7 @@ func (m AlertsModel) generatePlainTextDetail(alert *api.Alert) string {
 	b.WriteString("\n\n")
 
 	// Links
-	rootlyURL := alert.URL
-	if rootlyURL == "" && alert.ShortID != "" {
-		rootlyURL = fmt.Sprintf("https://rootly.com/account/alerts/%s", alert.ShortID)
-	}
+	rootlyURL := api.ResolveAlertURL(alert)
 	if rootlyURL != "" || alert.ExternalURL != "" {
 		b.WriteString("Links\n")
 		if rootlyURL != "" {
@@ -995,6 +1470,9 @@ func (m AlertsModel) generatePlainTextDetail(alert *api.Alert) string {
 		if len(alert.Responders) > 0 {
 			b.WriteString("\nResponders: " + strings.Join(alert.Responders, ", ") + "\n")
 		}
+		if len(alert.OnCall) > 0 {
+			b.WriteString("On Call: " + strings.Join(alert.OnCall, ", ") + "\n")
+		}
 
 		if len(alert.Labels) > 0 {
 			b.WriteString("\nLabels\n")
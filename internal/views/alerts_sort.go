@@ -0,0 +1,257 @@
+package views
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/api"
+	"github.com/rootlyhq/rootly-tui/internal/components"
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+)
+
+// AlertSortField is a field the loaded alerts page can be sorted by (client-side)
+type AlertSortField int
+
+const (
+	AlertSortByNone AlertSortField = iota
+	AlertSortByCreated
+	AlertSortByStarted
+	AlertSortByEnded
+	AlertSortBySource
+	AlertSortByStatus
+)
+
+// alertSortNames are the names used to persist the alerts sort in config
+var alertSortNames = map[AlertSortField]string{
+	AlertSortByCreated: "created",
+	AlertSortByStarted: "started",
+	AlertSortByEnded:   "ended",
+	AlertSortBySource:  "source",
+	AlertSortByStatus:  "status",
+}
+
+// alertSortOptions builds the alerts sort menu entries
+func alertSortOptions() []components.SortOption {
+	alphabetical := func(label, desc string, field AlertSortField) components.SortOption {
+		return components.SortOption{
+			Label:       label,
+			Description: desc,
+			Value:       field,
+			DescLabel:   i18n.T("sorting.z_to_a"),
+			AscLabel:    i18n.T("sorting.a_to_z"),
+		}
+	}
+	return []components.SortOption{
+		{Label: i18n.T("sorting.created"), Description: i18n.T("sorting.desc.alert_created"), Value: AlertSortByCreated},
+		{Label: i18n.T("sorting.started"), Description: i18n.T("sorting.desc.started"), Value: AlertSortByStarted},
+		{Label: i18n.T("sorting.ended"), Description: i18n.T("sorting.desc.ended"), Value: AlertSortByEnded},
+		alphabetical(i18n.T("alerts.detail.source"), i18n.T("sorting.desc.source"), AlertSortBySource),
+		alphabetical(i18n.T("incidents.detail.status"), i18n.T("sorting.desc.status"), AlertSortByStatus),
+	}
+}
+
+// alertSortTime returns the timestamp an alert is sorted on for field, or nil
+// when the alert doesn't have it (or field is not a time field)
+func alertSortTime(a *api.Alert, field AlertSortField) *time.Time {
+	switch field {
+	case AlertSortByCreated:
+		if a.CreatedAt.IsZero() {
+			return nil
+		}
+		return &a.CreatedAt
+	case AlertSortByStarted:
+		return a.StartedAt
+	case AlertSortByEnded:
+		return a.EndedAt
+	}
+	return nil
+}
+
+// alertSortMissing reports whether a lacks the value sorted on; such alerts go last
+func alertSortMissing(a *api.Alert, field AlertSortField) bool {
+	switch field {
+	case AlertSortByCreated, AlertSortByStarted, AlertSortByEnded:
+		t := alertSortTime(a, field)
+		return t == nil || t.IsZero()
+	case AlertSortBySource:
+		return strings.TrimSpace(a.Source) == ""
+	case AlertSortByStatus:
+		return strings.TrimSpace(a.Status) == ""
+	}
+	return false
+}
+
+// compareAlerts compares a and b by field in ascending order (-1, 0 or 1).
+// Both must have the value; see alertSortMissing.
+func compareAlerts(a, b *api.Alert, field AlertSortField) int {
+	switch field {
+	case AlertSortBySource:
+		return strings.Compare(strings.ToLower(a.Source), strings.ToLower(b.Source))
+	case AlertSortByStatus:
+		return strings.Compare(strings.ToLower(a.Status), strings.ToLower(b.Status))
+	}
+	ta, tb := alertSortTime(a, field), alertSortTime(b, field)
+	if ta == nil || tb == nil {
+		return 0
+	}
+	return ta.Compare(*tb)
+}
+
+// sortAlerts orders alerts in place by field and direction. Alerts missing the
+// value come last in either direction; ties fall back to newest first, then ID.
+func sortAlerts(alerts []api.Alert, field AlertSortField, direction components.SortDirection) {
+	if field == AlertSortByNone {
+		return
+	}
+	sort.SliceStable(alerts, func(i, j int) bool {
+		a, b := &alerts[i], &alerts[j]
+		if ma, mb := alertSortMissing(a, field), alertSortMissing(b, field); ma != mb {
+			return mb
+		}
+		if c := compareAlerts(a, b, field); c != 0 {
+			if direction == components.SortDesc {
+				return c > 0
+			}
+			return c < 0
+		}
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.ID < b.ID
+	})
+}
+
+// SortBy re-sorts the loaded alerts by field and direction, keeping the
+// selected alert selected. AlertSortByNone keeps the current order.
+func (m *AlertsModel) SortBy(field AlertSortField, direction components.SortDirection) {
+	if field == AlertSortByNone {
+		return
+	}
+	m.sortState.Set(field, direction)
+	var selectedID string
+	if alert := m.SelectedAlert(); alert != nil {
+		selectedID = alert.ID
+	}
+	sortAlerts(m.alerts, field, direction)
+	m.buildRows()
+	cursor := max(m.nextSelectableRow(0, 1), 0)
+	for i, row := range m.rows {
+		if row.alert >= 0 && m.alerts[row.alert].ID == selectedID {
+			cursor = i
+			break
+		}
+	}
+	m.table = m.table.WithRows(m.tableRows(cursor)).WithHighlightedRow(cursor)
+	m.updateViewportContent()
+}
+
+// sortField returns the active sort field, or AlertSortByNone
+func (m AlertsModel) sortField() AlertSortField {
+	if field, ok := m.sortState.Field.(AlertSortField); ok && m.sortState.IsEnabled() {
+		return field
+	}
+	return AlertSortByNone
+}
+
+// SortConfig returns the active sort as stored in config ("started", "-created", ...),
+// or "" when the alerts are unsorted
+func (m AlertsModel) SortConfig() string {
+	name, ok := alertSortNames[m.sortField()]
+	if !ok {
+		return ""
+	}
+	if m.sortState.Direction == components.SortDesc {
+		return "-" + name
+	}
+	return name
+}
+
+// SetSortConfig restores a sort saved with SortConfig; "" goes back to the API
+// order (newest first) and unknown values are ignored
+func (m *AlertsModel) SetSortConfig(value string) {
+	if value == "" {
+		if m.sortField() != AlertSortByNone {
+			m.SortBy(AlertSortByCreated, components.SortDesc)
+			m.sortState.Reset()
+		}
+		return
+	}
+	name := strings.TrimPrefix(value, "-")
+	direction := components.SortAsc
+	if strings.HasPrefix(value, "-") {
+		direction = components.SortDesc
+	}
+	for field, n := range alertSortNames {
+		if n == name {
+			m.SortBy(field, direction)
+			return
+		}
+	}
+}
+
+// GetSortInfo returns a string describing the current sort
+func (m AlertsModel) GetSortInfo() string {
+	var fieldName string
+	alphabetical := false
+	switch m.sortField() {
+	case AlertSortByCreated:
+		fieldName = i18n.T("sorting.created")
+	case AlertSortByStarted:
+		fieldName = i18n.T("sorting.started")
+	case AlertSortByEnded:
+		fieldName = i18n.T("sorting.ended")
+	case AlertSortBySource:
+		fieldName, alphabetical = i18n.T("alerts.detail.source"), true
+	case AlertSortByStatus:
+		fieldName, alphabetical = i18n.T("incidents.detail.status"), true
+	default:
+		return ""
+	}
+
+	var directionLabel string
+	desc := m.sortState.Direction == components.SortDesc
+	switch {
+	case alphabetical && desc:
+		directionLabel = i18n.T("sorting.z_to_a")
+	case alphabetical:
+		directionLabel = i18n.T("sorting.a_to_z")
+	case desc:
+		directionLabel = i18n.T("sorting.newest_first")
+	default:
+		directionLabel = i18n.T("sorting.oldest_first")
+	}
+	return fmt.Sprintf("%s (%s)", fieldName, directionLabel)
+}
+
+// ToggleSortMenu toggles the visibility of the sort menu
+func (m *AlertsModel) ToggleSortMenu() {
+	m.sortMenu.Toggle()
+}
+
+// IsSortMenuVisible returns whether the sort menu is visible
+func (m AlertsModel) IsSortMenuVisible() bool {
+	return m.sortMenu.IsVisible()
+}
+
+// HandleSortMenuKey handles keyboard input for the sort menu.
+// Returns true if the sort changed (the page is re-sorted in place).
+func (m *AlertsModel) HandleSortMenuKey(key string) bool {
+	selected, shouldApply := m.sortMenu.HandleKey(key)
+	if !shouldApply {
+		return false
+	}
+	field, ok := selected.(AlertSortField)
+	if !ok {
+		return false
+	}
+	m.sortState.Toggle(field)
+	m.SortBy(field, m.sortState.Direction)
+	return true
+}
+
+// RenderSortMenu renders the sort menu overlay
+func (m AlertsModel) RenderSortMenu() string {
+	return m.sortMenu.Render(m.sortState.Field, m.sortState.Direction)
+}
@@ -0,0 +1,123 @@
+package views
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/api"
+	"github.com/rootlyhq/rootly-tui/internal/components"
+)
+
+func alertIDs(alerts []api.Alert) []string {
+	ids := make([]string, len(alerts))
+	for i, a := range alerts {
+		ids[i] = a.ID
+	}
+	return ids
+}
+
+func TestCompareAlerts(t *testing.T) {
+	early := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	late := early.Add(time.Hour)
+	a := &api.Alert{Source: "datadog", Status: "open", CreatedAt: early, StartedAt: &early}
+	b := &api.Alert{Source: "Grafana", Status: "Acknowledged", CreatedAt: late, StartedAt: &late}
+
+	tests := []struct {
+		field AlertSortField
+		want  int
+	}{
+		{AlertSortByCreated, -1},
+		{AlertSortByStarted, -1},
+		{AlertSortBySource, -1},
+		{AlertSortByStatus, 1},
+	}
+	for _, tt := range tests {
+		if got := compareAlerts(a, b, tt.field); got != tt.want {
+			t.Errorf("field %d: expected %d, got %d", tt.field, tt.want, got)
+		}
+		if got := compareAlerts(b, a, tt.field); got != -tt.want {
+			t.Errorf("field %d reversed: expected %d, got %d", tt.field, -tt.want, got)
+		}
+	}
+}
+
+func TestSortAlertsMissingTimesLast(t *testing.T) {
+	base := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := base.Add(d)
+		return &t
+	}
+	alerts := func() []api.Alert {
+		return []api.Alert{
+			{ID: "open", CreatedAt: base, StartedAt: at(time.Hour)},
+			{ID: "nil", CreatedAt: base.Add(time.Minute)},
+			{ID: "ended", CreatedAt: base, StartedAt: at(0), EndedAt: at(2 * time.Hour)},
+			{ID: "zero", CreatedAt: base, StartedAt: &time.Time{}},
+		}
+	}
+
+	tests := []struct {
+		field     AlertSortField
+		direction components.SortDirection
+		want      []string
+	}{
+		{AlertSortByStarted, components.SortAsc, []string{"ended", "open", "nil", "zero"}},
+		{AlertSortByStarted, components.SortDesc, []string{"open", "ended", "nil", "zero"}},
+		{AlertSortByEnded, components.SortAsc, []string{"ended", "nil", "open", "zero"}},
+		{AlertSortByEnded, components.SortDesc, []string{"ended", "nil", "open", "zero"}},
+	}
+	for _, tt := range tests {
+		got := alerts()
+		sortAlerts(got, tt.field, tt.direction)
+		ids := alertIDs(got)
+		for i := range tt.want {
+			if ids[i] != tt.want[i] {
+				t.Errorf("field %d direction %v: expected %v, got %v", tt.field, tt.direction, tt.want, ids)
+				break
+			}
+		}
+	}
+}
+
+func TestAlertsModelSortBy(t *testing.T) {
+	base := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	m := NewAlertsModel()
+	m.SetAlerts([]api.Alert{
+		{ID: "b", Source: "grafana", CreatedAt: base},
+		{ID: "a", Source: "datadog", CreatedAt: base.Add(time.Minute)},
+		{ID: "c", CreatedAt: base.Add(2 * time.Minute)},
+	}, api.PaginationInfo{CurrentPage: 1})
+	m.table = m.table.WithHighlightedRow(1) // "a"
+
+	m.SortBy(AlertSortBySource, components.SortAsc)
+
+	if ids := alertIDs(m.alerts); ids[0] != "a" || ids[1] != "b" || ids[2] != "c" {
+		t.Errorf("expected a, b, c by source with the sourceless alert last, got %v", ids)
+	}
+	if alert := m.SelectedAlert(); alert == nil || alert.ID != "a" {
+		t.Errorf("expected the selected alert to stay selected, got %+v", alert)
+	}
+	if got := m.SortConfig(); got != "source" {
+		t.Errorf("expected sort config %q, got %q", "source", got)
+	}
+	if m.GetSortInfo() == "" {
+		t.Error("expected sort info in the footer")
+	}
+}
+
+func TestAlertsModelSortConfigRoundTrip(t *testing.T) {
+	m := NewAlertsModel()
+	if got := m.SortConfig(); got != "" {
+		t.Errorf("expected no sort by default, got %q", got)
+	}
+
+	m.SetSortConfig("-started")
+	if got := m.SortConfig(); got != "-started" {
+		t.Errorf("expected %q, got %q", "-started", got)
+	}
+
+	m.SetSortConfig("bogus")
+	if got := m.SortConfig(); got != "-started" {
+		t.Errorf("expected unknown values to be ignored, got %q", got)
+	}
+}
@@ -6,8 +6,12 @@ import (
 	"time"
 
 	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/evertras/bubble-table/table"
 
 	"github.com/rootlyhq/rootly-tui/internal/api"
+	"github.com/rootlyhq/rootly-tui/internal/config"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
 )
 
 func TestNewAlertsModel(t *testing.T) {
@@ -42,6 +46,41 @@ func TestAlertsModelSetAlerts(t *testing.T) {
 	}
 }
 
+func TestAlertsModelAgeColors(t *testing.T) {
+	now := time.Now()
+	m := NewAlertsModel()
+	m.SetAlerts([]api.Alert{
+		{ID: "fresh", Status: "triggered", CreatedAt: now.Add(-time.Minute)},
+		{ID: "stale", Status: "triggered", CreatedAt: now.Add(-time.Hour)},
+		{ID: "done", Status: "resolved", CreatedAt: now.Add(-time.Hour)},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	rows := m.tableRows(0)
+	timeStyle := func(i int) lipgloss.Style {
+		t.Helper()
+		cell, ok := rows[i].Data[alertColKeyTime].(table.StyledCell)
+		if !ok {
+			t.Fatalf("expected a styled time cell in row %d", i)
+		}
+		return cell.Style
+	}
+	fresh, stale, done := timeStyle(0), timeStyle(1), timeStyle(2)
+	if fresh.GetForeground() == stale.GetForeground() {
+		t.Error("expected an hour-old triggered alert to render differently from a fresh one")
+	}
+	if stale.GetForeground() != styles.ColorPastelRed {
+		t.Error("expected an hour-old triggered alert to render red")
+	}
+	if done.GetForeground() != fresh.GetForeground() {
+		t.Error("expected a resolved alert to keep the default style regardless of age")
+	}
+
+	warn := alertAgeStyle(&api.Alert{Status: "open", CreatedAt: now.Add(-alertAgeWarn)}, now)
+	if warn.GetForeground() != styles.ColorPastelYellow {
+		t.Error("expected an alert past the warning threshold to render yellow")
+	}
+}
+
 func TestAlertsModelSetLoading(t *testing.T) {
 	m := NewAlertsModel()
 
@@ -139,6 +178,9 @@ func TestAlertsModelView(t *testing.T) {
 	if !strings.Contains(view, "Loading") {
 		t.Error("expected 'Loading' in loading view")
 	}
+	if !strings.Contains(view, "░░░") {
+		t.Error("expected a skeleton in the detail pane while loading")
+	}
 
 	// Test error view
 	m.SetLoading(false)
@@ -662,6 +704,56 @@ func TestAlertsModelViewShowsExtendedDetail(t *testing.T) {
 	}
 }
 
+func TestAlertsModelViewShowsEscalation(t *testing.T) {
+	m := NewAlertsModel()
+	m.SetDimensions(100, 40)
+
+	alerts := []api.Alert{
+		{
+			ID:               "1",
+			ShortID:          "ABC123",
+			Summary:          "Test alert",
+			Status:           "triggered",
+			Source:           "datadog",
+			CreatedAt:        time.Now(),
+			DetailLoaded:     true,
+			EscalationPolicy: "Primary On-call",
+			EscalationLevel:  2,
+			OnCall:           []string{"Jane Smith"},
+		},
+		{
+			ID:           "2",
+			ShortID:      "DEF456",
+			Summary:      "Quiet alert",
+			Status:       "triggered",
+			Source:       "datadog",
+			CreatedAt:    time.Now(),
+			DetailLoaded: true,
+		},
+	}
+	m.SetAlerts(alerts, api.PaginationInfo{CurrentPage: 1})
+
+	view := m.View()
+	if !strings.Contains(view, "Escalation") {
+		t.Error("expected 'Escalation' section in detail view")
+	}
+	if !strings.Contains(view, "Primary On-call") {
+		t.Error("expected escalation policy name in detail view")
+	}
+	if !strings.Contains(view, "Level") {
+		t.Error("expected escalation level in detail view")
+	}
+	if !strings.Contains(view, "On Call") || !strings.Contains(view, "Jane Smith") {
+		t.Error("expected on-call users in detail view")
+	}
+
+	// Alert without escalation info omits the sections
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	if view := m.View(); strings.Contains(view, "Escalation") || strings.Contains(view, "On Call") {
+		t.Error("expected no 'Escalation' or 'On Call' section when alert has no escalation info")
+	}
+}
+
 func TestIsURL(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -747,6 +839,75 @@ func TestAlertsModelRenderLabelValueTruncation(t *testing.T) {
 	}
 }
 
+func TestAlertsModelMarksFlappingAlerts(t *testing.T) {
+	m := NewAlertsModel()
+	m.SetDimensions(160, 40)
+
+	now := time.Now()
+	fired := func(ago time.Duration) *time.Time {
+		ts := now.Add(-ago)
+		return &ts
+	}
+	m.SetAlerts([]api.Alert{
+		{ID: "1", Summary: "CPU high", Source: "datadog", StartedAt: fired(5 * time.Minute), DetailLoaded: true},
+		{ID: "2", Summary: "CPU high", Source: "datadog", StartedAt: fired(20 * time.Minute)},
+		{ID: "3", Summary: "CPU high", Source: "datadog", StartedAt: fired(40 * time.Minute)},
+		{ID: "4", Summary: "Queue backlog", Source: "datadog", StartedAt: fired(10 * time.Minute)},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	if got := m.alertTitle(&m.alerts[0]); got != flappingIndicator+" CPU high" {
+		t.Errorf("expected flapping alert to be flagged in the list, got %q", got)
+	}
+	if got := m.alertTitle(&m.alerts[3]); got != "Queue backlog" {
+		t.Errorf("expected stable alert to be unflagged, got %q", got)
+	}
+	detail := stripANSI(m.generateDetailContent(&m.alerts[0]))
+	if !strings.Contains(detail, flappingIndicator+" Flapping: fired 3 times within 60 minutes") {
+		t.Errorf("expected flapping notice in detail, got %q", detail)
+	}
+}
+
+func TestAlertsModelTruncatesLongLabelValues(t *testing.T) {
+	m := NewAlertsModel()
+	m.SetDimensions(120, 40)
+
+	trace := "panic: " + strings.Repeat("x", 300)
+	m.SetAlerts([]api.Alert{
+		{
+			ID:           "1",
+			Summary:      "Crash loop",
+			Status:       "triggered",
+			DetailLoaded: true,
+			Labels:       map[string]string{"stack": trace, "region": "us-west-2"},
+		},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	content := stripANSI(m.generateDetailContent(m.SelectedAlert()))
+	if strings.Contains(content, trace) {
+		t.Error("expected over-long label value to be truncated")
+	}
+	if !strings.Contains(content, trace[:config.DefaultMaxLabelValueLen]+"…") {
+		t.Error("expected truncated value with an ellipsis")
+	}
+	if !strings.Contains(content, "us-west-2") {
+		t.Error("expected short label value to be shown in full")
+	}
+	if !strings.Contains(m.GetDetailPlainText(), trace) {
+		t.Error("expected copied detail to keep the full label value")
+	}
+
+	if !m.ToggleLabelsExpanded() {
+		t.Fatal("expected expand toggle to apply to the truncated label")
+	}
+	if !strings.Contains(stripANSI(m.generateDetailContent(m.SelectedAlert())), trace) {
+		t.Error("expected expanded label to show the full value")
+	}
+	m.ToggleLabelsExpanded()
+	if strings.Contains(stripANSI(m.generateDetailContent(m.SelectedAlert())), trace) {
+		t.Error("expected toggling again to truncate the label")
+	}
+}
+
 func TestAlertsModelViewShowsClickableLabels(t *testing.T) {
 	m := NewAlertsModel()
 	m.SetDimensions(120, 40)
@@ -918,3 +1079,180 @@ func TestAlertsModelLayoutPageSize(t *testing.T) {
 		t.Error("expected non-zero heights after layout set")
 	}
 }
+
+func TestAlertsModelWindowTooSmall(t *testing.T) {
+	m := NewAlertsModel()
+	m.SetAlerts([]api.Alert{{ID: "1", ShortID: "ABC", Summary: "CPU high"}}, api.PaginationInfo{CurrentPage: 1})
+
+	m.SetDimensions(10, 3)
+	if view := stripANSI(m.View()); view != "Window too small" {
+		t.Errorf("expected window too small notice, got %q", view)
+	}
+
+	// Negative heights (app subtracts chrome from the terminal size) must not panic
+	m.SetDimensions(6, -7)
+	_ = m.View()
+}
+
+func TestAlertsModelMissingSummary(t *testing.T) {
+	m := NewAlertsModel()
+	m.SetDimensions(120, 40)
+	m.SetAlerts([]api.Alert{
+		{ID: "a1", Description: "Replica lag above 30s\nOn db-2"},
+		{ID: "a2", Source: "datadog"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	view := stripANSI(m.View())
+	if !strings.Contains(view, "Replica lag above 30s") {
+		t.Error("expected the description's first line as the title")
+	}
+	if !strings.Contains(view, "(no summary)") {
+		t.Error("expected (no summary) for an alert with neither summary nor description")
+	}
+	if detail := m.GetDetailPlainText(); !strings.HasPrefix(detail, "Replica lag above 30s") {
+		t.Errorf("expected the fallback title in the detail header, got %q", detail)
+	}
+}
+
+func TestAlertsModelGroupByIncident(t *testing.T) {
+	m := NewAlertsModel()
+	m.SetDimensions(160, 40)
+	m.SetAlerts([]api.Alert{
+		{ID: "a1", Summary: "CPU high", IncidentID: "inc_7", IncidentSeqID: "INC-7", IncidentTitle: "API outage"},
+		{ID: "a2", Summary: "Disk full"},
+		{ID: "a3", Summary: "Latency", IncidentID: "inc_7", IncidentSeqID: "INC-7", IncidentTitle: "API outage"},
+		{ID: "a4", Summary: "Queue backlog", IncidentID: "inc_9", IncidentSeqID: "INC-9"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	// Select a2 so the toggle has to follow it to its new row
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	if !m.ToggleGroupByIncident() {
+		t.Fatal("expected grouping to be on")
+	}
+	if sel := m.SelectedAlert(); sel == nil || sel.ID != "a2" {
+		t.Errorf("expected a2 to stay selected after grouping, got %+v", sel)
+	}
+
+	// header INC-7, a1, a3, header INC-9, a4, header No incident, a2
+	want := []struct {
+		alert  string
+		header string
+	}{
+		{header: "INC-7"}, {alert: "a1"}, {alert: "a3"},
+		{header: "INC-9"}, {alert: "a4"},
+		{header: ""}, {alert: "a2"},
+	}
+	if len(m.rows) != len(want) {
+		t.Fatalf("expected %d rows, got %d", len(want), len(m.rows))
+	}
+	for i, w := range want {
+		row := m.rows[i]
+		if w.alert != "" {
+			if row.alert < 0 || m.alerts[row.alert].ID != w.alert {
+				t.Errorf("row %d: expected alert %s, got %+v", i, w.alert, row)
+			}
+			continue
+		}
+		if row.alert >= 0 || row.group.IncidentRef() != w.header {
+			t.Errorf("row %d: expected header for %q, got %+v", i, w.header, row)
+		}
+	}
+
+	view := stripANSI(m.View())
+	for _, s := range []string{"API outage (2)", "INC-9 (1)", "No incident (1)"} {
+		if !strings.Contains(view, s) {
+			t.Errorf("expected group header %q in view", s)
+		}
+	}
+
+	// Headers open their incident; the "No incident" header does not
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'g', Text: "g"})
+	if m.SelectedAlert() != nil || m.SelectedGroupIncident() != "INC-7" {
+		t.Errorf("expected the INC-7 header to be selected, got %q", m.SelectedGroupIncident())
+	}
+	m.table = m.table.WithHighlightedRow(5)
+	if m.SelectedGroupIncident() != "" {
+		t.Errorf("expected no incident for the unattached bucket, got %q", m.SelectedGroupIncident())
+	}
+
+	if m.ToggleGroupByIncident() || len(m.rows) != 4 {
+		t.Errorf("expected the flat list back, got %d rows", len(m.rows))
+	}
+}
+
+func TestAlertsModelGroupByService(t *testing.T) {
+	m := NewAlertsModel()
+	m.SetDimensions(160, 40)
+	m.SetAlerts([]api.Alert{
+		{ID: "a1", Summary: "CPU high", Services: []string{"api"}},
+		{ID: "a2", Summary: "Disk full"},
+		{ID: "a3", Summary: "Latency", Services: []string{"api", "db"}},
+		{ID: "a4", Summary: "Queue backlog", Services: []string{"db"}},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	if m.ToggleGroupCollapsed() {
+		t.Error("expected collapsing to need service grouping")
+	}
+	if !m.ToggleGroupByService() {
+		t.Fatal("expected grouping by service to be on")
+	}
+	if m.IsGroupedByIncident() {
+		t.Error("expected service grouping to replace incident grouping")
+	}
+	if sel := m.SelectedAlert(); sel == nil || sel.ID != "a1" {
+		t.Errorf("expected a1 to stay selected, got %+v", sel)
+	}
+
+	view := stripANSI(m.View())
+	for _, s := range []string{"api (2)", "db (1)", "Ungrouped (1)"} {
+		if !strings.Contains(view, s) {
+			t.Errorf("expected group header %q in view", s)
+		}
+	}
+
+	// Navigation skips headers: a1, a3, a4, a2
+	var visited []string
+	for range 4 {
+		visited = append(visited, m.SelectedAlert().ID)
+		m, _ = m.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	}
+	if strings.Join(visited, ",") != "a1,a3,a4,a2" {
+		t.Errorf("expected j to skip headers, visited %v", visited)
+	}
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'g', Text: "g"})
+	if sel := m.SelectedAlert(); sel == nil || sel.ID != "a1" {
+		t.Errorf("expected g to select the first alert, got %+v", sel)
+	}
+
+	// Collapsing hides the group's alerts and selects its header
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	if !m.ToggleGroupCollapsed() {
+		t.Fatal("expected the api group to collapse")
+	}
+	if service, ok := m.SelectedGroupService(); !ok || service != "api" {
+		t.Errorf("expected the collapsed api header to be selected, got %q", service)
+	}
+	if len(m.rows) != 5 {
+		t.Errorf("expected the api alerts to be hidden, got %d rows", len(m.rows))
+	}
+	if !strings.Contains(stripANSI(m.View()), "Press Z to expand api") {
+		t.Error("expected an expand hint in the detail pane")
+	}
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	if sel := m.SelectedAlert(); sel == nil || sel.ID != "a4" {
+		t.Errorf("expected j to move from the collapsed header to a4, got %+v", sel)
+	}
+
+	// Expanding lands on the group's first alert
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'g', Text: "g"})
+	if !m.ToggleGroupCollapsed() {
+		t.Fatal("expected the api group to expand")
+	}
+	if sel := m.SelectedAlert(); sel == nil || sel.ID != "a1" {
+		t.Errorf("expected a1 to be selected after expanding, got %+v", sel)
+	}
+
+	if m.ToggleGroupByService() || len(m.rows) != 4 {
+		t.Errorf("expected the flat list back, got %d rows", len(m.rows))
+	}
+}
@@ -0,0 +1,12 @@
+package views
+
+import "github.com/rootlyhq/rootly-tui/internal/styles"
+
+// initialsBadge renders a colored initials badge followed by a space, or "" for an empty name
+func initialsBadge(name string) string {
+	badge := styles.RenderInitialsBadge(name)
+	if badge == "" {
+		return ""
+	}
+	return badge + " "
+}
@@ -3,13 +3,28 @@ package views
 import (
 	"fmt"
 	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
+	"github.com/rootlyhq/rootly-tui/internal/debug"
 )
 
+// timeLayout is the layout formatTime and formatAlertTime use for dates
+var timeLayout = config.DefaultTimeLayout
+
+// SetTimeFormat sets the Go layout used for detail pane dates (empty resets
+// to the default); see config.Config.TimeLayout for presets and validation
+func SetTimeFormat(layout string) {
+	if layout == "" {
+		layout = config.DefaultTimeLayout
+	}
+	timeLayout = layout
+}
+
 // formatTime formats a timestamp with local and UTC display
 func formatTime(t time.Time) string {
 	// Convert to local timezone
 	local := t.Local()
-	localStr := local.Format("Jan 2, 2006 15:04 MST")
+	localStr := local.Format(timeLayout)
 
 	// If not UTC, also show UTC equivalent
 	_, offset := local.Zone()
@@ -24,7 +39,7 @@ func formatTime(t time.Time) string {
 func formatAlertTime(t time.Time) string {
 	// Convert to local timezone
 	local := t.Local()
-	localStr := local.Format("Jan 2, 2006 15:04 MST")
+	localStr := local.Format(timeLayout)
 
 	// If not UTC, also show UTC equivalent
 	_, offset := local.Zone()
@@ -35,6 +50,33 @@ func formatAlertTime(t time.Time) string {
 	return localStr
 }
 
+// formatRelative formats a detail pane timestamp relative to now ("just now",
+// "12m ago", "3h ago", "2d ago"); unlike formatRelativeTime it stays in days
+// rather than rounding to weeks or months. Future times, such as a scheduled maintenance window, read "in 3h";
+// anything within a minute either way is "just now".
+func formatRelative(t time.Time, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+	var span string
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		span = fmt.Sprintf("%dm", int(d/time.Minute))
+	case d < 24*time.Hour:
+		span = fmt.Sprintf("%dh", int(d/time.Hour))
+	default:
+		span = fmt.Sprintf("%dd", int(d/(24*time.Hour)))
+	}
+	if future {
+		return "in " + span
+	}
+	return span + " ago"
+}
+
 // formatDuration formats seconds into a human-readable duration string
 func formatDuration(seconds int64) string {
 	if seconds < 60 {
@@ -56,6 +98,61 @@ func formatDuration(seconds int64) string {
 	return fmt.Sprintf("%dh", hours)
 }
 
+// formatSpan formats a duration like formatDuration, but in days and hours
+// past a day ("45s", "1h 23m", "2d 4h"); negative durations show as "0s"
+func formatSpan(d time.Duration) string {
+	seconds := max(int64(d/time.Second), 0)
+	if seconds < 60 {
+		return fmt.Sprintf("%ds", seconds)
+	}
+	if seconds < 3600 {
+		mins := seconds / 60
+		secs := seconds % 60
+		if secs > 0 {
+			return fmt.Sprintf("%dm %ds", mins, secs)
+		}
+		return fmt.Sprintf("%dm", mins)
+	}
+	if seconds < 86400 {
+		hours := seconds / 3600
+		mins := (seconds % 3600) / 60
+		if mins > 0 {
+			return fmt.Sprintf("%dh %dm", hours, mins)
+		}
+		return fmt.Sprintf("%dh", hours)
+	}
+	days := seconds / 86400
+	hours := (seconds % 86400) / 3600
+	if hours > 0 {
+		return fmt.Sprintf("%dd %dh", days, hours)
+	}
+	return fmt.Sprintf("%dd", days)
+}
+
+// FormatAge formats how long ago something happened in whole units ("45s",
+// "2m", "1h 5m"), for short notes such as a cache entry's age
+func FormatAge(d time.Duration) string {
+	if d >= time.Minute {
+		d = d.Truncate(time.Minute)
+	}
+	return formatSpan(d)
+}
+
+// phaseDuration returns the time between two incident timestamps; ok is false
+// when either is missing. Out-of-order timestamps (clock skew, manual edits)
+// are clamped to zero.
+func phaseDuration(phase string, from, to *time.Time) (d time.Duration, ok bool) {
+	if from == nil || to == nil {
+		return 0, false
+	}
+	d = to.Sub(*from)
+	if d < 0 {
+		debug.Logger.Warn("Negative incident duration, showing 0", "phase", phase, "from", *from, "to", *to)
+		return 0, true
+	}
+	return d, true
+}
+
 // formatHours formats hours into a human-readable string
 func formatHours(hours float64) string {
 	if hours < 1 {
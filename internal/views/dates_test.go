@@ -4,6 +4,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/config"
 )
 
 func mustParseTime(s string) time.Time {
@@ -44,6 +46,54 @@ func TestFormatDuration(t *testing.T) {
 	}
 }
 
+func TestFormatSpan(t *testing.T) {
+	tests := []struct {
+		name     string
+		d        time.Duration
+		expected string
+	}{
+		{"zero seconds", 0, "0s"},
+		{"30 seconds", 30 * time.Second, "30s"},
+		{"59 seconds", 59 * time.Second, "59s"},
+		{"1 minute", time.Minute, "1m"},
+		{"1 minute 30 seconds", 90 * time.Second, "1m 30s"},
+		{"5 minutes", 5 * time.Minute, "5m"},
+		{"59 minutes 59 seconds", 3599 * time.Second, "59m 59s"},
+		{"1 hour", time.Hour, "1h"},
+		{"1 hour 30 minutes", 90 * time.Minute, "1h 30m"},
+		{"2 hours", 2 * time.Hour, "2h"},
+		{"1 hour 23 minutes", 83*time.Minute + 10*time.Second, "1h 23m"},
+		{"24 hours", 24 * time.Hour, "1d"},
+		{"1 day 1 hour", 25*time.Hour + 30*time.Minute, "1d 1h"},
+		{"3 days", 72 * time.Hour, "3d"},
+		{"negative", -5 * time.Minute, "0s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := formatSpan(tt.d)
+			if result != tt.expected {
+				t.Errorf("formatSpan(%v) = %q, expected %q", tt.d, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPhaseDuration(t *testing.T) {
+	start := mustParseTime("2025-01-15T10:00:00Z")
+	end := start.Add(83 * time.Minute)
+
+	if _, ok := phaseDuration("test", &start, nil); ok {
+		t.Error("expected a missing endpoint to be skipped")
+	}
+	if d, ok := phaseDuration("test", &start, &end); !ok || d != 83*time.Minute {
+		t.Errorf("expected 83m, got %v (ok=%v)", d, ok)
+	}
+	if d, ok := phaseDuration("test", &end, &start); !ok || d != 0 {
+		t.Errorf("expected out-of-order timestamps to clamp to 0, got %v (ok=%v)", d, ok)
+	}
+}
+
 func TestFormatHours(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -108,6 +158,71 @@ func TestFormatAlertTimeHelper(t *testing.T) {
 	}
 }
 
+func TestFormatTimePresets(t *testing.T) {
+	// Pin the local zone so the output has no UTC suffix
+	prevLocal := time.Local
+	time.Local = time.UTC
+	t.Cleanup(func() {
+		time.Local = prevLocal
+		SetTimeFormat("")
+	})
+
+	testTime := mustParseTime("2025-03-04T17:05:09Z")
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"", "Mar 4, 2025 17:05 UTC"},
+		{"default", "Mar 4, 2025 17:05 UTC"},
+		{"iso8601", "2025-03-04T17:05:09Z"},
+		{"us", "03/04/2025 5:05 PM UTC"},
+		{"eu", "04/03/2025 17:05 UTC"},
+		{"2006.01.02 15:04", "2025.03.04 17:05"},
+		{"not a layout", "Mar 4, 2025 17:05 UTC"},
+	}
+	for _, tt := range tests {
+		SetTimeFormat((&config.Config{TimeFormat: tt.format}).TimeLayout())
+		if got := formatTime(testTime); got != tt.want {
+			t.Errorf("formatTime() with %q = %q, want %q", tt.format, got, tt.want)
+		}
+		if got := formatAlertTime(testTime); got != tt.want {
+			t.Errorf("formatAlertTime() with %q = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestFormatRelative(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		time     time.Time
+		expected string
+	}{
+		{"same instant", now, "just now"},
+		{"59 seconds ago", now.Add(-59 * time.Second), "just now"},
+		{"1 minute ago", now.Add(-time.Minute), "1m ago"},
+		{"12 minutes ago", now.Add(-12 * time.Minute), "12m ago"},
+		{"59 minutes ago", now.Add(-59*time.Minute - 59*time.Second), "59m ago"},
+		{"1 hour ago", now.Add(-time.Hour), "1h ago"},
+		{"3 hours ago", now.Add(-3*time.Hour - 30*time.Minute), "3h ago"},
+		{"1 day ago", now.Add(-24 * time.Hour), "1d ago"},
+		{"45 days ago", now.Add(-45 * 24 * time.Hour), "45d ago"},
+		{"seconds ahead (clock skew)", now.Add(30 * time.Second), "just now"},
+		{"minutes ahead", now.Add(5 * time.Minute), "in 5m"},
+		{"hours ahead", now.Add(3 * time.Hour), "in 3h"},
+		{"days ahead", now.Add(2 * 24 * time.Hour), "in 2d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatRelative(tt.time, now); got != tt.expected {
+				t.Errorf("formatRelative() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestFormatRelativeTime(t *testing.T) {
 	now := time.Now()
 
@@ -45,7 +45,10 @@ func (m HelpModel) View() string {
 	b.WriteString(renderHelpLine("G", i18n.T("help.nav.last")))
 	b.WriteString(renderHelpLine("[", i18n.T("help.nav.prev_page")))
 	b.WriteString(renderHelpLine("]", i18n.T("help.nav.next_page")))
+	b.WriteString(renderHelpLine("{", i18n.T("help.nav.first_page")))
+	b.WriteString(renderHelpLine("}", i18n.T("help.nav.last_page")))
 	b.WriteString(renderHelpLine("Tab", i18n.T("help.nav.switch_tabs")))
+	b.WriteString(renderHelpLine("g… / ,…", i18n.T("help.action.leader")))
 	b.WriteString("\n")
 
 	// Actions section
@@ -54,7 +57,42 @@ func (m HelpModel) View() string {
 	b.WriteString(renderHelpLine("r", i18n.T("help.action.refresh")))
 	b.WriteString(renderHelpLine("Enter", i18n.T("help.action.details")))
 	b.WriteString(renderHelpLine("o", i18n.T("help.action.open_url")))
-	b.WriteString(renderHelpLine("y", i18n.T("help.action.copy")))
+	b.WriteString(renderHelpLine("b", i18n.T("help.action.runbook")))
+	b.WriteString(renderHelpLine("c", i18n.T("help.action.copy")))
+	b.WriteString(renderHelpLine("y", i18n.T("help.action.copy_url")))
+	b.WriteString(renderHelpLine("J", i18n.T("help.action.copy_json")))
+	b.WriteString(renderHelpLine("C", i18n.T("help.action.copy_contact")))
+	b.WriteString(renderHelpLine("L", i18n.T("help.action.copy_slack")))
+	b.WriteString(renderHelpLine("Y", i18n.T("help.action.copy_detail")))
+	b.WriteString(renderHelpLine("M", i18n.T("help.action.copy_markdown")))
+	b.WriteString(renderHelpLine("u", i18n.T("help.action.copy_status_update")))
+	b.WriteString(renderHelpLine("W", i18n.T("help.action.copy_table")))
+	b.WriteString(renderHelpLine("X", i18n.T("help.action.permalink")))
+	b.WriteString(renderHelpLine("V", i18n.T("help.action.copy_services")))
+	b.WriteString(renderHelpLine("E", i18n.T("help.action.export_html")))
+	b.WriteString(renderHelpLine("/", i18n.T("help.action.search")))
+	b.WriteString(renderHelpLine(":", i18n.T("help.action.jump")))
+	b.WriteString(renderHelpLine("n", i18n.T("help.action.add_note")))
+	b.WriteString(renderHelpLine("T", i18n.T("help.action.filter_team")))
+	b.WriteString(renderHelpLine("w", i18n.T("help.action.save_view")))
+	b.WriteString(renderHelpLine("F", i18n.T("help.action.saved_views")))
+	b.WriteString(renderHelpLine("P", i18n.T("help.action.switch_profile")))
+	b.WriteString(renderHelpLine("O", i18n.T("help.action.oncall_only")))
+	b.WriteString(renderHelpLine("U", i18n.T("help.action.scope")))
+	b.WriteString(renderHelpLine("I", i18n.T("help.action.toggle_id")))
+	b.WriteString(renderHelpLine("H", i18n.T("help.action.present")))
+	b.WriteString(renderHelpLine("t", i18n.T("help.action.relative_times")))
+	b.WriteString(renderHelpLine("D", i18n.T("help.action.summary")))
+	b.WriteString(renderHelpLine("a", i18n.T("help.action.ack_incident")))
+	b.WriteString(renderHelpLine("N", i18n.T("help.action.next_needs_ack")))
+	b.WriteString(renderHelpLine("f", i18n.T("help.action.status_filter")))
+	b.WriteString(renderHelpLine("v", i18n.T("help.action.severity_filter")))
+	b.WriteString(renderHelpLine("R", i18n.T("help.action.reopen")))
+	b.WriteString(renderHelpLine("K", i18n.T("help.action.ack_all")))
+	b.WriteString(renderHelpLine("x", i18n.T("help.action.expand_labels")))
+	b.WriteString(renderHelpLine("i", i18n.T("help.action.group_by_incident")))
+	b.WriteString(renderHelpLine("z", i18n.T("help.action.group_by_service")))
+	b.WriteString(renderHelpLine("Z", i18n.T("help.action.collapse_group")))
 	b.WriteString("\n")
 
 	// Sorting section
@@ -106,7 +144,10 @@ func RenderHelpBar(width int, hasSelection, isLoading, isIncidentsTab bool, curr
 	}
 	// Show sorting hint only on incidents tab
 	if isIncidentsTab {
-		items = append(items, styles.RenderHelpItem("S", i18n.T("sorting.sort_by_date")))
+		items = append(items,
+			styles.RenderHelpItem("S", i18n.T("sorting.sort_by_date")),
+			styles.RenderHelpItem("T", i18n.T("helpbar.team")),
+		)
 	}
 	items = append(items,
 		styles.RenderHelpItem("l", i18n.T("helpbar.logs")),
@@ -2,12 +2,16 @@ package views
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
+	"charm.land/bubbles/v2/textinput"
 	"charm.land/bubbles/v2/viewport"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 	"charm.land/lipgloss/v2/list"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/evertras/bubble-table/table"
 
 	"github.com/rootlyhq/rootly-tui/internal/api"
@@ -17,6 +21,11 @@ import (
 	"github.com/rootlyhq/rootly-tui/internal/styles"
 )
 
+// renderCachedMarker renders the marker under a detail served from the cache
+func renderCachedMarker() string {
+	return styles.TextDim.Render("⚡" + i18n.T("common.cached"))
+}
+
 // renderBulletList renders a section with a bold title and bullet list using lipgloss/list
 func renderBulletList(icon, title string, items []string) string {
 	if len(items) == 0 {
@@ -38,6 +47,58 @@ func renderBulletList(icon, title string, items []string) string {
 	return b.String()
 }
 
+// renderEnvironmentList renders environments as a bullet list colored by risk
+// (see styles.EnvironmentStyle)
+func renderEnvironmentList(icon, title string, envs []string) string {
+	if len(envs) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(styles.TextBold.Render(icon + " " + title))
+	b.WriteString("\n")
+	anyItems := make([]any, len(envs))
+	for i, env := range envs {
+		anyItems[i] = env
+	}
+	l := list.New(anyItems...).
+		Enumerator(list.Bullet).
+		ItemStyleFunc(func(_ list.Items, i int) lipgloss.Style {
+			return styles.EnvironmentStyle(envs[i])
+		})
+	b.WriteString(l.String())
+	b.WriteString("\n\n") // Blank line after section
+	return b.String()
+}
+
+// taskCheckbox marks completed action items as checked and cancelled ones as dropped
+func taskCheckbox(task api.IncidentTask) string {
+	switch {
+	case task.IsDone():
+		return "[x]"
+	case strings.EqualFold(task.Status, api.TaskStatusCancelled):
+		return "[-]"
+	default:
+		return "[ ]"
+	}
+}
+
+// renderTask renders one action item line with its checkbox and assignee
+func renderTask(task api.IncidentTask) string {
+	box := taskCheckbox(task)
+	title := styles.DetailValue.Render(task.Title)
+	if task.IsDone() {
+		box = styles.Success.Render(box)
+		title = styles.TextDim.Render(task.Title)
+	} else {
+		box = styles.TextDim.Render(box)
+	}
+	line := "  " + box + " " + title
+	if task.AssigneeName != "" {
+		line += " " + styles.TextDim.Render("("+task.AssigneeName+")")
+	}
+	return line
+}
+
 // Column keys for incidents table
 const (
 	colKeyIndicator = "indicator"
@@ -51,6 +112,13 @@ const (
 // Row indicator for selected row
 const rowIndicator = "▶"
 
+// rowNewBadge marks incidents that arrived with the latest reload, for
+// NewBadgeDuration or until the cursor reaches them
+const rowNewBadge = "NEW"
+
+// NewBadgeDuration is how long a newly arrived incident keeps its NEW badge
+const NewBadgeDuration = 10 * time.Second
+
 // SortField represents the field to sort by
 type SortField int
 
@@ -58,8 +126,15 @@ const (
 	SortByNone SortField = iota
 	SortByCreated
 	SortByUpdated
+	SortBySeverity // Client-side; the API has no severity sort
+	SortByStatus   // Client-side, by status bucket (active, in progress, resolved, closed)
 )
 
+// clientSideSort reports whether field is sorted over the loaded page rather than by the API
+func clientSideSort(field any) bool {
+	return field == SortBySeverity || field == SortByStatus
+}
+
 type IncidentsModel struct {
 	incidents    []api.Incident
 	width        int
@@ -69,6 +144,7 @@ type IncidentsModel struct {
 	listHeight   int
 	detailHeight int
 	layout       string // "horizontal" or "vertical"
+	narrow       bool   // width below narrowWidth: panes stack whatever the layout
 	loading      bool
 	error        string
 	// Pagination state
@@ -77,19 +153,65 @@ type IncidentsModel struct {
 	totalCount  int
 	hasNext     bool
 	hasPrev     bool
+	// Infinite scroll: a next-page fetch to append is in flight, and how many
+	// pages have been appended after currentPage
+	appending     bool
+	appendedPages int
 	// Loading spinner (passed from app)
 	spinnerView string
 	// Detail loading state - tracks which incident ID is currently loading (empty = not loading)
 	detailLoadingID string
+	// IDs whose loaded detail was served from the cache (marked ⚡cached)
+	cachedDetails map[string]bool
 	// Detail viewport for scrollable content
 	detailViewport      viewport.Model
 	detailViewportReady bool
 	detailFocused       bool // Whether detail pane has focus (for scrolling)
 	// Table for list view
 	table table.Model
+	// Built rows and the row currently carrying the cursor indicator,
+	// so cursor moves only touch the two affected rows
+	rows         []table.Row
+	indicatorRow int
+	// IDs listed by the last load and the page, sort and team it was for;
+	// incidents a reload of the same list adds are badged NEW since they arrived
+	seenIDs  map[string]bool
+	seenList string
+	newSince map[string]time.Time
 	// Sorting
 	sortState *components.SortState
 	sortMenu  *components.SortMenuModel
+	// Team filter (sent to the API, and applied to the loaded page until it reloads)
+	allIncidents []api.Incident
+	teamFilter   string
+	teamPicker   *components.PickerModel
+	// On-call filter: only incidents touching services/teams the user is on call for
+	onCallScopes *api.OnCallScopes
+	onCallOnly   bool
+	// Mine filter: only incidents the user created or holds a role in (nil = off)
+	mineUser *api.User
+	// Status filter cycled with f (all, active or resolved)
+	statusFilter   StatusFilter
+	severityFilter SeverityFilter
+	// Search filter on title/summary (/), and its prompt while typing
+	searchQuery string
+	searchInput textinput.Model
+	searching   bool
+	// Show opaque incident IDs instead of sequential ones (for API debugging)
+	showOpaqueID bool
+	// Mask emails and links in the detail pane (for screensharing)
+	presentMode bool
+	// relativeTimes shows detail timestamps as "12m ago" instead of dates
+	relativeTimes bool
+	// Show colored initials badges next to people in the detail pane
+	showInitials bool
+	// Timeline events per incident ID, fetched the first time its detail is focused
+	events map[string]*incidentEvents
+	// Timezone for timeline event times (nil = UTC)
+	location *time.Location
+	// Table rows that fit on screen, and the page size used by list requests
+	fitRows  int
+	pageSize int
 }
 
 // borderNoDividers creates a rounded border without vertical column dividers
@@ -115,29 +237,55 @@ func borderNoDividers() table.Border {
 	}
 }
 
-func NewIncidentsModel() IncidentsModel {
-	// Define table columns with i18n headers using evertras/bubble-table
-	columns := []table.Column{
-		table.NewColumn(colKeyIndicator, "", 2), // Selection indicator column
+// ID column widths for sequential (INC-123) and opaque (UUID) IDs
+const (
+	idColWidth       = 10
+	opaqueIDColWidth = 36
+)
+
+// incidentColumns defines table columns with i18n headers using evertras/bubble-table
+func incidentColumns(idWidth int) []table.Column {
+	return []table.Column{
+		table.NewColumn(colKeyIndicator, "", 4), // Selection indicator or NEW badge
 		table.NewColumn(colKeySev, i18n.T("incidents.col.severity"), 4),
-		table.NewColumn(colKeyID, i18n.T("incidents.col.id"), 10),
+		table.NewColumn(colKeyID, i18n.T("incidents.col.id"), idWidth),
 		table.NewColumn(colKeyStatus, i18n.T("incidents.detail.status"), 12),
 		table.NewColumn(colKeyTime, "", 8),                                 // Relative time (e.g., "2d ago", "3h ago")
 		table.NewFlexColumn(colKeyTitle, i18n.T("incidents.col.title"), 1), // Flex to fill remaining space
 	}
+}
 
-	t := table.New(columns).
+func NewIncidentsModel() IncidentsModel {
+	t := table.New(incidentColumns(idColWidth)).
 		Focused(true).
 		Border(borderNoDividers()).
 		WithBaseStyle(lipgloss.NewStyle().Foreground(styles.ColorText)).
 		HighlightStyle(lipgloss.NewStyle()). // No background highlight, arrow shows selection
 		HeaderStyle(lipgloss.NewStyle().Bold(true).Foreground(styles.ColorText))
 
-	// Initialize sort menu with incident-specific options (only API-supported sorts)
+	// Initialize sort menu with incident-specific options (severity and status are sorted client-side per page)
 	sortOptions := []components.SortOption{
 		{Label: i18n.T("sorting.created"), Description: i18n.T("sorting.desc.created"), Value: SortByCreated},
 		{Label: i18n.T("sorting.updated"), Description: i18n.T("sorting.desc.updated"), Value: SortByUpdated},
-	}
+		{
+			Label:       i18n.T("sorting.severity"),
+			Description: i18n.T("sorting.desc.severity"),
+			Value:       SortBySeverity,
+			DescLabel:   i18n.T("sorting.most_severe_first"),
+			AscLabel:    i18n.T("sorting.least_severe_first"),
+		},
+		{
+			Label:       i18n.T("incidents.detail.status"),
+			Description: i18n.T("sorting.desc.incident_status"),
+			Value:       SortByStatus,
+			DescLabel:   i18n.T("sorting.active_first"),
+			AscLabel:    i18n.T("sorting.resolved_first"),
+		},
+	}
+
+	searchInput := textinput.New()
+	searchInput.Prompt = "/ "
+	searchInput.Placeholder = i18n.T("search.placeholder")
 
 	return IncidentsModel{
 		incidents:   []api.Incident{},
@@ -145,6 +293,8 @@ func NewIncidentsModel() IncidentsModel {
 		table:       t,
 		sortState:   components.NewSortState(),
 		sortMenu:    components.NewSortMenu(sortOptions),
+		teamPicker:  components.NewPicker(i18n.T("teams.picker_title")),
+		searchInput: searchInput,
 	}
 }
 
@@ -209,6 +359,12 @@ func (m IncidentsModel) Update(msg tea.Msg) (IncidentsModel, tea.Cmd) {
 
 		// Handle navigation keys ourselves to prevent table's wrap-around behavior
 		switch msg.String() {
+		case "esc":
+			// Clear the search filter and restore the full page
+			if m.searchQuery != "" {
+				m.Filter("")
+			}
+			return m, nil
 		case "j", "down":
 			cursor := m.table.GetHighlightedRowIndex()
 			if cursor < len(m.incidents)-1 {
@@ -266,56 +422,161 @@ func (m IncidentsModel) Update(msg tea.Msg) (IncidentsModel, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
-// updateRowIndicators updates the arrow indicator to show on the current row
+// updateRowIndicators moves the arrow indicator to the current row.
+// Only the previously and newly highlighted rows are rebuilt.
 func (m *IncidentsModel) updateRowIndicators() {
 	if len(m.incidents) == 0 {
 		return
 	}
 	cursor := m.table.GetHighlightedRowIndex()
-	rows := make([]table.Row, len(m.incidents))
+	if len(m.rows) != len(m.incidents) {
+		m.buildRows(cursor)
+		return
+	}
+	if cursor == m.indicatorRow {
+		return
+	}
+	m.setRowIndicator(m.indicatorRow, m.indicatorCell(m.indicatorRow, false))
+	m.setRowIndicator(cursor, m.indicatorCell(cursor, true))
+	m.indicatorRow = cursor
+	m.table = m.table.WithRows(m.rows)
+}
+
+// setRowIndicator replaces the indicator cell of a single row
+func (m *IncidentsModel) setRowIndicator(index int, indicator any) {
+	if index < 0 || index >= len(m.rows) {
+		return
+	}
+	row := table.NewRow(m.rows[index].Data)
+	row.Data[colKeyIndicator] = indicator
+	m.rows[index] = row
+}
+
+// indicatorCell returns the indicator cell for a row: the arrow on the
+// cursor's row, which also clears its NEW badge, or the badge if it has one
+func (m *IncidentsModel) indicatorCell(index int, highlighted bool) any {
+	if index < 0 || index >= len(m.incidents) {
+		return ""
+	}
+	id := m.incidents[index].ID
+	if highlighted {
+		delete(m.newSince, id)
+		return rowIndicator
+	}
+	if _, ok := m.newSince[id]; ok {
+		return table.NewStyledCell(rowNewBadge, styles.SuccessMsg)
+	}
+	return ""
+}
+
+// buildRows fully rebuilds the table rows from the current incidents
+func (m *IncidentsModel) buildRows(cursor int) {
+	m.rows = make([]table.Row, len(m.incidents))
 	for i, inc := range m.incidents {
-		seqID := inc.SequentialID
-		if seqID == "" {
-			seqID = "INC-?"
-		}
-		status := inc.Status
-		if len(status) > 12 {
-			status = status[:12]
+		m.rows[i] = buildIncidentRow(inc, m.showOpaqueID)
+		m.rows[i].Data[colKeyIndicator] = m.indicatorCell(i, i == cursor)
+	}
+	m.indicatorRow = cursor
+	m.table = m.table.WithRows(m.rows)
+}
+
+// trackNewIncidents badges incidents that a reload of the same page, sort and
+// team added since the previous load; other loads start over
+func (m *IncidentsModel) trackNewIncidents(incidents []api.Incident, page int, now time.Time) {
+	list := fmt.Sprintf("%d|%s|%s", page, m.GetSortParam(), m.teamFilter)
+	ids := make(map[string]bool, len(incidents))
+	for _, inc := range incidents {
+		ids[inc.ID] = true
+	}
+	if m.seenIDs == nil || list != m.seenList {
+		m.newSince = nil
+	} else {
+		for id := range ids {
+			if m.seenIDs[id] {
+				continue
+			}
+			if m.newSince == nil {
+				m.newSince = make(map[string]time.Time)
+			}
+			m.newSince[id] = now
 		}
-		title := inc.Summary
-		if title == "" {
-			title = inc.Title
+	}
+	for id := range m.newSince {
+		if !ids[id] {
+			delete(m.newSince, id)
 		}
-		title = strings.ReplaceAll(title, "\n", " ")
-		title = strings.ReplaceAll(title, "\r", "")
+	}
+	m.seenIDs = ids
+	m.seenList = list
+}
 
-		sevCell := table.NewStyledCell(severitySignalPlain(inc.Severity), severityStyle(inc.Severity))
-		statusCell := table.NewStyledCell(status, statusStyle(status))
+// HasNewBadges reports whether any listed incident is badged NEW
+func (m IncidentsModel) HasNewBadges() bool {
+	return len(m.newSince) > 0
+}
 
-		// Use StartedAt if available, otherwise CreatedAt
-		timeStr := "-"
-		if inc.StartedAt != nil {
-			timeStr = formatRelativeTime(*inc.StartedAt)
-		} else if !inc.CreatedAt.IsZero() {
-			timeStr = formatRelativeTime(inc.CreatedAt)
-		}
-		timeCell := table.NewStyledCell(timeStr, styles.TextDim)
+// IsNew reports whether the incident is badged NEW
+func (m IncidentsModel) IsNew(id string) bool {
+	_, ok := m.newSince[id]
+	return ok
+}
 
-		indicator := ""
-		if i == cursor {
-			indicator = rowIndicator
+// ExpireNewBadges drops NEW badges shown for NewBadgeDuration as of now
+func (m *IncidentsModel) ExpireNewBadges(now time.Time) {
+	expired := false
+	for id, since := range m.newSince {
+		if now.Sub(since) >= NewBadgeDuration {
+			delete(m.newSince, id)
+			expired = true
 		}
+	}
+	if expired && len(m.rows) == len(m.incidents) {
+		m.buildRows(m.table.GetHighlightedRowIndex())
+	}
+}
 
-		rows[i] = table.NewRow(table.RowData{
-			colKeyIndicator: indicator,
-			colKeySev:       sevCell,
-			colKeyID:        seqID,
-			colKeyStatus:    statusCell,
-			colKeyTime:      timeCell,
-			colKeyTitle:     title,
-		})
+// buildIncidentRow creates a table row with styled cells for an incident,
+// leaving the indicator cell to the caller
+func buildIncidentRow(inc api.Incident, opaqueID bool) table.Row {
+	seqID := inc.SequentialID
+	if opaqueID {
+		seqID = inc.ID
 	}
-	m.table = m.table.WithRows(rows)
+	if seqID == "" {
+		seqID = "INC-?"
+	}
+	status := inc.Status
+	if len(status) > 12 {
+		status = status[:12]
+	}
+	title := inc.Summary
+	if title == "" {
+		title = inc.Title
+	}
+	title = strings.ReplaceAll(title, "\n", " ")
+	title = strings.ReplaceAll(title, "\r", "")
+
+	// Create styled cells using evertras/bubble-table
+	sevCell := table.NewStyledCell(severitySignalPlain(inc.Severity), severityStyle(inc.Severity))
+	statusCell := table.NewStyledCell(status, statusStyle(status))
+
+	// Use StartedAt if available, otherwise CreatedAt
+	timeStr := "-"
+	if inc.StartedAt != nil {
+		timeStr = formatRelativeTime(*inc.StartedAt)
+	} else if !inc.CreatedAt.IsZero() {
+		timeStr = formatRelativeTime(inc.CreatedAt)
+	}
+	timeCell := table.NewStyledCell(timeStr, styles.TextDim)
+
+	return table.NewRow(table.RowData{
+		colKeyIndicator: "",
+		colKeySev:       sevCell,
+		colKeyID:        seqID,
+		colKeyStatus:    statusCell,
+		colKeyTime:      timeCell,
+		colKeyTitle:     title,
+	})
 }
 
 // updateViewportContent updates the viewport content when data changes
@@ -342,9 +603,11 @@ func (m *IncidentsModel) updateDimensions() {
 		m.layout = config.LayoutHorizontal
 	}
 
+	m.narrow = isNarrow(m.width)
+
 	var tableWidth, tableHeight, viewportWidth, viewportHeight int
 
-	if m.layout == config.LayoutVertical {
+	if m.stacked() {
 		// Vertical layout: use full height, minimal overhead
 		// App already subtracts 10 for header/help bar, so we only need minimal adjustment
 		totalContentHeight := m.height - 2 // Just account for spacing between panes
@@ -391,7 +654,16 @@ func (m *IncidentsModel) updateDimensions() {
 		viewportHeight = totalContentHeight - 4
 	}
 
-	// Ensure minimum dimensions
+	// Ensure minimum dimensions (tiny terminals can drive these to zero or below)
+	if m.listWidth < 1 {
+		m.listWidth = 1
+	}
+	if m.detailWidth < 1 {
+		m.detailWidth = 1
+	}
+	if tableWidth < 10 {
+		tableWidth = 10
+	}
 	if viewportHeight < 1 {
 		viewportHeight = 1
 	}
@@ -401,12 +673,13 @@ func (m *IncidentsModel) updateDimensions() {
 
 	// Calculate page size based on available table height
 	// Account for header row (1 line) and some padding
-	pageSize := tableHeight - 2
-	if pageSize < 3 {
-		pageSize = 3
+	m.fitRows = tableHeight - 2
+	if m.fitRows < 3 {
+		m.fitRows = 3
 	}
-	if pageSize > 25 {
-		pageSize = 25 // Cap at API page size
+	pageSize := m.fitRows
+	if pageSize > m.apiPageSize() {
+		pageSize = m.apiPageSize() // Cap at API page size
 	}
 
 	// Update table dimensions and page size
@@ -423,71 +696,45 @@ func (m *IncidentsModel) updateDimensions() {
 }
 
 func (m *IncidentsModel) SetIncidents(incidents []api.Incident, pagination api.PaginationInfo) {
-	m.incidents = incidents
+	// Remember the selected incident so the cursor can follow it if the order changed
+	prevID := ""
+	if sel := m.SelectedIncident(); sel != nil {
+		prevID = sel.ID
+	}
+
+	m.allIncidents = incidents
+	m.incidents = m.applyFilters(incidents)
 	m.loading = false
+	m.appending = false
+	m.appendedPages = 0
 	m.error = ""
+	m.trackNewIncidents(incidents, pagination.CurrentPage, time.Now())
 	m.currentPage = pagination.CurrentPage
 	m.totalPages = pagination.TotalPages
 	m.totalCount = pagination.TotalCount
 	m.hasNext = pagination.HasNext
 	m.hasPrev = pagination.HasPrev
 
-	// Build table rows from incidents with styled cells
-	rows := make([]table.Row, len(m.incidents))
+	// Keep the cursor on the same incident, falling back to clamping the index
 	cursor := m.table.GetHighlightedRowIndex()
 	for i, inc := range m.incidents {
-		seqID := inc.SequentialID
-		if seqID == "" {
-			seqID = "INC-?"
-		}
-		status := inc.Status
-		if len(status) > 12 {
-			status = status[:12]
-		}
-		title := inc.Summary
-		if title == "" {
-			title = inc.Title
-		}
-		title = strings.ReplaceAll(title, "\n", " ")
-		title = strings.ReplaceAll(title, "\r", "")
-
-		// Create styled cells using evertras/bubble-table
-		sevCell := table.NewStyledCell(severitySignalPlain(inc.Severity), severityStyle(inc.Severity))
-		statusCell := table.NewStyledCell(status, statusStyle(status))
-
-		// Use StartedAt if available, otherwise CreatedAt
-		timeStr := "-"
-		if inc.StartedAt != nil {
-			timeStr = formatRelativeTime(*inc.StartedAt)
-		} else if !inc.CreatedAt.IsZero() {
-			timeStr = formatRelativeTime(inc.CreatedAt)
-		}
-		timeCell := table.NewStyledCell(timeStr, styles.TextDim)
-
-		// Show indicator for highlighted row
-		indicator := ""
-		if i == cursor {
-			indicator = rowIndicator
-		}
-
-		rows[i] = table.NewRow(table.RowData{
-			colKeyIndicator: indicator,
-			colKeySev:       sevCell,
-			colKeyID:        seqID,
-			colKeyStatus:    statusCell,
-			colKeyTime:      timeCell,
-			colKeyTitle:     title,
-		})
+		if prevID != "" && inc.ID == prevID {
+			cursor = i
+			break
+		}
+	}
+	if cursor >= len(m.incidents) && len(m.incidents) > 0 {
+		cursor = len(m.incidents) - 1
 	}
-	m.table = m.table.WithRows(rows)
+
+	m.buildRows(cursor)
 
 	// Set custom footer with pagination info
 	footer := m.buildPaginationFooter()
 	m.table = m.table.WithStaticFooter(footer)
 
-	// Adjust cursor if needed
-	if cursor >= len(incidents) && len(incidents) > 0 {
-		m.table = m.table.WithHighlightedRow(len(incidents) - 1)
+	if len(m.incidents) > 0 {
+		m.table = m.table.WithHighlightedRow(cursor)
 	}
 	m.updateViewportContent()
 }
@@ -503,6 +750,61 @@ func (m *IncidentsModel) buildPaginationFooter() string {
 	return ""
 }
 
+// AppendIncidents adds the next page to the loaded incidents (infinite scroll),
+// skipping any already listed and keeping the cursor on the same incident
+func (m *IncidentsModel) AppendIncidents(incidents []api.Incident, pagination api.PaginationInfo) {
+	m.appending = false
+	seen := make(map[string]bool, len(m.allIncidents))
+	for _, inc := range m.allIncidents {
+		seen[inc.ID] = true
+	}
+	all := make([]api.Incident, len(m.allIncidents), len(m.allIncidents)+len(incidents))
+	copy(all, m.allIncidents)
+	for _, inc := range incidents {
+		if !seen[inc.ID] {
+			seen[inc.ID] = true
+			all = append(all, inc)
+		}
+	}
+
+	// Earlier pages stay loaded, so the list still starts at currentPage
+	appended := m.appendedPages + 1
+	pagination.CurrentPage = m.currentPage
+	pagination.HasPrev = m.hasPrev
+	m.SetIncidents(all, pagination)
+	m.appendedPages = appended
+}
+
+// NextAppendPage returns the page to fetch for the next infinite-scroll append
+func (m IncidentsModel) NextAppendPage() int {
+	return m.currentPage + m.appendedPages + 1
+}
+
+// BeginAppend marks a next-page fetch as in flight. It returns false if there is no
+// next page or a fetch is already running, so scrolling can't queue duplicate appends.
+func (m *IncidentsModel) BeginAppend() bool {
+	if m.appending || !m.hasNext {
+		return false
+	}
+	m.appending = true
+	return true
+}
+
+// CancelAppend clears the in-flight append after a failed fetch so it can be retried
+func (m *IncidentsModel) CancelAppend() {
+	m.appending = false
+}
+
+// IsAppending returns whether a next-page fetch to append is in flight
+func (m IncidentsModel) IsAppending() bool {
+	return m.appending
+}
+
+// AtLastRow returns whether the cursor is on the last listed incident
+func (m IncidentsModel) AtLastRow() bool {
+	return len(m.incidents) > 0 && m.table.GetHighlightedRowIndex() == len(m.incidents)-1
+}
+
 func (m *IncidentsModel) SetLoading(loading bool) {
 	m.loading = loading
 }
@@ -516,6 +818,25 @@ func (m *IncidentsModel) SetError(err string) {
 	m.loading = false
 }
 
+// FitPageSize returns how many rows fit in the table at the current height
+func (m IncidentsModel) FitPageSize() int {
+	return m.fitRows
+}
+
+// SetAPIPageSize sets the page size used by list requests so the table never pages within it
+func (m *IncidentsModel) SetAPIPageSize(size int) {
+	m.pageSize = size
+	m.updateDimensions()
+}
+
+// apiPageSize returns the page size used by list requests
+func (m IncidentsModel) apiPageSize() int {
+	if m.pageSize > 0 {
+		return m.pageSize
+	}
+	return api.DefaultPageSize
+}
+
 func (m *IncidentsModel) SetDimensions(width, height int) {
 	m.width = width
 	m.height = height
@@ -564,6 +885,12 @@ func (m *IncidentsModel) PrevPage() {
 	}
 }
 
+// SetPage moves to a page (at least 1) for the caller to load
+func (m *IncidentsModel) SetPage(page int) {
+	m.currentPage = max(page, 1)
+	m.table = m.table.WithHighlightedRow(0)
+}
+
 func (m IncidentsModel) SelectedIncident() *api.Incident {
 	cursor := m.table.GetHighlightedRowIndex()
 	if cursor >= 0 && cursor < len(m.incidents) {
@@ -580,6 +907,15 @@ func (m *IncidentsModel) SetDetailLoading(id string) {
 	m.detailLoadingID = id
 }
 
+// SetDetailFromCache records whether the detail of the incident with id was served
+// from the cache rather than fetched, marked under the detail
+func (m *IncidentsModel) SetDetailFromCache(id string, fromCache bool) {
+	if m.cachedDetails == nil {
+		m.cachedDetails = make(map[string]bool)
+	}
+	m.cachedDetails[id] = fromCache
+}
+
 func (m *IncidentsModel) ClearDetailLoading() {
 	m.detailLoadingID = ""
 }
@@ -595,7 +931,12 @@ func (m IncidentsModel) IsLoadingIncident(id string) bool {
 
 // SetDetailFocused sets focus on the detail pane for scrolling
 func (m *IncidentsModel) SetDetailFocused(focused bool) {
+	if m.detailFocused == focused {
+		return
+	}
 	m.detailFocused = focused
+	// The event timeline only shows while focused
+	m.updateViewportContent()
 }
 
 // IsDetailFocused returns whether the detail pane has focus
@@ -603,9 +944,26 @@ func (m IncidentsModel) IsDetailFocused() bool {
 	return m.detailFocused
 }
 
+// IndexOf returns the position of the incident with id in the list, or -1
+func (m IncidentsModel) IndexOf(id string) int {
+	for i := range m.incidents {
+		if m.incidents[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
 func (m *IncidentsModel) UpdateIncidentDetail(index int, incident *api.Incident) {
 	if index >= 0 && index < len(m.incidents) && incident != nil {
 		m.incidents[index] = *incident
+		// Keep the unfiltered list in sync so the detail survives filter changes
+		for i := range m.allIncidents {
+			if m.allIncidents[i].ID == incident.ID {
+				m.allIncidents[i] = *incident
+				break
+			}
+		}
 		// Update viewport content without resetting scroll (detail just loaded)
 		if m.detailViewportReady && index == m.table.GetHighlightedRowIndex() {
 			content := m.generateDetailContent(incident)
@@ -614,13 +972,61 @@ func (m *IncidentsModel) UpdateIncidentDetail(index int, incident *api.Incident)
 	}
 }
 
+// ShowIncident selects the incident and shows its detail, adding it to the top of
+// the loaded page if it isn't already listed
+func (m *IncidentsModel) ShowIncident(inc api.Incident) {
+	found := false
+	for i := range m.allIncidents {
+		if m.allIncidents[i].ID == inc.ID {
+			m.allIncidents[i] = inc
+			found = true
+		}
+	}
+	if !found {
+		m.allIncidents = append([]api.Incident{inc}, m.allIncidents...)
+	}
+	m.incidents = m.applyFilters(m.allIncidents)
+
+	cursor := 0
+	for i := range m.incidents {
+		if m.incidents[i].ID == inc.ID {
+			cursor = i
+			break
+		}
+	}
+	m.table = m.table.WithHighlightedRow(cursor)
+	m.buildRows(cursor)
+	m.updateViewportContent()
+}
+
+// SetIncidentStatus updates the status of an incident in place (e.g. after a write action)
+func (m *IncidentsModel) SetIncidentStatus(id, status string) {
+	for i := range m.allIncidents {
+		if m.allIncidents[i].ID == id {
+			m.allIncidents[i].Status = status
+		}
+	}
+	for i := range m.incidents {
+		if m.incidents[i].ID == id {
+			m.incidents[i].Status = status
+			m.buildRows(m.table.GetHighlightedRowIndex())
+			m.updateViewportContent()
+			return
+		}
+	}
+}
+
 func (m IncidentsModel) View() string {
+	if windowTooSmall(m.width, m.height) {
+		return renderWindowTooSmall()
+	}
+
 	if m.loading {
 		// Show loading within the layout structure to prevent jarring shift
 		loadingMsg := fmt.Sprintf("%s %s", m.spinnerView, i18n.Tf("incidents.loading_page", map[string]any{"Page": m.currentPage}))
-		listContent := styles.TextBold.Render(i18n.T("incidents.title")) + "\n\n" + styles.TextDim.Render(loadingMsg)
+		listContent := m.renderTitle() + "\n\n" + styles.TextDim.Render(loadingMsg)
 		listView := styles.ListContainer.Width(m.listWidth).Height(m.listHeight).Render(listContent)
-		detailView := styles.DetailContainer.Width(m.detailWidth).Height(m.detailHeight).Render("")
+		detailView := styles.DetailContainer.Width(m.detailWidth).Height(m.detailHeight).Render(renderDetailSkeleton(m.detailWidth))
 		return m.joinPanes(listView, detailView)
 	}
 
@@ -629,6 +1035,24 @@ func (m IncidentsModel) View() string {
 	}
 
 	if len(m.incidents) == 0 {
+		if m.searchQuery != "" || m.searching {
+			return m.renderTitle() + "\n\n" + styles.TextDim.Render(i18n.Tf("search.none", map[string]any{"Query": m.searchQuery}))
+		}
+		if m.teamFilter != "" {
+			return styles.TextDim.Render(i18n.Tf("teams.none_for_team", map[string]any{"Team": m.teamFilter}))
+		}
+		if m.onCallOnly {
+			return styles.TextDim.Render(i18n.T("oncall.none"))
+		}
+		if m.mineUser != nil {
+			return styles.TextDim.Render(i18n.T("scope.none_mine"))
+		}
+		if m.statusFilter != StatusFilterAll {
+			return m.renderTitle() + "\n\n" + styles.TextDim.Render(i18n.Tf("incidents.status_filter.none", map[string]any{"Filter": m.statusFilter.Label()}))
+		}
+		if m.severityFilter != SeverityFilterAll {
+			return m.renderTitle() + "\n\n" + styles.TextDim.Render(i18n.Tf("incidents.severity_filter.none", map[string]any{"Filter": m.severityFilter.Label()}))
+		}
 		return styles.TextDim.Render(i18n.T("incidents.none_found"))
 	}
 
@@ -642,9 +1066,15 @@ func (m IncidentsModel) View() string {
 	return m.joinPanes(listView, detailView)
 }
 
+// stacked reports whether the list sits above the detail pane: with the
+// vertical layout, or on a narrow terminal where side by side doesn't fit
+func (m IncidentsModel) stacked() bool {
+	return m.layout == config.LayoutVertical || m.narrow
+}
+
 // joinPanes joins the list and detail panes based on the current layout
 func (m IncidentsModel) joinPanes(listView, detailView string) string {
-	if m.layout == config.LayoutVertical {
+	if m.stacked() {
 		return lipgloss.JoinVertical(lipgloss.Left, listView, detailView)
 	}
 	return lipgloss.JoinHorizontal(lipgloss.Top, listView, "  ", detailView)
@@ -654,8 +1084,7 @@ func (m IncidentsModel) renderList(height int) string {
 	var b strings.Builder
 
 	// Title
-	title := styles.TextBold.Render(i18n.T("incidents.title"))
-	b.WriteString(title)
+	b.WriteString(m.renderTitle())
 	b.WriteString("\n\n")
 
 	// Render table
@@ -729,6 +1158,18 @@ func (m IncidentsModel) renderDetail(height int) string {
 	return containerStyle.Width(m.detailWidth).Height(height).Render(viewportContent)
 }
 
+// RenderFullDetail renders the selected incident's detail at the given size, for
+// full-screen display; content beyond the height is cut off
+func (m IncidentsModel) RenderFullDetail(width, height int) string {
+	inc := m.SelectedIncident()
+	if inc == nil {
+		return ""
+	}
+	// Border and padding of the container
+	m.detailWidth = width - 4
+	return styles.DetailContainer.Width(width).Height(height).MaxHeight(height).Render(m.generateDetailContent(inc))
+}
+
 //nolint:gocyclo // View rendering function with many optional fields to display
 func (m IncidentsModel) generateDetailContent(inc *api.Incident) string {
 	var b strings.Builder
@@ -740,8 +1181,8 @@ func (m IncidentsModel) generateDetailContent(inc *api.Incident) string {
 	}
 	title = strings.ReplaceAll(title, "\n", " ")
 	title = strings.ReplaceAll(title, "\r", "")
-	if inc.SequentialID != "" {
-		b.WriteString(styles.Primary.Bold(true).Render("[" + inc.SequentialID + "]"))
+	if id := m.displayID(inc); id != "" {
+		b.WriteString(styles.Primary.Bold(true).Render("[" + id + "]"))
 		b.WriteString(" ")
 	}
 	b.WriteString(styles.DetailTitle.Render(title))
@@ -763,20 +1204,14 @@ func (m IncidentsModel) generateDetailContent(inc *api.Incident) string {
 
 	// Show creator if available (from detail view)
 	if inc.CreatedByName != "" {
-		creatorInfo := styles.RenderNameWithEmail(inc.CreatedByName, inc.CreatedByEmail)
+		creatorInfo := m.renderPerson(inc.CreatedByName, inc.CreatedByEmail)
 		relTime := formatRelativeTime(inc.CreatedAt)
 		fmt.Fprintf(&b, "  %s %s by %s", i18n.T("incidents.timeline.created"), relTime, creatorInfo)
 	}
 	b.WriteString("\n\n")
 
 	// Links section (high up for quick access)
-	rootlyURL := inc.ShortURL
-	if rootlyURL == "" {
-		rootlyURL = inc.URL
-	}
-	if rootlyURL == "" && inc.ID != "" {
-		rootlyURL = fmt.Sprintf("https://rootly.com/account/incidents/%s", inc.ID)
-	}
+	rootlyURL := api.ResolveIncidentURL(inc)
 	if inc.SlackChannelURL != "" || inc.JiraIssueURL != "" || rootlyURL != "" {
 		b.WriteString(styles.TextBold.Render("🔗 " + i18n.T("incidents.detail.links")))
 		b.WriteString("\n")
@@ -812,7 +1247,7 @@ func (m IncidentsModel) generateDetailContent(inc *api.Incident) string {
 		if descWidth < 40 {
 			descWidth = 40
 		}
-		b.WriteString(styles.RenderMarkdown(summaryClean, descWidth))
+		b.WriteString(renderMarkdown(summaryClean, descWidth, m.presentMode))
 		b.WriteString("\n\n")
 	}
 
@@ -821,35 +1256,38 @@ func (m IncidentsModel) generateDetailContent(inc *api.Incident) string {
 	b.WriteString("\n")
 
 	if !inc.CreatedAt.IsZero() {
-		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.created"), formatTime(inc.CreatedAt)))
+		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.created"), m.formatDetailTime(inc.CreatedAt)))
 	}
 	if inc.StartedAt != nil {
-		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.started"), formatTime(*inc.StartedAt)))
+		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.started"), m.formatDetailTime(*inc.StartedAt)))
 	}
 	if inc.DetectedAt != nil {
-		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.detected"), formatTime(*inc.DetectedAt)))
+		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.detected"), m.formatDetailTime(*inc.DetectedAt)))
 	}
 	if inc.AcknowledgedAt != nil {
-		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.acknowledged"), formatTime(*inc.AcknowledgedAt)))
+		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.acknowledged"), m.formatDetailTime(*inc.AcknowledgedAt)))
 	}
 	if inc.MitigatedAt != nil {
-		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.mitigated"), formatTime(*inc.MitigatedAt)))
+		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.mitigated"), m.formatDetailTime(*inc.MitigatedAt)))
 	}
 	if inc.ResolvedAt != nil {
-		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.resolved"), formatTime(*inc.ResolvedAt)))
+		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.resolved"), m.formatDetailTime(*inc.ResolvedAt)))
 	}
 	if inc.ClosedAt != nil {
-		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.closed"), formatTime(*inc.ClosedAt)))
+		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.closed"), m.formatDetailTime(*inc.ClosedAt)))
 	}
 	if inc.CancelledAt != nil {
-		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.cancelled"), formatTime(*inc.CancelledAt)))
+		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.cancelled"), m.formatDetailTime(*inc.CancelledAt)))
 	}
 	// Scheduled maintenance times
 	if inc.ScheduledFor != nil {
-		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.scheduled_for"), formatTime(*inc.ScheduledFor)))
+		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.scheduled_for"), m.formatDetailTime(*inc.ScheduledFor)))
 	}
 	if inc.ScheduledUntil != nil {
-		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.scheduled_until"), formatTime(*inc.ScheduledUntil)))
+		b.WriteString(m.renderDetailRow(i18n.T("incidents.timeline.scheduled_until"), m.formatDetailTime(*inc.ScheduledUntil)))
+	}
+	if m.detailFocused {
+		b.WriteString(m.renderEvents(inc.ID))
 	}
 	b.WriteString("\n")
 
@@ -902,9 +1340,12 @@ func (m IncidentsModel) generateDetailContent(inc *api.Incident) string {
 		b.WriteString("\n")
 	}
 
+	// Durations between lifecycle steps (start → resolve is the time to resolve)
+	b.WriteString(m.renderPhaseDurations(inc))
+
 	// Services, Environments, Teams
 	b.WriteString(renderBulletList("🛠 ", i18n.T("incidents.detail.services"), inc.Services))
-	b.WriteString(renderBulletList("🌐 ", i18n.T("incidents.detail.environments"), inc.Environments))
+	b.WriteString(renderEnvironmentList("🌐 ", i18n.T("incidents.detail.environments"), inc.Environments))
 	b.WriteString(renderBulletList("👥 ", i18n.T("incidents.detail.teams"), inc.Teams))
 
 	// Extended info (populated when DetailLoaded is true)
@@ -917,7 +1358,7 @@ func (m IncidentsModel) generateDetailContent(inc *api.Incident) string {
 			if descWidth < 40 {
 				descWidth = 40
 			}
-			b.WriteString(styles.RenderMarkdown(inc.MitigationMessage, descWidth))
+			b.WriteString(renderMarkdown(inc.MitigationMessage, descWidth, m.presentMode))
 			b.WriteString("\n\n")
 		}
 
@@ -929,7 +1370,7 @@ func (m IncidentsModel) generateDetailContent(inc *api.Incident) string {
 			if descWidth < 40 {
 				descWidth = 40
 			}
-			b.WriteString(styles.RenderMarkdown(inc.ResolutionMessage, descWidth))
+			b.WriteString(renderMarkdown(inc.ResolutionMessage, descWidth, m.presentMode))
 			b.WriteString("\n\n")
 		}
 
@@ -940,19 +1381,19 @@ func (m IncidentsModel) generateDetailContent(inc *api.Incident) string {
 			if inc.StartedByName != "" {
 				b.WriteString(styles.DetailLabel.Render(i18n.T("incidents.detail.started_by") + ":"))
 				b.WriteString(" ")
-				b.WriteString(styles.RenderNameWithEmail(inc.StartedByName, inc.StartedByEmail))
+				b.WriteString(m.renderPerson(inc.StartedByName, inc.StartedByEmail))
 				b.WriteString("\n")
 			}
 			if inc.MitigatedByName != "" {
 				b.WriteString(styles.DetailLabel.Render(i18n.T("incidents.detail.mitigated_by") + ":"))
 				b.WriteString(" ")
-				b.WriteString(styles.RenderNameWithEmail(inc.MitigatedByName, inc.MitigatedByEmail))
+				b.WriteString(m.renderPerson(inc.MitigatedByName, inc.MitigatedByEmail))
 				b.WriteString("\n")
 			}
 			if inc.ResolvedByName != "" {
 				b.WriteString(styles.DetailLabel.Render(i18n.T("incidents.detail.resolved_by") + ":"))
 				b.WriteString(" ")
-				b.WriteString(styles.RenderNameWithEmail(inc.ResolvedByName, inc.ResolvedByEmail))
+				b.WriteString(m.renderPerson(inc.ResolvedByName, inc.ResolvedByEmail))
 				b.WriteString("\n")
 			}
 			b.WriteString("\n")
@@ -971,7 +1412,18 @@ func (m IncidentsModel) generateDetailContent(inc *api.Incident) string {
 				userEmail := strings.TrimSpace(role.UserEmail)
 				b.WriteString(styles.DetailLabel.Render(roleName + ":"))
 				b.WriteString(" ")
-				b.WriteString(styles.RenderNameWithEmail(userName, userEmail))
+				b.WriteString(m.renderPerson(userName, userEmail))
+				b.WriteString("\n")
+			}
+			b.WriteString("\n")
+		}
+
+		// Action items, completed ones checked
+		if len(inc.ActionItems) > 0 {
+			b.WriteString(styles.TextBold.Render("✅ " + i18n.T("incidents.detail.action_items")))
+			b.WriteString("\n")
+			for _, task := range inc.ActionItems {
+				b.WriteString(renderTask(task))
 				b.WriteString("\n")
 			}
 			b.WriteString("\n")
@@ -1036,6 +1488,9 @@ func (m IncidentsModel) generateDetailContent(inc *api.Incident) string {
 	} else if !inc.DetailLoaded {
 		b.WriteString("\n")
 		b.WriteString(styles.TextDim.Render(i18n.T("incidents.press_enter")))
+	} else if m.cachedDetails[inc.ID] {
+		b.WriteString("\n")
+		b.WriteString(renderCachedMarker())
 	}
 
 	return b.String()
@@ -1045,6 +1500,29 @@ func (m IncidentsModel) renderDetailRow(label, value string) string {
 	return styles.DetailLabel.Render(label+":") + " " + styles.DetailValue.Render(value) + "\n"
 }
 
+// renderPhaseDurations renders the Durations section: detect → acknowledge,
+// acknowledge → mitigate and start → resolve, skipping steps without both timestamps
+func (m IncidentsModel) renderPhaseDurations(inc *api.Incident) string {
+	phases := []struct {
+		label    string
+		from, to *time.Time
+	}{
+		{i18n.T("incidents.durations.detect_to_ack"), inc.DetectedAt, inc.AcknowledgedAt},
+		{i18n.T("incidents.durations.ack_to_mitigate"), inc.AcknowledgedAt, inc.MitigatedAt},
+		{i18n.T("incidents.durations.start_to_resolve"), inc.StartedAt, inc.ResolvedAt},
+	}
+	var rows strings.Builder
+	for _, p := range phases {
+		if d, ok := phaseDuration(p.label, p.from, p.to); ok {
+			rows.WriteString(m.renderMetricRow(p.label, formatSpan(d)))
+		}
+	}
+	if rows.Len() == 0 {
+		return ""
+	}
+	return styles.TextBold.Render("⏱ "+i18n.T("incidents.durations.title")) + "\n" + rows.String() + "\n"
+}
+
 func (m IncidentsModel) renderMetricRow(label, value string) string {
 	return styles.DetailLabel.Render(label+":") + " " + styles.RenderMetric(value) + "\n"
 }
@@ -1062,11 +1540,11 @@ func (m IncidentsModel) renderLinkRow(label, url string) string {
 		displayURL = displayURL[:maxURLLen-3] + "..."
 	}
 
-	return styles.DetailLabel.Render(label+":") + " " + styles.RenderLink(url, displayURL) + "\n"
+	return styles.DetailLabel.Render(label+":") + " " + renderLink(url, displayURL, m.presentMode) + "\n"
 }
 
 func (m IncidentsModel) renderLinkRowCustom(label, url, displayText string) string {
-	return styles.DetailLabel.Render(label+":") + " " + styles.RenderLink(url, displayText) + "\n"
+	return styles.DetailLabel.Render(label+":") + " " + renderLink(url, displayText, m.presentMode) + "\n"
 }
 
 // severitySignalPlain returns plain signal bars without color styling
@@ -1104,6 +1582,9 @@ func severityStyle(severity string) lipgloss.Style {
 // statusStyle returns the lipgloss style for a status
 func statusStyle(status string) lipgloss.Style {
 	s := strings.ToLower(strings.TrimSpace(status))
+	if bucket, ok := styles.CustomStatusBucket(s); ok {
+		s = bucket
+	}
 	switch s {
 	case "open", "triggered", "firing", "critical":
 		return lipgloss.NewStyle().Foreground(styles.ColorPastelRed)
@@ -1136,6 +1617,9 @@ func (m IncidentsModel) GetSortParam() string {
 		fieldName = "created_at"
 	case SortByUpdated:
 		fieldName = "updated_at"
+	case SortBySeverity, SortByStatus:
+		// Fetch newest first; the page is then ordered client-side
+		return "-created_at"
 	default:
 		return ""
 	}
@@ -1159,21 +1643,85 @@ func (m IncidentsModel) GetSortInfo() string {
 		fieldName = i18n.T("sorting.created")
 	case SortByUpdated:
 		fieldName = i18n.T("sorting.updated")
+	case SortBySeverity:
+		fieldName = i18n.T("sorting.severity")
+	case SortByStatus:
+		fieldName = i18n.T("incidents.detail.status")
 	default:
 		return ""
 	}
 
 	// Show direction as "Newest First" or "Oldest First"
 	var directionLabel string
-	if m.sortState.Direction == components.SortDesc {
+	switch {
+	case m.sortState.Field == SortBySeverity && m.sortState.Direction == components.SortDesc:
+		directionLabel = i18n.T("sorting.most_severe_first")
+	case m.sortState.Field == SortBySeverity:
+		directionLabel = i18n.T("sorting.least_severe_first")
+	case m.sortState.Field == SortByStatus && m.sortState.Direction == components.SortDesc:
+		directionLabel = i18n.T("sorting.active_first")
+	case m.sortState.Field == SortByStatus:
+		directionLabel = i18n.T("sorting.resolved_first")
+	case m.sortState.Direction == components.SortDesc:
 		directionLabel = i18n.T("sorting.newest_first")
-	} else {
+	default:
 		directionLabel = i18n.T("sorting.oldest_first")
 	}
 
 	return fmt.Sprintf("%s (%s)", fieldName, directionLabel)
 }
 
+// incidentSortNames are the names used to save the incidents sort in config
+var incidentSortNames = map[SortField]string{
+	SortByCreated:  "created",
+	SortByUpdated:  "updated",
+	SortBySeverity: "severity",
+	SortByStatus:   "status",
+}
+
+// SortConfig returns the active sort as saved in config ("created", "-severity", ...),
+// or "" for the API default
+func (m IncidentsModel) SortConfig() string {
+	field, ok := m.sortState.Field.(SortField)
+	if !ok || !m.sortState.IsEnabled() {
+		return ""
+	}
+	name, ok := incidentSortNames[field]
+	if !ok {
+		return ""
+	}
+	if m.sortState.Direction == components.SortDesc {
+		return "-" + name
+	}
+	return name
+}
+
+// SetSortConfig restores a sort saved with SortConfig ("" resets to the API default).
+// Returns true if the API sort changed and the page must be reloaded.
+func (m *IncidentsModel) SetSortConfig(value string) bool {
+	if value == m.SortConfig() {
+		return false
+	}
+	prevParam := m.GetSortParam()
+	name := strings.TrimPrefix(value, "-")
+	direction := components.SortAsc
+	if strings.HasPrefix(value, "-") {
+		direction = components.SortDesc
+	}
+	m.sortState.Reset()
+	for field, n := range incidentSortNames {
+		if n == name {
+			m.sortState.Set(field, direction)
+			break
+		}
+	}
+	if m.GetSortParam() != prevParam {
+		return true
+	}
+	m.refilter()
+	return false
+}
+
 // ToggleSortMenu toggles the visibility of the sort menu
 func (m *IncidentsModel) ToggleSortMenu() {
 	m.sortMenu.Toggle()
@@ -1189,7 +1737,13 @@ func (m IncidentsModel) IsSortMenuVisible() bool {
 func (m *IncidentsModel) HandleSortMenuKey(key string) bool {
 	if selected, shouldApply := m.sortMenu.HandleKey(key); shouldApply {
 		if field, ok := selected.(SortField); ok {
-			return m.SetSort(field)
+			if m.SetSort(field) {
+				return true
+			}
+			// Client-side sorts only need the page re-ordered
+			if clientSideSort(field) {
+				m.refilter()
+			}
 		}
 	}
 	return false
@@ -1200,6 +1754,684 @@ func (m IncidentsModel) RenderSortMenu() string {
 	return m.sortMenu.Render(m.sortState.Field, m.sortState.Direction)
 }
 
+// renderTitle renders the list title, including the active team filter if any
+func (m IncidentsModel) renderTitle() string {
+	title := styles.TextBold.Render(i18n.T("incidents.title"))
+	if m.teamFilter != "" {
+		title += styles.Primary.Render("  " + i18n.Tf("teams.active", map[string]any{"Team": m.teamFilter}))
+	}
+	if m.onCallOnly {
+		title += styles.Primary.Render("  " + i18n.T("oncall.active"))
+	}
+	if m.mineUser != nil {
+		title += styles.Primary.Render("  " + i18n.T("scope.mine"))
+	}
+	if m.statusFilter != StatusFilterAll {
+		title += styles.Primary.Render("  " + i18n.Tf("incidents.status_filter.active", map[string]any{"Filter": m.statusFilter.Label()}))
+	}
+	if m.severityFilter != SeverityFilterAll {
+		title += styles.Primary.Render("  " + i18n.Tf("incidents.severity_filter.active", map[string]any{"Filter": m.severityFilter.Label()}))
+	}
+	if count := m.NeedsAckCount(); count > 0 {
+		title += styles.Warning.Bold(true).Render("  " + i18n.Tf("incidents.needs_ack_badge", map[string]any{"Count": count}))
+	}
+	if m.searching {
+		title += "  " + m.searchInput.View()
+	} else if m.searchQuery != "" {
+		title += styles.Primary.Render("  " + i18n.Tf("search.active", map[string]any{"Query": m.searchQuery}))
+	}
+	return title
+}
+
+// filterIncidentsByTeam returns the incidents that belong to the given team.
+// An empty team returns the incidents unchanged.
+func filterIncidentsByTeam(incidents []api.Incident, team string) []api.Incident {
+	if team == "" {
+		return incidents
+	}
+	filtered := make([]api.Incident, 0, len(incidents))
+	for _, inc := range incidents {
+		for _, t := range inc.Teams {
+			if strings.EqualFold(strings.TrimSpace(t), team) {
+				filtered = append(filtered, inc)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// filterIncidentsByQuery returns the incidents whose title or summary contains
+// query, case-insensitively. An empty query returns the incidents unchanged.
+func filterIncidentsByQuery(incidents []api.Incident, query string) []api.Incident {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return incidents
+	}
+	filtered := make([]api.Incident, 0, len(incidents))
+	for _, inc := range incidents {
+		if strings.Contains(strings.ToLower(inc.Title), query) || strings.Contains(strings.ToLower(inc.Summary), query) {
+			filtered = append(filtered, inc)
+		}
+	}
+	return filtered
+}
+
+// applyFilters returns the incidents matching the team, search, on-call, mine,
+// status and severity filters, in display order
+func (m IncidentsModel) applyFilters(incidents []api.Incident) []api.Incident {
+	incidents = filterIncidentsByTeam(incidents, m.teamFilter)
+	incidents = filterIncidentsByQuery(incidents, m.searchQuery)
+	if !m.onCallOnly && m.mineUser == nil && m.statusFilter == StatusFilterAll && m.severityFilter == SeverityFilterAll {
+		return m.sortIncidents(incidents)
+	}
+	filtered := make([]api.Incident, 0, len(incidents))
+	for i := range incidents {
+		if m.onCallOnly && !m.onCallScopes.Covers(&incidents[i]) {
+			continue
+		}
+		if m.mineUser != nil && !incidents[i].InvolvesUser(m.mineUser) {
+			continue
+		}
+		if !m.statusFilter.Matches(incidents[i].Status) {
+			continue
+		}
+		if !m.severityFilter.Matches(incidents[i].Severity) {
+			continue
+		}
+		filtered = append(filtered, incidents[i])
+	}
+	return m.sortIncidents(filtered)
+}
+
+// sortIncidents applies the client-side severity or status sort. Ties fall back to
+// newest first, then ID, so their order does not depend on API order and stays put
+// across refreshes.
+func (m IncidentsModel) sortIncidents(incidents []api.Incident) []api.Incident {
+	var rank func(*api.Incident) int
+	switch {
+	case m.sortState.IsField(SortBySeverity):
+		rank = func(inc *api.Incident) int { return severityRank(inc.Severity) }
+	case m.sortState.IsField(SortByStatus):
+		rank = func(inc *api.Incident) int { return statusRank(inc.Status) }
+	default:
+		return incidents
+	}
+	sorted := make([]api.Incident, len(incidents))
+	copy(sorted, incidents)
+	// Descending puts the most severe (or most active) first, i.e. the lowest rank
+	lowestFirst := m.sortState.Direction == components.SortDesc
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := &sorted[i], &sorted[j]
+		if ra, rb := rank(a), rank(b); ra != rb {
+			if lowestFirst {
+				return ra < rb
+			}
+			return ra > rb
+		}
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.ID < b.ID
+	})
+	return sorted
+}
+
+// severityRank orders severities from most (0) to least severe; unknown severities rank last
+func severityRank(severity string) int {
+	switch strings.ToLower(severity) {
+	case "critical", "sev0":
+		return 0
+	case "high", "sev1":
+		return 1
+	case "medium", "sev2":
+		return 2
+	case "low", "sev3":
+		return 3
+	default:
+		return 4
+	}
+}
+
+// statusRank orders statuses from most active (0) to closed, using the buckets
+// RenderStatus colors them by
+func statusRank(status string) int {
+	switch styles.StatusBucket(status) {
+	case styles.StatusBucketActive:
+		return 0
+	case styles.StatusBucketInProgress:
+		return 1
+	case styles.StatusBucketResolved:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// ToggleOpaqueID switches displayed IDs between sequential (INC-123) and opaque IDs
+func (m *IncidentsModel) ToggleOpaqueID() {
+	m.showOpaqueID = !m.showOpaqueID
+	width := idColWidth
+	if m.showOpaqueID {
+		width = opaqueIDColWidth
+	}
+	m.table = m.table.WithColumns(incidentColumns(width))
+	m.buildRows(m.table.GetHighlightedRowIndex())
+	m.updateViewportContent()
+}
+
+// IsShowingOpaqueID returns whether opaque IDs are displayed
+func (m IncidentsModel) IsShowingOpaqueID() bool {
+	return m.showOpaqueID
+}
+
+// SetPresentMode masks emails and links in the detail pane
+func (m *IncidentsModel) SetPresentMode(enabled bool) {
+	m.presentMode = enabled
+	m.updateViewportContent()
+}
+
+// SetRelativeTimes shows detail pane timestamps relative to now ("12m ago")
+// instead of as dates
+func (m *IncidentsModel) SetRelativeTimes(enabled bool) {
+	m.relativeTimes = enabled
+	m.updateViewportContent()
+}
+
+// formatDetailTime formats a detail pane timestamp as a date, or relative to
+// now when relative times are on
+func (m IncidentsModel) formatDetailTime(t time.Time) string {
+	if m.relativeTimes {
+		return formatRelative(t, time.Now())
+	}
+	return formatTime(t)
+}
+
+// incidentEvents is the timeline state of one incident: loading, failed or loaded
+type incidentEvents struct {
+	loading bool
+	err     string
+	events  []api.IncidentEvent
+}
+
+// SetLocation sets the timezone timeline event times are shown in
+func (m *IncidentsModel) SetLocation(loc *time.Location) {
+	m.location = loc
+	m.updateViewportContent()
+}
+
+// NeedsEvents returns whether the timeline of the incident with id hasn't been
+// fetched yet
+func (m IncidentsModel) NeedsEvents(id string) bool {
+	_, ok := m.events[id]
+	return !ok
+}
+
+// SetEventsLoading marks the timeline of the incident with id as being fetched
+func (m *IncidentsModel) SetEventsLoading(id string) {
+	if m.events == nil {
+		m.events = make(map[string]*incidentEvents)
+	}
+	m.events[id] = &incidentEvents{loading: true}
+	m.updateViewportContent()
+}
+
+// SetEvents stores the fetched timeline of the incident with id, newest first,
+// or the error that prevented fetching it
+func (m *IncidentsModel) SetEvents(id string, events []api.IncidentEvent, err error) {
+	if m.events == nil {
+		m.events = make(map[string]*incidentEvents)
+	}
+	state := &incidentEvents{events: events}
+	if err != nil {
+		state.err = err.Error()
+	}
+	m.events[id] = state
+	m.updateViewportContent()
+}
+
+// ClearEvents drops the timeline of the incident with id so it's fetched again
+func (m *IncidentsModel) ClearEvents(id string) {
+	delete(m.events, id)
+}
+
+// renderEvents renders the event timeline of the incident with id as
+// "HH:MM — actor: message" lines
+func (m IncidentsModel) renderEvents(id string) string {
+	state := m.events[id]
+	if state == nil {
+		return ""
+	}
+	switch {
+	case state.loading:
+		return styles.TextDim.Render(i18n.T("incidents.events.loading")) + "\n"
+	case state.err != "":
+		return styles.Error.Render(i18n.Tf("incidents.events.failed", map[string]any{"Error": state.err})) + "\n"
+	case len(state.events) == 0:
+		return styles.TextDim.Render(i18n.T("incidents.events.none")) + "\n"
+	}
+
+	loc := m.location
+	if loc == nil {
+		loc = time.UTC
+	}
+	var b strings.Builder
+	for _, e := range state.events {
+		message := strings.Join(strings.Fields(e.Message), " ")
+		if m.presentMode {
+			message = redactSensitive(message)
+		}
+		line := message
+		if e.Actor != "" {
+			line = e.Actor + ": " + message
+		}
+		b.WriteString(styles.TextDim.Render(e.OccurredAt.In(loc).Format("15:04")) + " — " + styles.DetailValue.Render(line) + "\n")
+	}
+	return b.String()
+}
+
+// SetShowInitials toggles initials badges next to people in the detail pane
+func (m *IncidentsModel) SetShowInitials(enabled bool) {
+	m.showInitials = enabled
+	m.updateViewportContent()
+}
+
+// renderPerson renders a person in the detail pane, with an initials badge when enabled
+func (m IncidentsModel) renderPerson(name, email string) string {
+	person := renderNameWithEmail(name, email, m.presentMode)
+	if m.showInitials {
+		person = initialsBadge(name) + person
+	}
+	return person
+}
+
+// displayID returns the incident ID shown in the detail header and copied text
+func (m IncidentsModel) displayID(inc *api.Incident) string {
+	if m.showOpaqueID {
+		return inc.ID
+	}
+	return inc.SequentialID
+}
+
+// SetOnCallScopes stores the resolved on-call scopes for the session
+func (m *IncidentsModel) SetOnCallScopes(scopes *api.OnCallScopes) {
+	m.onCallScopes = scopes
+}
+
+// OnCallScopes returns the resolved on-call scopes (nil until resolved)
+func (m IncidentsModel) OnCallScopes() *api.OnCallScopes {
+	return m.onCallScopes
+}
+
+// SetOnCallOnly toggles showing only incidents within the user's on-call scopes
+func (m *IncidentsModel) SetOnCallOnly(enabled bool) {
+	m.onCallOnly = enabled
+	m.refilter()
+}
+
+// IsOnCallOnly returns whether the on-call filter is active
+func (m IncidentsModel) IsOnCallOnly() bool {
+	return m.onCallOnly
+}
+
+// SetMineFilter restricts the list to incidents the user created or holds a role in (nil clears the filter)
+func (m *IncidentsModel) SetMineFilter(user *api.User) {
+	m.mineUser = user
+	m.refilter()
+}
+
+// IsMineOnly returns whether the mine filter is active
+func (m IncidentsModel) IsMineOnly() bool {
+	return m.mineUser != nil
+}
+
+// StatusFilter is a group of statuses the incidents list can be narrowed to
+type StatusFilter int
+
+const (
+	StatusFilterAll StatusFilter = iota
+	StatusFilterActive
+	StatusFilterResolved
+)
+
+// statusFilterNames are the names used to persist the status filter (e.g. in saved views)
+var statusFilterNames = map[StatusFilter]string{
+	StatusFilterActive:   "active",
+	StatusFilterResolved: "resolved",
+}
+
+// Matches reports whether an incident status belongs to the group. Statuses are
+// normalized like RenderStatus: active and in-progress statuses are active, the
+// rest (resolved, closed, cancelled, ...) resolved.
+func (f StatusFilter) Matches(status string) bool {
+	switch f {
+	case StatusFilterActive:
+		bucket := styles.StatusBucket(status)
+		return bucket == styles.StatusBucketActive || bucket == styles.StatusBucketInProgress
+	case StatusFilterResolved:
+		return !StatusFilterActive.Matches(status)
+	default:
+		return true
+	}
+}
+
+// String returns the persisted name of the filter ("" for all)
+func (f StatusFilter) String() string {
+	return statusFilterNames[f]
+}
+
+// Label returns the translated name of the filter
+func (f StatusFilter) Label() string {
+	switch f {
+	case StatusFilterActive:
+		return i18n.T("incidents.status_filter.group_active")
+	case StatusFilterResolved:
+		return i18n.T("incidents.status_filter.group_resolved")
+	default:
+		return i18n.T("incidents.status_filter.group_all")
+	}
+}
+
+// ParseStatusFilter parses a name saved with String; unknown names mean all
+func ParseStatusFilter(name string) StatusFilter {
+	for f, n := range statusFilterNames {
+		if n == name {
+			return f
+		}
+	}
+	return StatusFilterAll
+}
+
+// CycleStatusFilter moves to the next status filter (all → active → resolved) and returns it
+func (m *IncidentsModel) CycleStatusFilter() StatusFilter {
+	m.SetStatusFilter((m.statusFilter + 1) % (StatusFilterResolved + 1))
+	return m.statusFilter
+}
+
+// SetStatusFilter narrows the list to a status group (StatusFilterAll clears it)
+func (m *IncidentsModel) SetStatusFilter(filter StatusFilter) {
+	m.statusFilter = filter
+	m.refilter()
+}
+
+// StatusFilter returns the active status filter
+func (m IncidentsModel) StatusFilter() StatusFilter {
+	return m.statusFilter
+}
+
+// SeverityFilter is the lowest severity the incidents list shows
+type SeverityFilter int
+
+const (
+	SeverityFilterAll SeverityFilter = iota
+	SeverityFilterCritical
+	SeverityFilterHigh
+	SeverityFilterMedium
+)
+
+// severityFilterNames are the names used to persist the severity filter (e.g. in saved views)
+var severityFilterNames = map[SeverityFilter]string{
+	SeverityFilterCritical: "critical",
+	SeverityFilterHigh:     "high",
+	SeverityFilterMedium:   "medium",
+}
+
+// Matches reports whether a severity is at or above the threshold. Severities
+// are bucketed like severityStyle (sev0 is critical, sev1 high, ...); unknown or
+// empty ones rank below low, so any threshold hides them.
+func (f SeverityFilter) Matches(severity string) bool {
+	if f == SeverityFilterAll {
+		return true
+	}
+	// Thresholds are declared in rank order: critical (rank 0) is the first after all
+	return severityRank(severity) <= int(f-SeverityFilterCritical)
+}
+
+// String returns the persisted name of the filter ("" for all)
+func (f SeverityFilter) String() string {
+	return severityFilterNames[f]
+}
+
+// Label returns the translated name of the filter
+func (f SeverityFilter) Label() string {
+	switch f {
+	case SeverityFilterCritical:
+		return i18n.T("incidents.severity_filter.critical")
+	case SeverityFilterHigh:
+		return i18n.T("incidents.severity_filter.high")
+	case SeverityFilterMedium:
+		return i18n.T("incidents.severity_filter.medium")
+	default:
+		return i18n.T("incidents.severity_filter.all")
+	}
+}
+
+// ParseSeverityFilter parses a name saved with String; unknown names mean all
+func ParseSeverityFilter(name string) SeverityFilter {
+	for f, n := range severityFilterNames {
+		if n == name {
+			return f
+		}
+	}
+	return SeverityFilterAll
+}
+
+// CycleSeverityFilter moves to the next severity threshold (all → critical →
+// high+ → medium+) and returns it
+func (m *IncidentsModel) CycleSeverityFilter() SeverityFilter {
+	m.SetSeverityFilter((m.severityFilter + 1) % (SeverityFilterMedium + 1))
+	return m.severityFilter
+}
+
+// SetSeverityFilter hides incidents below a severity (SeverityFilterAll clears it)
+func (m *IncidentsModel) SetSeverityFilter(filter SeverityFilter) {
+	m.severityFilter = filter
+	m.refilter()
+}
+
+// SeverityFilter returns the active severity filter
+func (m IncidentsModel) SeverityFilter() SeverityFilter {
+	return m.severityFilter
+}
+
+// Incidents returns the incidents currently listed (after filters)
+func (m IncidentsModel) Incidents() []api.Incident {
+	return m.incidents
+}
+
+// SetTeamFilter restricts the list to incidents of the given team ("" clears
+// the filter). The API filters by team as well, so a change moves back to the
+// first page and returns true for the caller to reload it.
+func (m *IncidentsModel) SetTeamFilter(team string) bool {
+	changed := team != m.teamFilter
+	m.teamFilter = team
+	if changed {
+		m.SetPage(1)
+	}
+	m.refilter()
+	return changed
+}
+
+// ActiveCount returns how many listed incidents are active (not resolved,
+// closed or otherwise over), honoring the configured status map
+func (m IncidentsModel) ActiveCount() int {
+	count := 0
+	for i := range m.incidents {
+		if StatusFilterActive.Matches(m.incidents[i].Status) {
+			count++
+		}
+	}
+	return count
+}
+
+// NeedsAckCount returns how many listed incidents are open and unacknowledged
+func (m IncidentsModel) NeedsAckCount() int {
+	count := 0
+	for i := range m.incidents {
+		if m.incidents[i].NeedsAck() {
+			count++
+		}
+	}
+	return count
+}
+
+// JumpToNextNeedsAck moves the cursor to the next open, unacknowledged incident
+// (wrapping around). Returns false when there is none.
+func (m *IncidentsModel) JumpToNextNeedsAck() bool {
+	next := components.NextMatching(m.incidents, m.table.GetHighlightedRowIndex(), (*api.Incident).NeedsAck)
+	if next < 0 {
+		return false
+	}
+	m.table = m.table.WithHighlightedRow(next)
+	m.updateRowIndicators()
+	m.updateViewportContent()
+	return true
+}
+
+// SelectIndex moves the cursor to row i, clamped to the listed incidents
+func (m *IncidentsModel) SelectIndex(i int) {
+	if len(m.incidents) == 0 {
+		return
+	}
+	m.table = m.table.WithHighlightedRow(min(max(i, 0), len(m.incidents)-1))
+	m.updateRowIndicators()
+	m.updateViewportContent()
+}
+
+// SelectIncidentRef moves the cursor to the listed incident matching ref (INC-123,
+// 123 or an incident ID). Returns false when it isn't on the page.
+func (m *IncidentsModel) SelectIncidentRef(ref string) bool {
+	ref = strings.TrimSpace(ref)
+	seq, isSeq := api.ParseSequentialRef(ref)
+	for i := range m.incidents {
+		inc := &m.incidents[i]
+		if n, ok := api.ParseSequentialRef(inc.SequentialID); (isSeq && ok && n == seq) || strings.EqualFold(inc.ID, ref) {
+			m.table = m.table.WithHighlightedRow(i)
+			m.updateRowIndicators()
+			m.updateViewportContent()
+			return true
+		}
+	}
+	return false
+}
+
+// Filter narrows the loaded page to incidents whose title or summary contains
+// query (case-insensitive) without a new API call; "" restores the full page
+func (m *IncidentsModel) Filter(query string) {
+	m.searchQuery = strings.TrimSpace(query)
+	m.refilter()
+}
+
+// SearchQuery returns the active search filter (empty if none)
+func (m IncidentsModel) SearchQuery() string {
+	return m.searchQuery
+}
+
+// StartSearch opens the search prompt, pre-filled with the active query
+func (m *IncidentsModel) StartSearch() tea.Cmd {
+	m.searching = true
+	m.searchInput.SetValue(m.searchQuery)
+	m.searchInput.CursorEnd()
+	return m.searchInput.Focus()
+}
+
+// IsSearching returns whether the search prompt is capturing input
+func (m IncidentsModel) IsSearching() bool {
+	return m.searching
+}
+
+// HandleSearchKey handles typing in the search prompt, filtering as the query
+// changes. Enter keeps the filter, Esc clears it; both close the prompt.
+func (m *IncidentsModel) HandleSearchKey(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		m.searching = false
+		m.searchInput.Blur()
+		return nil
+	case "esc":
+		m.searching = false
+		m.searchInput.Blur()
+		m.Filter("")
+		return nil
+	}
+	var cmd tea.Cmd
+	m.searchInput, cmd = m.searchInput.Update(msg)
+	if query := strings.TrimSpace(m.searchInput.Value()); query != m.searchQuery {
+		m.Filter(query)
+	}
+	return cmd
+}
+
+// refilter re-applies the active filters to the loaded page and resets the cursor
+func (m *IncidentsModel) refilter() {
+	m.incidents = m.applyFilters(m.allIncidents)
+	m.table = m.table.WithHighlightedRow(0)
+	m.buildRows(0)
+	if len(m.incidents) == 0 {
+		return
+	}
+	m.updateViewportContent()
+}
+
+// TeamFilter returns the active team filter (empty if none)
+func (m IncidentsModel) TeamFilter() string {
+	return m.teamFilter
+}
+
+// LoadedTeams returns the unique team names across the loaded incidents, sorted
+func (m IncidentsModel) LoadedTeams() []string {
+	seen := make(map[string]bool)
+	var teams []string
+	for _, inc := range m.allIncidents {
+		for _, t := range inc.Teams {
+			t = strings.TrimSpace(t)
+			if t == "" || seen[strings.ToLower(t)] {
+				continue
+			}
+			seen[strings.ToLower(t)] = true
+			teams = append(teams, t)
+		}
+	}
+	sort.Slice(teams, func(i, j int) bool {
+		return strings.ToLower(teams[i]) < strings.ToLower(teams[j])
+	})
+	return teams
+}
+
+// SetTeamOptions populates the team picker, with "All teams" as the first entry
+func (m *IncidentsModel) SetTeamOptions(teams []string) {
+	options := make([]components.PickerOption, 0, len(teams)+1)
+	options = append(options, components.PickerOption{Label: i18n.T("teams.all"), Value: ""})
+	for _, t := range teams {
+		options = append(options, components.PickerOption{Label: t, Value: t})
+	}
+	m.teamPicker.SetOptions(options)
+}
+
+// ToggleTeamPicker toggles the visibility of the team picker
+func (m *IncidentsModel) ToggleTeamPicker() {
+	m.teamPicker.Toggle()
+}
+
+// IsTeamPickerVisible returns whether the team picker is visible
+func (m IncidentsModel) IsTeamPickerVisible() bool {
+	return m.teamPicker.IsVisible()
+}
+
+// HandleTeamPickerKey handles keyboard input for the team picker
+// Returns true if the team filter changed
+func (m *IncidentsModel) HandleTeamPickerKey(key string) bool {
+	if team, shouldApply := m.teamPicker.HandleKey(key); shouldApply {
+		return m.SetTeamFilter(team)
+	}
+	return false
+}
+
+// RenderTeamPicker renders the team picker overlay
+func (m IncidentsModel) RenderTeamPicker() string {
+	return m.teamPicker.Render(m.teamFilter)
+}
+
 // isIncidentURL checks if a string looks like a URL
 func isIncidentURL(s string) bool {
 	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
@@ -1217,7 +2449,7 @@ func (m IncidentsModel) renderLabelValue(value string) string {
 		if len(displayURL) > maxLen {
 			displayURL = displayURL[:maxLen-3] + "..."
 		}
-		return styles.RenderLink(value, displayURL)
+		return renderLink(value, displayURL, m.presentMode)
 	}
 	return styles.DetailValue.Render(value)
 }
@@ -1289,6 +2521,60 @@ func formatRetroStatus(status string) string {
 	}
 }
 
+// SelectedContactCard returns the contact card of the selected incident's commander
+func (m IncidentsModel) SelectedContactCard() string {
+	return contactCard(m.SelectedIncident())
+}
+
+// contactCard formats the commander as "Name <email>", falling back to the
+// communications lead. Returns an empty string if neither role is assigned.
+func contactCard(inc *api.Incident) string {
+	if inc == nil {
+		return ""
+	}
+	var lead *api.IncidentRole
+	for i := range inc.Roles {
+		role := &inc.Roles[i]
+		if strings.TrimSpace(role.UserName) == "" {
+			continue
+		}
+		if strings.EqualFold(role.Name, "commander") {
+			lead = role
+			break
+		}
+		if lead == nil && strings.Contains(strings.ToLower(role.Name), "communications") {
+			lead = role
+		}
+	}
+	if lead == nil {
+		return ""
+	}
+	card := strings.TrimSpace(lead.UserName)
+	if email := strings.TrimSpace(lead.UserEmail); email != "" {
+		card += " <" + email + ">"
+	}
+	return card
+}
+
+// SelectedDetailText returns the selected incident's detail pane as plain text
+func (m IncidentsModel) SelectedDetailText() string {
+	inc := m.SelectedIncident()
+	if inc == nil {
+		return ""
+	}
+	return m.detailPlainText(inc)
+}
+
+// detailPlainText renders the detail pane of inc without styling: the same
+// sections, labels and values, minus escape codes and trailing padding
+func (m IncidentsModel) detailPlainText(inc *api.Incident) string {
+	lines := strings.Split(ansi.Strip(m.generateDetailContent(inc)), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n")) + "\n"
+}
+
 // GetDetailPlainText returns the detail panel content as plain text for clipboard
 func (m IncidentsModel) GetDetailPlainText() string {
 	inc := m.SelectedIncident()
@@ -1309,8 +2595,8 @@ func (m IncidentsModel) generatePlainTextDetail(inc *api.Incident) string {
 	}
 	title = strings.ReplaceAll(title, "\n", " ")
 	title = strings.ReplaceAll(title, "\r", "")
-	if inc.SequentialID != "" {
-		b.WriteString("[" + inc.SequentialID + "] ")
+	if id := m.displayID(inc); id != "" {
+		b.WriteString("[" + id + "] ")
 	}
 	b.WriteString(title)
 	b.WriteString("\n\n")
@@ -1330,13 +2616,7 @@ func (m IncidentsModel) generatePlainTextDetail(inc *api.Incident) string {
 	b.WriteString("\n\n")
 
 	// Links
-	rootlyURL := inc.ShortURL
-	if rootlyURL == "" {
-		rootlyURL = inc.URL
-	}
-	if rootlyURL == "" && inc.ID != "" {
-		rootlyURL = fmt.Sprintf("https://rootly.com/account/incidents/%s", inc.ID)
-	}
+	rootlyURL := api.ResolveIncidentURL(inc)
 	if inc.SlackChannelURL != "" || inc.JiraIssueURL != "" || rootlyURL != "" {
 		b.WriteString("Links\n")
 		if rootlyURL != "" {
@@ -1415,6 +2695,17 @@ func (m IncidentsModel) generatePlainTextDetail(inc *api.Incident) string {
 			}
 		}
 
+		if len(inc.ActionItems) > 0 {
+			b.WriteString("\nAction Items\n")
+			for _, task := range inc.ActionItems {
+				b.WriteString("  " + taskCheckbox(task) + " " + task.Title)
+				if task.AssigneeName != "" {
+					b.WriteString(" (" + task.AssigneeName + ")")
+				}
+				b.WriteString("\n")
+			}
+		}
+
 		if len(inc.Labels) > 0 {
 			b.WriteString("\nLabels\n")
 			for k, v := range inc.Labels {
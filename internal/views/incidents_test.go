@@ -1,13 +1,20 @@
 package views
 
 import (
+	"errors"
+	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
 
 	"github.com/rootlyhq/rootly-tui/internal/api"
+	"github.com/rootlyhq/rootly-tui/internal/config"
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
 )
 
 func TestNewIncidentsModel(t *testing.T) {
@@ -140,6 +147,9 @@ func TestIncidentsModelView(t *testing.T) {
 	if !strings.Contains(view, "Loading") {
 		t.Error("expected 'Loading' in loading view")
 	}
+	if !strings.Contains(view, "░░░") {
+		t.Error("expected a skeleton in the detail pane while loading")
+	}
 
 	// Test error view
 	m.SetLoading(false)
@@ -380,6 +390,56 @@ func TestIncidentsModelSetIncidentsCursorAdjustment(t *testing.T) {
 	}
 }
 
+func TestIncidentsModelSetIncidentsPreservesSelectedID(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(100, 30)
+	incidents := []api.Incident{
+		{ID: "a", SequentialID: "INC-1", Title: "First"},
+		{ID: "b", SequentialID: "INC-2", Title: "Second"},
+		{ID: "c", SequentialID: "INC-3", Title: "Third"},
+	}
+	m.SetIncidents(incidents, api.PaginationInfo{CurrentPage: 1})
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	if sel := m.SelectedIncident(); sel == nil || sel.ID != "b" {
+		t.Fatalf("expected incident b selected before refresh, got %v", sel)
+	}
+
+	// Refresh with a new incident on top and a different order
+	refreshed := []api.Incident{
+		{ID: "d", SequentialID: "INC-4", Title: "Fourth"},
+		incidents[2],
+		incidents[0],
+		incidents[1],
+	}
+	m.SetIncidents(refreshed, api.PaginationInfo{CurrentPage: 1})
+
+	if sel := m.SelectedIncident(); sel == nil || sel.ID != "b" {
+		t.Errorf("expected selection to stay on incident b, got %v", sel)
+	}
+	if m.SelectedIndex() != 3 {
+		t.Errorf("expected cursor at index 3, got %d", m.SelectedIndex())
+	}
+}
+
+func TestIncidentsModelSetIncidentsSelectedIDGone(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(100, 30)
+	incidents := []api.Incident{
+		{ID: "a", SequentialID: "INC-1"},
+		{ID: "b", SequentialID: "INC-2"},
+		{ID: "c", SequentialID: "INC-3"},
+	}
+	m.SetIncidents(incidents, api.PaginationInfo{CurrentPage: 1})
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'G', Text: "G"})
+
+	// Selected incident disappears; cursor falls back to clamping
+	m.SetIncidents(incidents[:2], api.PaginationInfo{CurrentPage: 1})
+
+	if m.SelectedIndex() != 1 {
+		t.Errorf("expected cursor clamped to 1, got %d", m.SelectedIndex())
+	}
+}
+
 func TestIncidentsModelWindowSizeMsg(t *testing.T) {
 	m := NewIncidentsModel()
 
@@ -934,6 +994,38 @@ func TestIncidentsModelLayoutDimensions(t *testing.T) {
 	}
 }
 
+func TestIncidentsModelNarrowStacks(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetLayout("horizontal")
+	m.SetIncidents(api.MockIncidents(), api.PaginationInfo{CurrentPage: 1})
+
+	// An 80-column terminal stacks the panes even with the horizontal layout
+	m.SetDimensions(76, 40)
+	if !m.stacked() {
+		t.Fatal("expected a narrow view to stack its panes")
+	}
+	if m.listWidth != m.detailWidth || m.listWidth != 74 {
+		t.Errorf("expected full-width panes, got list=%d detail=%d", m.listWidth, m.detailWidth)
+	}
+	if total := m.listHeight + m.detailHeight; total != 38 {
+		t.Errorf("expected the panes to split the height, got %d", total)
+	}
+	for i, line := range strings.Split(m.View(), "\n") {
+		if w := lipgloss.Width(line); w > 76 {
+			t.Errorf("line %d is %d columns wide, want at most 76", i, w)
+		}
+	}
+
+	// Widening the terminal goes back to side by side
+	m.SetDimensions(140, 40)
+	if m.stacked() {
+		t.Error("expected a wide view to keep the horizontal layout")
+	}
+	if m.listWidth >= 140-6 {
+		t.Errorf("expected a half-width list, got %d", m.listWidth)
+	}
+}
+
 func TestIncidentsModelVerticalLayoutView(t *testing.T) {
 	m := NewIncidentsModel()
 	m.SetDimensions(100, 50)
@@ -985,3 +1077,893 @@ func TestIncidentsModelLayoutPageSize(t *testing.T) {
 		t.Error("expected non-zero heights after layout set")
 	}
 }
+
+func TestIncidentsModelTeamFilter(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(100, 50)
+	m.SetIncidents(api.MockIncidents(), api.PaginationInfo{CurrentPage: 1})
+
+	m.SetTeamFilter("Platform")
+
+	if m.TeamFilter() != "Platform" {
+		t.Errorf("expected team filter 'Platform', got %q", m.TeamFilter())
+	}
+	if len(m.incidents) != 2 {
+		t.Fatalf("expected 2 incidents for Platform, got %d", len(m.incidents))
+	}
+	for _, inc := range m.incidents {
+		found := false
+		for _, team := range inc.Teams {
+			if team == "Platform" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("incident %s does not belong to Platform: %v", inc.ID, inc.Teams)
+		}
+	}
+
+	view := stripANSI(m.View())
+	if !strings.Contains(view, "Team: Platform") {
+		t.Error("expected active team in list title")
+	}
+
+	if m.SetTeamFilter("Platform") {
+		t.Error("expected setting the same team not to ask for a reload")
+	}
+
+	// Clearing the filter restores all incidents
+	if !m.SetTeamFilter("") {
+		t.Error("expected clearing the team to ask for a reload")
+	}
+	if len(m.incidents) != len(api.MockIncidents()) {
+		t.Errorf("expected all incidents after clearing filter, got %d", len(m.incidents))
+	}
+}
+
+func TestIncidentsModelFilter(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(100, 50)
+	m.SetIncidents([]api.Incident{
+		{ID: "inc_1", SequentialID: "INC-1", Title: "Checkout latency"},
+		{ID: "inc_2", SequentialID: "INC-2", Title: "Login errors", Summary: "Elevated 500s after the checkout deploy"},
+		{ID: "inc_3", SequentialID: "INC-3", Title: "Disk full"},
+	}, api.PaginationInfo{CurrentPage: 1})
+	m.table = m.table.WithHighlightedRow(2)
+
+	m.Filter("CHECKOUT")
+
+	if len(m.incidents) != 2 || m.incidents[0].ID != "inc_1" || m.incidents[1].ID != "inc_2" {
+		t.Fatalf("expected title and summary matches, got %+v", m.incidents)
+	}
+	if inc := m.SelectedIncident(); inc == nil || inc.ID != "inc_1" {
+		t.Errorf("expected the selection to follow the filtered list, got %+v", inc)
+	}
+	if view := stripANSI(m.View()); !strings.Contains(view, "(1-2)") {
+		t.Error("expected the footer count to reflect the filtered list")
+	}
+
+	// Esc clears the filter and restores the page
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.SearchQuery() != "" || len(m.incidents) != 3 {
+		t.Errorf("expected esc to restore all incidents, got %d (query %q)", len(m.incidents), m.SearchQuery())
+	}
+}
+
+func TestIncidentsModelSeverityFilter(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(100, 50)
+	// critical, high, medium, low, high, plus one without a severity
+	page := append(api.MockIncidents(), api.Incident{ID: "inc_006", SequentialID: "INC-144"})
+	m.SetIncidents(page, api.PaginationInfo{CurrentPage: 1})
+
+	tests := []struct {
+		want  SeverityFilter
+		count int
+		label string
+	}{
+		{SeverityFilterCritical, 1, "(critical)"},
+		{SeverityFilterHigh, 3, "(high+)"},
+		{SeverityFilterMedium, 4, "(medium+)"},
+		{SeverityFilterAll, 6, ""},
+	}
+	for _, tt := range tests {
+		if got := m.CycleSeverityFilter(); got != tt.want {
+			t.Fatalf("expected filter %v, got %v", tt.want, got)
+		}
+		if len(m.incidents) != tt.count {
+			t.Errorf("%v: expected %d incidents, got %d", tt.want, tt.count, len(m.incidents))
+		}
+		if tt.label != "" && !strings.Contains(stripANSI(m.View()), tt.label) {
+			t.Errorf("%v: expected %q in the list title", tt.want, tt.label)
+		}
+	}
+
+	// The filter survives loading another page
+	m.SetSeverityFilter(SeverityFilterHigh)
+	m.SetIncidents(page[2:], api.PaginationInfo{CurrentPage: 2})
+	if len(m.incidents) != 1 || m.incidents[0].ID != "inc_005" {
+		t.Errorf("expected the filter re-applied to the new page, got %+v", m.incidents)
+	}
+	if ParseSeverityFilter(SeverityFilterMedium.String()) != SeverityFilterMedium || ParseSeverityFilter("bogus") != SeverityFilterAll {
+		t.Error("expected severity filter names to round-trip")
+	}
+}
+
+func TestIncidentsModelStatusFilter(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(100, 50)
+	page := []api.Incident{
+		{ID: "inc_1", SequentialID: "INC-1", Status: "started"},
+		{ID: "inc_2", SequentialID: "INC-2", Status: "resolved"},
+		{ID: "inc_3", SequentialID: "INC-3", Status: "triggered"},
+		{ID: "inc_4", SequentialID: "INC-4", Status: "cancelled"},
+	}
+	m.SetIncidents(page, api.PaginationInfo{CurrentPage: 1})
+
+	if got := m.CycleStatusFilter(); got != StatusFilterActive {
+		t.Fatalf("expected all → active, got %v", got)
+	}
+	if len(m.incidents) != 2 || m.incidents[0].ID != "inc_1" || m.incidents[1].ID != "inc_3" {
+		t.Errorf("expected the started and triggered incidents, got %+v", m.incidents)
+	}
+	if view := stripANSI(m.View()); !strings.Contains(view, "(active)") {
+		t.Error("expected the filter in the list title")
+	}
+
+	// The filter survives loading another page
+	m.SetIncidents(page[1:], api.PaginationInfo{CurrentPage: 2})
+	if len(m.incidents) != 1 || m.incidents[0].ID != "inc_3" {
+		t.Errorf("expected the filter re-applied to the new page, got %+v", m.incidents)
+	}
+
+	if got := m.CycleStatusFilter(); got != StatusFilterResolved {
+		t.Fatalf("expected active → resolved, got %v", got)
+	}
+	if len(m.incidents) != 2 || m.incidents[0].ID != "inc_2" || m.incidents[1].ID != "inc_4" {
+		t.Errorf("expected the resolved and cancelled incidents, got %+v", m.incidents)
+	}
+	if got := m.CycleStatusFilter(); got != StatusFilterAll || len(m.incidents) != 3 {
+		t.Errorf("expected resolved → all to show the whole page, got %v with %d", got, len(m.incidents))
+	}
+	if ParseStatusFilter(StatusFilterResolved.String()) != StatusFilterResolved || ParseStatusFilter("bogus") != StatusFilterAll {
+		t.Error("expected status filter names to round-trip")
+	}
+}
+
+func TestIncidentsModelJumpToNextNeedsAck(t *testing.T) {
+	acked := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	m := NewIncidentsModel()
+	m.SetDimensions(100, 50)
+	m.SetIncidents([]api.Incident{
+		{ID: "inc_1", Status: "open"},
+		{ID: "inc_2", Status: "open", AcknowledgedAt: &acked},
+		{ID: "inc_3", Status: "resolved"},
+		{ID: "inc_4", Status: "triggered"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	if got := m.NeedsAckCount(); got != 2 {
+		t.Errorf("expected 2 incidents awaiting ack, got %d", got)
+	}
+	if view := stripANSI(m.View()); !strings.Contains(view, "2 awaiting ack") {
+		t.Error("expected the awaiting ack badge in the list title")
+	}
+
+	for _, want := range []string{"inc_4", "inc_1", "inc_4"} {
+		if !m.JumpToNextNeedsAck() {
+			t.Fatal("expected a jump")
+		}
+		if got := m.SelectedIncident().ID; got != want {
+			t.Errorf("expected to land on %s, got %s", want, got)
+		}
+	}
+
+	m.SetIncidents([]api.Incident{{ID: "inc_2", Status: "open", AcknowledgedAt: &acked}}, api.PaginationInfo{CurrentPage: 1})
+	if m.JumpToNextNeedsAck() {
+		t.Error("expected no jump without incidents awaiting ack")
+	}
+}
+
+func TestIncidentsModelSelectIncidentRef(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(100, 50)
+	m.SetIncidents(api.MockIncidents(), api.PaginationInfo{CurrentPage: 1})
+
+	for ref, want := range map[string]string{"INC-140": "inc_003", "141": "inc_002", "inc-139": "inc_004", "inc_005": "inc_005"} {
+		if !m.SelectIncidentRef(ref) {
+			t.Errorf("expected %q to be found on the page", ref)
+			continue
+		}
+		if got := m.SelectedIncident().ID; got != want {
+			t.Errorf("SelectIncidentRef(%q) selected %s, want %s", ref, got, want)
+		}
+	}
+
+	before := m.SelectedIncident().ID
+	if m.SelectIncidentRef("INC-9999") {
+		t.Error("expected an incident not on the page to be reported missing")
+	}
+	if m.SelectedIncident().ID != before {
+		t.Error("expected the cursor to stay put when the incident isn't on the page")
+	}
+}
+
+func TestIncidentsModelSearchPrompt(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetIncidents([]api.Incident{
+		{ID: "inc_1", Title: "Checkout latency"},
+		{ID: "inc_2", Title: "Disk full"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	m.StartSearch()
+	for _, r := range "disk" {
+		m.HandleSearchKey(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	if !m.IsSearching() || len(m.incidents) != 1 || m.incidents[0].ID != "inc_2" {
+		t.Fatalf("expected the list to filter as the query is typed, got %+v", m.incidents)
+	}
+
+	m.HandleSearchKey(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.IsSearching() || m.SearchQuery() != "disk" {
+		t.Errorf("expected enter to keep the filter and close the prompt, got query %q", m.SearchQuery())
+	}
+
+	m.StartSearch()
+	m.HandleSearchKey(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.IsSearching() || m.SearchQuery() != "" || len(m.incidents) != 2 {
+		t.Errorf("expected esc to clear the filter, got %d incidents", len(m.incidents))
+	}
+}
+
+func TestIncidentsModelTeamFilterSurvivesReload(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetTeamFilter("backend")
+	m.SetIncidents(api.MockIncidents(), api.PaginationInfo{CurrentPage: 1})
+
+	// Matching is case-insensitive
+	if len(m.incidents) != 1 || m.incidents[0].Teams[0] != "Backend" {
+		t.Errorf("expected only the Backend incident, got %d incidents", len(m.incidents))
+	}
+}
+
+func TestIncidentsModelTeamFilterNoMatch(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetIncidents(api.MockIncidents(), api.PaginationInfo{CurrentPage: 1})
+
+	m.SetTeamFilter("Nonexistent")
+
+	if m.SelectedIncident() != nil {
+		t.Error("expected no selection when no incidents match")
+	}
+	if !strings.Contains(m.View(), "Nonexistent") {
+		t.Error("expected empty-state message to mention the team")
+	}
+}
+
+func TestIncidentsModelLoadedTeams(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetIncidents(api.MockIncidents(), api.PaginationInfo{CurrentPage: 1})
+
+	teams := m.LoadedTeams()
+	expected := []string{"Backend", "DevOps", "Infrastructure", "Platform", "Security", "SRE"}
+	if len(teams) != len(expected) {
+		t.Fatalf("expected %d teams, got %v", len(expected), teams)
+	}
+	for i, team := range expected {
+		if teams[i] != team {
+			t.Errorf("expected teams[%d] = %q, got %q", i, team, teams[i])
+		}
+	}
+}
+
+func TestIncidentsModelTeamPicker(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetIncidents(api.MockIncidents(), api.PaginationInfo{CurrentPage: 1})
+	m.SetTeamOptions([]string{"Backend", "Platform"})
+
+	m.ToggleTeamPicker()
+	if !m.IsTeamPickerVisible() {
+		t.Fatal("expected team picker to be visible")
+	}
+	if !strings.Contains(m.RenderTeamPicker(), "All teams") {
+		t.Error("expected 'All teams' option in picker")
+	}
+
+	// Move to "Platform" (All teams, Backend, Platform) and select
+	m.HandleTeamPickerKey("j")
+	m.HandleTeamPickerKey("j")
+	if changed := m.HandleTeamPickerKey("enter"); !changed {
+		t.Error("expected filter change on enter")
+	}
+	if m.TeamFilter() != "Platform" {
+		t.Errorf("expected team filter 'Platform', got %q", m.TeamFilter())
+	}
+	if m.IsTeamPickerVisible() {
+		t.Error("expected picker to close after selection")
+	}
+}
+
+func TestIncidentsModelRowIndicatorFollowsCursor(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(100, 30)
+	m.SetIncidents(api.MockIncidents(), api.PaginationInfo{CurrentPage: 1})
+
+	for _, key := range []string{"j", "j", "k", "G", "g"} {
+		m, _ = m.Update(tea.KeyPressMsg{Code: rune(key[0]), Text: key})
+
+		cursor := m.SelectedIndex()
+		for i, row := range m.rows {
+			hasIndicator := row.Data[colKeyIndicator] == rowIndicator
+			if hasIndicator != (i == cursor) {
+				t.Errorf("after %q: row %d indicator=%v, cursor at %d", key, i, hasIndicator, cursor)
+			}
+		}
+	}
+}
+
+func TestIncidentsModelNewBadges(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(100, 30)
+	incidents := []api.Incident{{ID: "inc_1", Title: "Old"}, {ID: "inc_2", Title: "Older"}}
+	m.SetIncidents(incidents, api.PaginationInfo{CurrentPage: 1})
+	if m.HasNewBadges() {
+		t.Fatal("expected the first load not to badge anything")
+	}
+
+	// A reload of the same page badges the incident it adds
+	m.SetIncidents(append([]api.Incident{{ID: "inc_3", Title: "Fresh"}}, incidents...), api.PaginationInfo{CurrentPage: 1})
+	if !m.IsNew("inc_3") || m.IsNew("inc_1") {
+		t.Fatalf("expected only inc_3 to be new")
+	}
+	index := m.IndexOf("inc_3")
+	if m.SelectedIndex() == index {
+		t.Fatal("expected the cursor to stay on inc_1")
+	}
+	if !strings.Contains(stripANSI(m.View()), rowNewBadge) {
+		t.Error("expected a NEW badge in the list")
+	}
+
+	// The cursor reaching the row clears its badge
+	m.SelectIndex(index)
+	if m.IsNew("inc_3") {
+		t.Error("expected the cursor to clear the badge")
+	}
+
+	// Badges expire, and other pages don't count as new arrivals
+	m.SetIncidents(append([]api.Incident{{ID: "inc_4"}}, m.allIncidents...), api.PaginationInfo{CurrentPage: 1})
+	m.ExpireNewBadges(time.Now().Add(NewBadgeDuration))
+	if m.HasNewBadges() || strings.Contains(stripANSI(m.View()), rowNewBadge) {
+		t.Error("expected badges to expire")
+	}
+	m.SetIncidents([]api.Incident{{ID: "inc_9"}}, api.PaginationInfo{CurrentPage: 2})
+	if m.HasNewBadges() {
+		t.Error("expected another page not to be badged")
+	}
+}
+
+func benchmarkIncidents(n int) []api.Incident {
+	incidents := make([]api.Incident, n)
+	now := time.Now()
+	for i := range incidents {
+		incidents[i] = api.Incident{
+			ID:           "id-" + strconv.Itoa(i),
+			SequentialID: "INC-" + strconv.Itoa(i+1),
+			Title:        "Database latency spike in primary region",
+			Status:       "started",
+			Severity:     "SEV1",
+			CreatedAt:    now.Add(-time.Duration(i) * time.Minute),
+		}
+	}
+	return incidents
+}
+
+func BenchmarkIncidentsRowsFullRebuild(b *testing.B) {
+	m := NewIncidentsModel()
+	m.SetDimensions(200, 60)
+	m.SetIncidents(benchmarkIncidents(500), api.PaginationInfo{CurrentPage: 1})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.buildRows(i % 500)
+	}
+}
+
+func BenchmarkIncidentsRowsIndicatorUpdate(b *testing.B) {
+	m := NewIncidentsModel()
+	m.SetDimensions(200, 60)
+	m.SetIncidents(benchmarkIncidents(500), api.PaginationInfo{CurrentPage: 1})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.table = m.table.WithHighlightedRow(i % 500)
+		m.updateRowIndicators()
+	}
+}
+
+func TestStatusStyleCustomStatusMap(t *testing.T) {
+	if got := statusStyle("triaging").GetForeground(); got != styles.ColorPastelGray {
+		t.Errorf("expected unmapped status to be gray, got %v", got)
+	}
+
+	styles.SetStatusMap(map[string]string{"triaging": "in_progress"})
+	defer styles.SetStatusMap(nil)
+
+	if got := statusStyle("triaging").GetForeground(); got != styles.ColorPastelYellow {
+		t.Errorf("expected mapped status to be yellow, got %v", got)
+	}
+}
+
+func TestIncidentsModelOnCallFilter(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(100, 50)
+	m.SetIncidents([]api.Incident{
+		{ID: "1", SequentialID: "INC-1", Services: []string{"payments"}},
+		{ID: "2", SequentialID: "INC-2", Teams: []string{"Platform"}},
+		{ID: "3", SequentialID: "INC-3", Services: []string{"web"}, Teams: []string{"Mobile"}},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	m.SetOnCallScopes(&api.OnCallScopes{Services: []string{"payments"}, Teams: []string{"platform"}})
+	m.SetOnCallOnly(true)
+
+	if !m.IsOnCallOnly() {
+		t.Error("expected on-call filter to be active")
+	}
+	if len(m.incidents) != 2 {
+		t.Fatalf("expected 2 on-call incidents, got %d", len(m.incidents))
+	}
+	if !strings.Contains(stripANSI(m.View()), "On-call") {
+		t.Error("expected on-call marker in list title")
+	}
+
+	// Refresh keeps the filter applied
+	m.SetIncidents(append(m.allIncidents, api.Incident{ID: "4", Services: []string{"web"}}), api.PaginationInfo{CurrentPage: 1})
+	if len(m.incidents) != 2 {
+		t.Errorf("expected filter to survive reload, got %d incidents", len(m.incidents))
+	}
+
+	m.SetOnCallOnly(false)
+	if len(m.incidents) != 4 {
+		t.Errorf("expected all incidents after clearing filter, got %d", len(m.incidents))
+	}
+}
+
+func TestIncidentsModelToggleOpaqueID(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(150, 40)
+	m.SetIncidents([]api.Incident{
+		{ID: "0f8c2a4e-1b2c-4d5e-9f00-123456789abc", SequentialID: "INC-123", Title: "Database outage"},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	if got := m.rows[0].Data[colKeyID]; got != "INC-123" {
+		t.Fatalf("expected ID cell INC-123, got %v", got)
+	}
+
+	m.ToggleOpaqueID()
+
+	if !m.IsShowingOpaqueID() {
+		t.Error("expected opaque IDs to be shown")
+	}
+	if got := m.rows[0].Data[colKeyID]; got != "0f8c2a4e-1b2c-4d5e-9f00-123456789abc" {
+		t.Errorf("expected opaque ID cell, got %v", got)
+	}
+	if !strings.Contains(stripANSI(m.View()), "0f8c2a4e-1b2c-4d5e-9f00-123456789abc") {
+		t.Error("expected opaque ID in rendered view")
+	}
+	if !strings.Contains(m.GetDetailPlainText(), "[0f8c2a4e-1b2c-4d5e-9f00-123456789abc]") {
+		t.Error("expected copied detail to use the opaque ID")
+	}
+
+	m.ToggleOpaqueID()
+	if got := m.rows[0].Data[colKeyID]; got != "INC-123" {
+		t.Errorf("expected ID cell back to INC-123, got %v", got)
+	}
+}
+
+func TestIncidentsModelPresentModeMasksEmailsAndLinks(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(150, 50)
+	m.SetIncidents([]api.Incident{
+		{
+			ID:              "1",
+			SequentialID:    "INC-123",
+			Title:           "Database outage",
+			Summary:         "Database outage",
+			Status:          "started",
+			CreatedAt:       time.Now(),
+			DetailLoaded:    true,
+			CreatedByName:   "Jane Doe",
+			CreatedByEmail:  "jane@example.com",
+			SlackChannelURL: "https://example.slack.com/archives/C123",
+		},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	if !strings.Contains(m.View(), "jane@example.com") {
+		t.Fatal("expected email to be shown outside present mode")
+	}
+
+	m.SetPresentMode(true)
+	view := m.View()
+	for _, secret := range []string{"jane@example.com", "example.slack.com"} {
+		if strings.Contains(view, secret) {
+			t.Errorf("expected %q to be hidden in present mode", secret)
+		}
+	}
+	if !strings.Contains(stripANSI(view), "Jane Doe") {
+		t.Error("expected creator name to remain visible")
+	}
+	if !strings.Contains(stripANSI(view), hiddenText) {
+		t.Errorf("expected %q placeholder in present mode", hiddenText)
+	}
+
+	m.SetPresentMode(false)
+	if !strings.Contains(m.View(), "jane@example.com") {
+		t.Error("expected email to be shown again after leaving present mode")
+	}
+}
+
+func TestIncidentsModelShowInitials(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(160, 50)
+	m.SetIncidents([]api.Incident{
+		{
+			ID:            "inc_1",
+			Title:         "Database outage",
+			Status:        "started",
+			DetailLoaded:  true,
+			CreatedByName: "Jane Doe",
+		},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	if strings.Contains(stripANSI(m.View()), "JD Jane Doe") {
+		t.Fatal("expected no initials badge by default")
+	}
+
+	m.SetShowInitials(true)
+	if !strings.Contains(stripANSI(m.View()), "JD Jane Doe") {
+		t.Error("expected initials badge before the creator name")
+	}
+}
+
+func TestIncidentsModelEventTimeline(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(160, 60)
+	m.SetLocation(time.FixedZone("EST", -5*3600))
+	m.SetIncidents([]api.Incident{
+		{ID: "inc_1", Title: "Database outage", Status: "started", DetailLoaded: true},
+	}, api.PaginationInfo{CurrentPage: 1})
+	m.SetEventsLoading("inc_1")
+
+	if strings.Contains(stripANSI(m.View()), "Loading events") {
+		t.Fatal("expected no timeline events while the detail isn't focused")
+	}
+
+	m.SetDetailFocused(true)
+	if !strings.Contains(stripANSI(m.View()), "Loading events") {
+		t.Error("expected a loading line while events are fetched")
+	}
+
+	m.SetEvents("inc_1", []api.IncidentEvent{
+		{Message: "Rolled back\nthe deploy", Actor: "Jane Doe", OccurredAt: time.Date(2026, 1, 2, 15, 4, 0, 0, time.UTC)},
+		{Message: "Incident started", OccurredAt: time.Date(2026, 1, 2, 14, 0, 0, 0, time.UTC)},
+	}, nil)
+	view := stripANSI(m.View())
+	if !strings.Contains(view, "10:04 — Jane Doe: Rolled back the deploy") {
+		t.Errorf("expected an event line in the configured timezone, got:\n%s", view)
+	}
+	if !strings.Contains(view, "09:00 — Incident started") {
+		t.Error("expected an event without an actor to show only its message")
+	}
+	if strings.Index(view, "10:04") > strings.Index(view, "09:00") {
+		t.Error("expected events to keep their newest-first order")
+	}
+
+	m.SetEvents("inc_1", nil, errors.New("boom"))
+	if !strings.Contains(stripANSI(m.View()), "Failed to load events: boom") {
+		t.Error("expected the fetch error in the timeline")
+	}
+
+	if m.NeedsEvents("inc_1") {
+		t.Error("expected fetched events not to be needed again")
+	}
+	m.ClearEvents("inc_1")
+	if !m.NeedsEvents("inc_1") {
+		t.Error("expected cleared events to be fetched again")
+	}
+}
+
+func TestIncidentsModelSeveritySortStable(t *testing.T) {
+	older := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	incidents := []api.Incident{
+		{ID: "b", Severity: "high", CreatedAt: older},
+		{ID: "c", Severity: "critical", CreatedAt: older},
+		{ID: "a", Severity: "high", CreatedAt: older},
+		{ID: "d", Severity: "high", CreatedAt: newer},
+	}
+	want := []string{"c", "d", "a", "b"}
+
+	m := NewIncidentsModel()
+	if !m.SetSort(SortBySeverity) {
+		t.Fatal("expected selecting severity sort to request a reload")
+	}
+	if got := m.GetSortParam(); got != "-created_at" {
+		t.Errorf("expected severity sort to fetch newest first, got %q", got)
+	}
+
+	// Equal severities order by CreatedAt desc, then ID, whatever the API order
+	for _, order := range [][]int{{0, 1, 2, 3}, {3, 2, 1, 0}, {2, 0, 3, 1}} {
+		input := make([]api.Incident, 0, len(order))
+		for _, idx := range order {
+			input = append(input, incidents[idx])
+		}
+		m.SetIncidents(input, api.PaginationInfo{CurrentPage: 1})
+		got := make([]string, 0, len(want))
+		for _, inc := range m.Incidents() {
+			got = append(got, inc.ID)
+		}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("input order %v: expected %v, got %v", order, want, got)
+		}
+	}
+
+	// Flipping direction reverses severity but keeps the tie-break
+	m.SetSort(SortBySeverity)
+	m.refilter()
+	if got := m.Incidents()[0].ID; got != "d" {
+		t.Errorf("expected least severe, newest incident first, got %q", got)
+	}
+}
+
+func TestIncidentsModelStatusSort(t *testing.T) {
+	older := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := NewIncidentsModel()
+	m.SetSort(SortByStatus)
+	if got := m.GetSortParam(); got != "-created_at" {
+		t.Errorf("expected status sort to fetch newest first, got %q", got)
+	}
+
+	m.SetIncidents([]api.Incident{
+		{ID: "closed", Status: "cancelled", CreatedAt: older},
+		{ID: "resolved", Status: "resolved", CreatedAt: older},
+		{ID: "started", Status: "started", CreatedAt: older},
+		{ID: "triggered", Status: "triggered", CreatedAt: older},
+	}, api.PaginationInfo{CurrentPage: 1})
+	ids := func() string {
+		var got []string
+		for _, inc := range m.Incidents() {
+			got = append(got, inc.ID)
+		}
+		return strings.Join(got, ",")
+	}
+	if got := ids(); got != "triggered,started,resolved,closed" {
+		t.Errorf("expected active incidents first, got %s", got)
+	}
+	if info := m.GetSortInfo(); !strings.Contains(info, "Active First") {
+		t.Errorf("expected the direction in the sort info, got %q", info)
+	}
+
+	// The sort survives a new page, and flipping it only re-orders the page
+	m.SetIncidents([]api.Incident{
+		{ID: "resolved", Status: "resolved", CreatedAt: older},
+		{ID: "triggered", Status: "triggered", CreatedAt: older},
+	}, api.PaginationInfo{CurrentPage: 2})
+	if got := ids(); got != "triggered,resolved" {
+		t.Errorf("expected the sort re-applied to the new page, got %s", got)
+	}
+	if m.SetSort(SortByStatus) {
+		t.Error("expected flipping a client-side sort not to need a reload")
+	}
+	m.refilter()
+	if got := ids(); got != "resolved,triggered" || m.SortConfig() != "status" {
+		t.Errorf("expected resolved first after flipping, got %s (%s)", got, m.SortConfig())
+	}
+}
+
+func TestRedactSensitive(t *testing.T) {
+	got := redactSensitive("Ping mailto:jane@example.com or bob@corp.io, runbook at https://wiki.corp.io/runbooks/db.")
+	for _, secret := range []string{"jane@example.com", "bob@corp.io", "wiki.corp.io"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("expected %q to be redacted, got %q", secret, got)
+		}
+	}
+	if !strings.Contains(got, "Ping "+hiddenText) {
+		t.Errorf("expected placeholder in output, got %q", got)
+	}
+}
+
+func TestIncidentsModelWindowTooSmall(t *testing.T) {
+	for _, layout := range []string{config.LayoutHorizontal, config.LayoutVertical} {
+		m := NewIncidentsModel()
+		m.SetLayout(layout)
+		m.SetIncidents([]api.Incident{
+			{ID: "1", SequentialID: "INC-1", Title: "Database outage", DetailLoaded: true},
+		}, api.PaginationInfo{CurrentPage: 1})
+
+		m.SetDimensions(10, 3)
+		view := stripANSI(m.View())
+		if view != "Window too small" {
+			t.Errorf("%s: expected window too small notice, got %q", layout, view)
+		}
+
+		// Growing back restores the normal view
+		m.SetDimensions(120, 40)
+		if !strings.Contains(stripANSI(m.View()), "INC-1") {
+			t.Errorf("%s: expected incidents after resize", layout)
+		}
+	}
+}
+
+func TestContactCard(t *testing.T) {
+	tests := []struct {
+		name     string
+		roles    []api.IncidentRole
+		expected string
+	}{
+		{
+			name: "commander",
+			roles: []api.IncidentRole{
+				{Name: "Communications Lead", UserName: "Jane Smith", UserEmail: "jane@example.com"},
+				{Name: "Commander", UserName: "John Doe", UserEmail: "john@example.com"},
+			},
+			expected: "John Doe <john@example.com>",
+		},
+		{
+			name: "falls back to communications lead",
+			roles: []api.IncidentRole{
+				{Name: "Scribe", UserName: "Sam Lee", UserEmail: "sam@example.com"},
+				{Name: "Communications Lead", UserName: "Jane Smith", UserEmail: "jane@example.com"},
+			},
+			expected: "Jane Smith <jane@example.com>",
+		},
+		{
+			name: "unassigned commander is skipped",
+			roles: []api.IncidentRole{
+				{Name: "Commander"},
+				{Name: "Communications Lead", UserName: "Jane Smith", UserEmail: "jane@example.com"},
+			},
+			expected: "Jane Smith <jane@example.com>",
+		},
+		{
+			name:     "name without email",
+			roles:    []api.IncidentRole{{Name: "Commander", UserName: "John Doe"}},
+			expected: "John Doe",
+		},
+		{
+			name:     "no lead roles",
+			roles:    []api.IncidentRole{{Name: "Scribe", UserName: "Sam Lee"}},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := contactCard(&api.Incident{Roles: tt.roles}); got != tt.expected {
+				t.Errorf("contactCard() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+
+	if contactCard(nil) != "" {
+		t.Error("expected empty card for nil incident")
+	}
+}
+
+func TestIncidentsModelActionItems(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(160, 50)
+	m.SetIncidents([]api.Incident{
+		{
+			ID: "inc_1", SequentialID: "INC-1", Title: "Database outage", DetailLoaded: true,
+			ActionItems: []api.IncidentTask{
+				{Title: "Rotate database credentials", Status: api.TaskStatusDone, AssigneeName: "Jane Smith"},
+				{Title: "Add replication lag alert", Status: api.TaskStatusOpen},
+			},
+		},
+		{ID: "inc_2", SequentialID: "INC-2", Title: "No follow-ups", DetailLoaded: true},
+	}, api.PaginationInfo{CurrentPage: 1})
+
+	detail := stripANSI(m.generateDetailContent(m.SelectedIncident()))
+	if !strings.Contains(detail, i18n.T("incidents.detail.action_items")) {
+		t.Error("expected action items section")
+	}
+	if !strings.Contains(detail, "[x] Rotate database credentials (Jane Smith)") {
+		t.Errorf("expected completed item checked, got %q", detail)
+	}
+	if !strings.Contains(detail, "[ ] Add replication lag alert") {
+		t.Errorf("expected open item unchecked, got %q", detail)
+	}
+	if !strings.Contains(m.GetDetailPlainText(), "[x] Rotate database credentials") {
+		t.Error("expected action items in copied detail")
+	}
+
+	empty := m.generateDetailContent(&api.Incident{ID: "inc_2", DetailLoaded: true})
+	if strings.Contains(stripANSI(empty), i18n.T("incidents.detail.action_items")) {
+		t.Error("expected no action items section when there are none")
+	}
+}
+
+func TestIncidentsModelPhaseDurations(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(160, 50)
+
+	started := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	detected := started.Add(5 * time.Minute)
+	acked := detected.Add(12 * time.Minute)
+	resolved := started.Add(83 * time.Minute)
+	inc := &api.Incident{
+		ID: "inc_1", StartedAt: &started, DetectedAt: &detected,
+		AcknowledgedAt: &acked, ResolvedAt: &resolved,
+	}
+
+	detail := stripANSI(m.generateDetailContent(inc))
+	if !strings.Contains(detail, i18n.T("incidents.durations.title")) {
+		t.Fatal("expected durations section")
+	}
+	if !regexp.MustCompile(regexp.QuoteMeta(i18n.T("incidents.durations.detect_to_ack")) + `:\s+12m\n`).MatchString(detail) {
+		t.Errorf("expected detect → ack of 12m, got %q", detail)
+	}
+	if !regexp.MustCompile(regexp.QuoteMeta(i18n.T("incidents.durations.start_to_resolve")) + `:\s+1h 23m\n`).MatchString(detail) {
+		t.Errorf("expected start → resolve of 1h 23m, got %q", detail)
+	}
+	if strings.Contains(detail, i18n.T("incidents.durations.ack_to_mitigate")) {
+		t.Error("expected ack → mitigate to be skipped without a mitigation time")
+	}
+
+	if strings.Contains(stripANSI(m.generateDetailContent(&api.Incident{ID: "inc_2"})), i18n.T("incidents.durations.title")) {
+		t.Error("expected no durations section without timestamps")
+	}
+}
+
+func TestIncidentsModelDetailPlainText(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(160, 50)
+
+	started := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	inc := &api.Incident{
+		ID: "inc_1", SequentialID: "INC-42", Title: "Checkout errors",
+		Severity: "critical", Status: "started", StartedAt: &started,
+		SlackChannelURL: "https://example.slack.com/archives/C1",
+		Services:        []string{"checkout", "payments"},
+	}
+
+	text := m.detailPlainText(inc)
+	if strings.Contains(text, "\x1b") {
+		t.Errorf("expected no escape codes, got %q", text)
+	}
+	for _, want := range []string{"[INC-42] Checkout errors", "Status: started", "https://example.slack.com/archives/C1", "• payments"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in the plain text:\n%s", want, text)
+		}
+	}
+	for i, line := range strings.Split(text, "\n") {
+		if strings.HasSuffix(line, " ") {
+			t.Errorf("line %d has trailing padding: %q", i, line)
+		}
+	}
+
+	// The same sections as the rendered pane, not the shorter c copy
+	if stripped := strings.TrimSpace(stripANSI(m.generateDetailContent(inc))); !strings.HasPrefix(text, stripped[:20]) {
+		t.Errorf("expected the rendered detail, got %q", text)
+	}
+
+	m.SetIncidents([]api.Incident{*inc}, api.PaginationInfo{CurrentPage: 1})
+	if got := m.SelectedDetailText(); got != m.detailPlainText(m.SelectedIncident()) {
+		t.Errorf("expected the selected incident's detail, got %q", got)
+	}
+}
+
+func TestIncidentsModelCachedDetailMarker(t *testing.T) {
+	m := NewIncidentsModel()
+	m.SetDimensions(160, 50)
+	inc := &api.Incident{ID: "inc_1", Title: "Database outage", DetailLoaded: true}
+	marker := "⚡" + i18n.T("common.cached")
+
+	m.SetDetailFromCache("inc_1", false)
+	if strings.Contains(stripANSI(m.generateDetailContent(inc)), marker) {
+		t.Error("expected no marker for a freshly fetched detail")
+	}
+	m.SetDetailFromCache("inc_1", true)
+	if !strings.Contains(stripANSI(m.generateDetailContent(inc)), marker) {
+		t.Error("expected the cached marker for a detail served from the cache")
+	}
+}
@@ -0,0 +1,59 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
+)
+
+// IntroModel is the one-time welcome overlay shown to new users before setup.
+type IntroModel struct {
+	Visible bool
+}
+
+func NewIntroModel() IntroModel {
+	return IntroModel{Visible: false}
+}
+
+func (m *IntroModel) Show() {
+	m.Visible = true
+}
+
+func (m *IntroModel) Hide() {
+	m.Visible = false
+}
+
+func (m IntroModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(styles.DialogTitle.Render(i18n.T("intro.title")))
+	b.WriteString("\n\n")
+
+	b.WriteString(styles.Text.Render(i18n.T("about.description")))
+	b.WriteString("\n\n")
+
+	// Setup steps
+	b.WriteString(styles.TextBold.Render(i18n.T("intro.setup_title")))
+	b.WriteString("\n")
+	b.WriteString(styles.Text.Render("1. " + i18n.T("intro.step_auth")))
+	b.WriteString("\n")
+	b.WriteString(styles.Text.Render("2. " + i18n.T("intro.step_connect")))
+	b.WriteString("\n")
+	b.WriteString(styles.Text.Render("3. " + i18n.T("intro.step_prefs")))
+	b.WriteString("\n\n")
+
+	// Navigation summary
+	b.WriteString(styles.TextBold.Render(i18n.T("help.section.navigation")))
+	b.WriteString("\n")
+	b.WriteString(renderHelpLine("j / k", i18n.T("help.nav.move_down")+" / "+i18n.T("help.nav.move_up")))
+	b.WriteString(renderHelpLine("Tab", i18n.T("help.nav.switch_tabs")))
+	b.WriteString(renderHelpLine("Enter", i18n.T("help.action.details")))
+	b.WriteString(renderHelpLine("?", i18n.T("help.action.help")))
+	b.WriteString(renderHelpLine("q", i18n.T("help.action.quit")))
+	b.WriteString("\n")
+
+	b.WriteString(styles.TextDim.Render(i18n.T("intro.press_any_key")))
+
+	return styles.Dialog.Render(b.String())
+}
@@ -0,0 +1,35 @@
+package views
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIntroModelShowHide(t *testing.T) {
+	m := NewIntroModel()
+
+	if m.Visible {
+		t.Error("expected intro to be hidden initially")
+	}
+
+	m.Show()
+	if !m.Visible {
+		t.Error("expected intro to be visible after Show()")
+	}
+
+	m.Hide()
+	if m.Visible {
+		t.Error("expected intro to be hidden after Hide()")
+	}
+}
+
+func TestIntroModelView(t *testing.T) {
+	m := NewIntroModel()
+	view := stripANSI(m.View())
+
+	for _, want := range []string{"Welcome to Rootly TUI", "Getting Started", "Navigation", "Press any key to continue"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected intro view to contain %q", want)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
+)
+
+// Smallest area the views can lay out; below this they render a single notice line
+const (
+	minViewWidth  = 30
+	minViewHeight = 5
+)
+
+// narrowWidth is the view width below which the list and detail panes are
+// stacked even with the horizontal layout, since neither is readable at half width
+const narrowWidth = 100
+
+// isNarrow reports whether a view of the given width should stack its panes
+func isNarrow(width int) bool {
+	return width > 0 && width < narrowWidth
+}
+
+// windowTooSmall reports whether the given area is too small to render a view.
+// A zero size means dimensions haven't been set yet and is not considered too small.
+func windowTooSmall(width, height int) bool {
+	if width == 0 && height == 0 {
+		return false
+	}
+	return width < minViewWidth || height < minViewHeight
+}
+
+// renderWindowTooSmall renders the notice shown instead of a view that doesn't fit
+func renderWindowTooSmall() string {
+	return styles.TextDim.Render(i18n.T("common.window_too_small"))
+}
+
+// skeletonLines are the placeholder rows of renderDetailSkeleton, as fractions
+// of the pane width: a title, the severity and status badges, then a timeline
+var skeletonLines = []float64{0.7, 0, 0.35, 0, 0.25, 0.55, 0.5, 0.55, 0, 0.25, 0.8, 0.6}
+
+// renderDetailSkeleton renders dimmed placeholder lines for a detail pane of the
+// given outer width, shown while a page loads so the pane doesn't sit empty
+func renderDetailSkeleton(width int) string {
+	// Border and horizontal padding of styles.DetailContainer
+	inner := min(width-2-2*styles.SpacingMedium, 60)
+	if inner <= 0 {
+		return ""
+	}
+	lines := make([]string, len(skeletonLines))
+	for i, frac := range skeletonLines {
+		if n := int(float64(inner) * frac); n > 0 {
+			lines[i] = strings.Repeat("░", n)
+		}
+	}
+	return styles.TextDim.Render(strings.Join(lines, "\n"))
+}
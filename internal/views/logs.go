@@ -1,10 +1,12 @@
 package views
 
 import (
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
-	"charm.land/bubbles/v2/viewport"
+	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 	"golang.design/x/clipboard"
@@ -25,22 +27,43 @@ var (
 	logInfoStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("12")) // Blue
 	logWarnStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("11")) // Yellow
 	logErrorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))  // Red
+
+	logMatchStyle        = lipgloss.NewStyle().Reverse(true)                                                    // Search matches
+	logCurrentMatchStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("0")).Background(lipgloss.Color("11")) // Match jumped to with n/N
 )
 
 type LogsModel struct {
-	Visible  bool
-	width    int
-	height   int
-	viewport viewport.Model
-
-	// Content tracking
-	content    string
-	lineCount  int
-	lastLength int // Track file size for change detection
+	Visible bool
+	width   int
+	height  int
+
+	// Content tracking: every line read, the ones shown at the minimum level
+	// (with their index in allLines; nil when nothing is filtered out), their
+	// colorized form (memoized per line, "" until first shown) and the scroll
+	// window over them
+	allLines     []string
+	minLevel     logLevel
+	rawIndex     []int
+	lines        []string
+	colorized    []string
+	lineCount    int
+	lastLength   int // Track file size for change detection
+	scrollPos    int // Index of the first visible line
+	visibleLines int
+	lineWidth    int
 
 	// Auto-tail mode
 	autoTail bool
 
+	// Search: the prompt, the active query (matched case-insensitively by
+	// searchRe), the shown lines that match it and the one jumped to (-1 if none)
+	searchInput textinput.Model
+	searching   bool
+	searchQuery string
+	searchRe    *regexp.Regexp
+	matches     []int
+	matchLine   int
+
 	// Mouse selection
 	selecting    bool
 	selectStart  int
@@ -55,12 +78,95 @@ type LogsModel struct {
 	clipboardAvailable bool
 }
 
+// logsWheelLines is how many lines a mouse wheel step scrolls
+const logsWheelLines = 3
+
+// logLevel is the severity of a log entry, detected from its level prefix
+type logLevel int
+
+const (
+	logLevelNone logLevel = iota // No level prefix; as a minimum, shows everything
+	logLevelDebug
+	logLevelInfo
+	logLevelWarn
+	logLevelError
+)
+
+// logLevelKeys are the keys that set the minimum level shown
+var logLevelKeys = map[string]logLevel{
+	"1": logLevelError,
+	"2": logLevelWarn,
+	"3": logLevelInfo,
+	"4": logLevelNone,
+}
+
+// label is how a minimum level reads in the overlay title
+func (l logLevel) label() string {
+	switch l {
+	case logLevelError:
+		return "ERROR"
+	case logLevelWarn:
+		return "WARN+"
+	case logLevelInfo:
+		return "INFO+"
+	case logLevelDebug:
+		return "DEBUG+"
+	}
+	return ""
+}
+
+// logEntryLevel detects the level of a log entry from the prefix text logged
+// by charmbracelet/log (ERRO/WARN/INFO/DEBU)
+func logEntryLevel(entry string) logLevel {
+	upperEntry := strings.ToUpper(entry)
+
+	switch {
+	case strings.Contains(upperEntry, "ERRO"):
+		return logLevelError
+	case strings.Contains(upperEntry, "WARN"):
+		return logLevelWarn
+	case strings.Contains(upperEntry, "INFO"):
+		return logLevelInfo
+	case strings.Contains(upperEntry, "DEBU"):
+		return logLevelDebug
+	default:
+		return logLevelNone
+	}
+}
+
+// filterLogLines returns the lines at or above minLevel and the index of each
+// in lines; a line without a level, such as a multi-line value, goes with the
+// entry above it. The index is nil when nothing is filtered out.
+func filterLogLines(lines []string, minLevel logLevel) ([]string, []int) {
+	if minLevel == logLevelNone {
+		return lines, nil
+	}
+	var shown []string
+	var index []int
+	level := logLevelNone
+	for i, line := range lines {
+		if l := logEntryLevel(line); l != logLevelNone {
+			level = l
+		}
+		if level >= minLevel {
+			shown = append(shown, line)
+			index = append(index, i)
+		}
+	}
+	return shown, index
+}
+
 func NewLogsModel() LogsModel {
-	vp := viewport.New(viewport.WithWidth(80), viewport.WithHeight(20))
+	searchInput := textinput.New()
+	searchInput.Prompt = "/ "
+	searchInput.Placeholder = i18n.T("logs.search_placeholder")
 
 	return LogsModel{
-		viewport: vp,
-		autoTail: true, // Auto-scroll to bottom by default
+		visibleLines: 20,
+		lineWidth:    80,
+		autoTail:     true, // Auto-scroll to bottom by default
+		searchInput:  searchInput,
+		matchLine:    -1,
 	}
 }
 
@@ -74,7 +180,6 @@ func (m LogsModel) Update(msg tea.Msg) (LogsModel, tea.Cmd) {
 	}
 
 	var cmds []tea.Cmd
-	var vpCmd tea.Cmd
 
 	switch msg := msg.(type) {
 	case LogsRefreshMsg:
@@ -87,33 +192,43 @@ func (m LogsModel) Update(msg tea.Msg) (LogsModel, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyPressMsg:
+		if m.searching {
+			return m, m.handleSearchKey(msg)
+		}
 		switch msg.String() {
+		case "/":
+			m.searching = true
+			m.searchInput.SetValue(m.searchQuery)
+			m.searchInput.CursorEnd()
+			cmds = append(cmds, m.searchInput.Focus())
+		case "n":
+			m.jumpToMatch(1)
+		case "N":
+			m.jumpToMatch(-1)
 		case "j", "down":
 			m.autoTail = false
-			m.viewport, vpCmd = m.viewport.Update(msg)
-			cmds = append(cmds, vpCmd)
+			m.scrollBy(1)
 		case "k", "up":
 			m.autoTail = false
-			m.viewport, vpCmd = m.viewport.Update(msg)
-			cmds = append(cmds, vpCmd)
+			m.scrollBy(-1)
 		case "g":
 			m.autoTail = false
-			m.viewport.GotoTop()
+			m.scrollTo(0)
 		case "G":
 			m.autoTail = true
-			m.viewport.GotoBottom()
+			m.scrollTo(m.maxScroll())
 		case "f":
 			// Toggle auto-tail (follow) mode
 			m.autoTail = !m.autoTail
 			if m.autoTail {
-				m.viewport.GotoBottom()
+				m.scrollTo(m.maxScroll())
 			}
+		case "1", "2", "3", "4":
+			m.setMinLevel(logLevelKeys[msg.String()])
 		case "c":
 			debug.ClearLogs()
-			m.content = ""
-			m.lineCount = 0
+			m.setLines(nil)
 			m.lastLength = 0
-			m.viewport.SetContent("")
 			m.clearSelection()
 		case "y":
 			if m.clipboardAvailable {
@@ -132,28 +247,34 @@ func (m LogsModel) Update(msg tea.Msg) (LogsModel, tea.Cmd) {
 				m.hasSelection = true
 			}
 		case "esc":
-			if m.hasSelection {
+			if m.searchQuery != "" {
+				m.setSearch("")
+			} else if m.hasSelection {
 				m.clearSelection()
 			}
-		case "ctrl+d", "pgdown":
+		case "pgdown":
 			m.autoTail = false
-			m.viewport, vpCmd = m.viewport.Update(msg)
-			cmds = append(cmds, vpCmd)
-		case "ctrl+u", "pgup":
+			m.scrollBy(m.visibleLines)
+		case "ctrl+d":
 			m.autoTail = false
-			m.viewport, vpCmd = m.viewport.Update(msg)
-			cmds = append(cmds, vpCmd)
+			m.scrollBy(m.visibleLines / 2)
+		case "pgup":
+			m.autoTail = false
+			m.scrollBy(-m.visibleLines)
+		case "ctrl+u":
+			m.autoTail = false
+			m.scrollBy(-m.visibleLines / 2)
 		}
 
 	case tea.MouseWheelMsg:
-		m.viewport, vpCmd = m.viewport.Update(msg)
-		cmds = append(cmds, vpCmd)
+		switch msg.Mouse().Button {
+		case tea.MouseWheelUp:
+			m.scrollBy(-logsWheelLines)
+		case tea.MouseWheelDown:
+			m.scrollBy(logsWheelLines)
+		}
 		m.autoTail = false
 
-	case tea.MouseMsg:
-		m.viewport, vpCmd = m.viewport.Update(msg)
-		cmds = append(cmds, vpCmd)
-
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -163,6 +284,100 @@ func (m LogsModel) Update(msg tea.Msg) (LogsModel, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// CapturesKey reports whether the overlay handles a key that would otherwise
+// close it: everything while the search prompt is open, and Esc while a search
+// is active (Esc clears it first)
+func (m LogsModel) CapturesKey(msg tea.KeyPressMsg) bool {
+	return m.searching || (msg.String() == "esc" && m.searchQuery != "")
+}
+
+// handleSearchKey handles typing in the search prompt, jumping to the first
+// match as the query changes. Enter keeps the search, Esc clears it; both
+// close the prompt.
+func (m *LogsModel) handleSearchKey(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		m.searching = false
+		m.searchInput.Blur()
+		return nil
+	case "esc":
+		m.searching = false
+		m.searchInput.Blur()
+		m.setSearch("")
+		return nil
+	}
+	var cmd tea.Cmd
+	m.searchInput, cmd = m.searchInput.Update(msg)
+	if query := strings.TrimSpace(m.searchInput.Value()); query != m.searchQuery {
+		m.setSearch(query)
+		m.jumpToMatch(1)
+	}
+	return cmd
+}
+
+// setSearch sets the search query ("" clears it) and finds its matches
+func (m *LogsModel) setSearch(query string) {
+	m.searchQuery = query
+	m.searchRe = nil
+	if query != "" {
+		m.searchRe = regexp.MustCompile("(?i)" + regexp.QuoteMeta(query))
+	}
+	m.matchLine = -1
+	m.findMatches()
+}
+
+// findMatches collects the shown lines matching the search query
+func (m *LogsModel) findMatches() {
+	m.matches = nil
+	if m.searchRe == nil {
+		return
+	}
+	for i, line := range m.lines {
+		if m.searchRe.MatchString(line) {
+			m.matches = append(m.matches, i)
+		}
+	}
+	if m.matchLine >= len(m.lines) {
+		m.matchLine = -1
+	}
+}
+
+// jumpToMatch moves to the next (dir 1) or previous (dir -1) match, wrapping
+// around, and scrolls it into view. Without a current match it starts from
+// the top of the window.
+func (m *LogsModel) jumpToMatch(dir int) {
+	if len(m.matches) == 0 {
+		return
+	}
+	var next int
+	if dir > 0 {
+		from := m.matchLine + 1
+		if m.matchLine < 0 {
+			from = m.scrollPos
+		}
+		i := sort.SearchInts(m.matches, from)
+		if i == len(m.matches) {
+			i = 0
+		}
+		next = m.matches[i]
+	} else {
+		before := m.matchLine
+		if m.matchLine < 0 {
+			before = m.scrollPos + m.visibleLines
+		}
+		i := sort.SearchInts(m.matches, before) - 1
+		if i < 0 {
+			i = len(m.matches) - 1
+		}
+		next = m.matches[i]
+	}
+	m.matchLine = next
+	m.autoTail = false
+	if next < m.scrollPos || next >= m.scrollPos+m.visibleLines {
+		m.scrollTo(next - m.visibleLines/2)
+	}
+}
+
 func (m *LogsModel) updateViewportSize() {
 	// Calculate viewport dimensions (leave room for title, help, borders)
 	vpHeight := m.height - 12
@@ -175,51 +390,164 @@ func (m *LogsModel) updateViewportSize() {
 		vpWidth = 20
 	}
 
-	m.viewport.SetWidth(vpWidth)
-	m.viewport.SetHeight(vpHeight)
+	m.lineWidth = vpWidth
+	m.visibleLines = vpHeight
+	if m.autoTail {
+		m.scrollTo(m.maxScroll())
+	} else {
+		m.scrollTo(m.scrollPos)
+	}
 }
 
 func (m *LogsModel) loadContent() {
-	var content string
 	var lines []string
 
 	if debug.HasLogFile() {
 		// Read from file
 		fileContent, err := debug.ReadLogFile()
 		if err != nil {
-			content = "Error reading log file: " + err.Error()
+			lines = []string{"Error reading log file: " + err.Error()}
 		} else {
 			// Only update if content changed
 			if len(fileContent) == m.lastLength {
 				return
 			}
 			m.lastLength = len(fileContent)
-			content = fileContent
+			lines = strings.Split(strings.TrimSuffix(fileContent, "\n"), "\n")
 		}
-		lines = strings.Split(strings.TrimSuffix(content, "\n"), "\n")
 	} else {
 		// Read from memory buffer
-		logEntries := debug.GetLogs()
-		lines = logEntries
+		lines = debug.GetLogs()
 	}
 
-	// Colorize lines
-	var colorized []string
+	// Drop blank lines; colorizing waits until a line is scrolled into view
+	kept := make([]string, 0, len(lines))
 	for _, line := range lines {
 		line = strings.TrimSuffix(line, "\n")
 		if line != "" {
-			colorized = append(colorized, colorizeLogEntry(line))
+			kept = append(kept, line)
 		}
 	}
+	m.setLines(kept)
+}
+
+// setLines replaces the buffer and shows the lines at the minimum level
+func (m *LogsModel) setLines(lines []string) {
+	m.allLines = lines
+	shown, index := filterLogLines(lines, m.minLevel)
+	m.rawIndex = index
+	m.showLines(shown)
+}
+
+// setMinLevel filters the buffer to a new minimum level, keeping the line at
+// the top of the window (or the next one still shown) in place
+func (m *LogsModel) setMinLevel(level logLevel) {
+	if level == m.minLevel {
+		return
+	}
+	top := m.rawLineIndex(m.scrollPos)
+	m.minLevel = level
+	m.clearSelection()
+	m.matchLine = -1
+	// Shown lines change arbitrarily, so their colorization can't be reused
+	m.lines = nil
+	m.setLines(m.allLines)
+	if !m.autoTail {
+		m.scrollTo(m.shownLineIndex(top))
+	}
+}
+
+// rawLineIndex returns the index in allLines of shown line i (or the end of
+// the buffer when i is past the shown lines)
+func (m LogsModel) rawLineIndex(i int) int {
+	if m.rawIndex == nil {
+		return min(i, len(m.allLines))
+	}
+	if i < len(m.rawIndex) {
+		return m.rawIndex[i]
+	}
+	return len(m.allLines)
+}
+
+// shownLineIndex returns the index of the first shown line at or after line
+// raw of allLines
+func (m LogsModel) shownLineIndex(raw int) int {
+	if m.rawIndex == nil {
+		return raw
+	}
+	return sort.SearchInts(m.rawIndex, raw)
+}
 
-	m.content = strings.Join(colorized, "\n")
-	m.lineCount = len(colorized)
-	m.viewport.SetContent(m.content)
+// showLines replaces the shown lines, keeping the colorization of lines already
+// seen when output was only appended, and follows the tail in auto-tail mode
+func (m *LogsModel) showLines(lines []string) {
+	colorized := make([]string, len(lines))
+	if n := len(m.lines); n > 0 && n <= len(lines) && lines[0] == m.lines[0] && lines[n-1] == m.lines[n-1] {
+		copy(colorized, m.colorized)
+	}
+	m.lines = lines
+	m.colorized = colorized
+	m.lineCount = len(lines)
+	m.findMatches()
 
 	// Auto-scroll to bottom if in tail mode
 	if m.autoTail {
-		m.viewport.GotoBottom()
+		m.scrollTo(m.maxScroll())
+	} else {
+		m.scrollTo(m.scrollPos)
+	}
+}
+
+// maxScroll returns the scroll position that shows the last line at the bottom
+func (m LogsModel) maxScroll() int {
+	return max(len(m.lines)-m.visibleLines, 0)
+}
+
+// scrollBy moves the window by delta lines
+func (m *LogsModel) scrollBy(delta int) {
+	m.scrollTo(m.scrollPos + delta)
+}
+
+// scrollTo moves the window to start at line pos (clamped) and colorizes the
+// lines that came into view
+func (m *LogsModel) scrollTo(pos int) {
+	m.scrollPos = min(max(pos, 0), m.maxScroll())
+	for i := m.scrollPos; i < min(m.scrollPos+m.visibleLines, len(m.lines)); i++ {
+		if m.colorized[i] == "" {
+			m.colorized[i] = colorizeLogEntry(m.lines[i])
+		}
+	}
+}
+
+// scrollPercent returns how far the window is scrolled (1 at the bottom)
+func (m LogsModel) scrollPercent() float64 {
+	if m.maxScroll() == 0 {
+		return 1
+	}
+	return float64(m.scrollPos) / float64(m.maxScroll())
+}
+
+// renderWindow renders the visible lines, padded to the window height
+func (m LogsModel) renderWindow() string {
+	window := make([]string, 0, m.visibleLines)
+	for i := m.scrollPos; i < min(m.scrollPos+m.visibleLines, len(m.lines)); i++ {
+		line := m.colorized[i]
+		switch {
+		case m.searchRe != nil && m.searchRe.MatchString(m.lines[i]):
+			match := logMatchStyle
+			if i == m.matchLine {
+				match = logCurrentMatchStyle
+			}
+			line = highlightLogMatches(m.lines[i], m.searchRe, match)
+		case line == "":
+			line = colorizeLogEntry(m.lines[i])
+		}
+		window = append(window, line)
 	}
+	for len(window) < m.visibleLines {
+		window = append(window, "")
+	}
+	return lipgloss.NewStyle().MaxWidth(m.lineWidth).Render(strings.Join(window, "\n"))
 }
 
 func (m *LogsModel) scheduleRefresh() tea.Cmd {
@@ -235,8 +563,22 @@ func (m *LogsModel) clearSelection() {
 	m.hasSelection = false
 }
 
+// selectedText returns the raw (uncolored) selected lines, or the whole buffer
+// when nothing is selected
+func (m LogsModel) selectedText() string {
+	if !m.hasSelection {
+		return strings.Join(m.lines, "\n")
+	}
+	start := max(min(m.selectStart, m.selectEnd), 0)
+	end := min(max(m.selectStart, m.selectEnd), len(m.lines)-1)
+	if start > end {
+		return ""
+	}
+	return strings.Join(m.lines[start:end+1], "\n")
+}
+
 func (m *LogsModel) copyToClipboard() {
-	text := m.content
+	text := m.selectedText()
 	if text == "" {
 		return
 	}
@@ -296,6 +638,10 @@ func (m LogsModel) StartAutoRefresh() tea.Cmd {
 }
 
 func (m LogsModel) View() string {
+	if windowTooSmall(m.width, m.height) {
+		return renderWindowTooSmall()
+	}
+
 	var b strings.Builder
 
 	// Title with source indicator
@@ -305,27 +651,41 @@ func (m LogsModel) View() string {
 	} else {
 		titleSuffix = " (" + i18n.T("logs.memory") + ")"
 	}
+	if m.minLevel != logLevelNone {
+		titleSuffix += " • " + i18n.Tf("logs.level", map[string]any{"Level": m.minLevel.label()})
+	}
 	title := styles.DialogTitle.Render(i18n.T("logs.title") + titleSuffix)
 	b.WriteString(title)
 	b.WriteString("\n\n")
 
 	// Viewport content
-	if m.lineCount == 0 {
+	if m.lineCount == 0 && len(m.allLines) > 0 {
+		b.WriteString(styles.TextDim.Render(i18n.T("logs.empty_level")))
+		b.WriteString("\n")
+	} else if m.lineCount == 0 {
 		b.WriteString(styles.TextDim.Render(i18n.T("logs.empty")))
 		b.WriteString("\n")
 	} else {
-		b.WriteString(m.viewport.View())
+		b.WriteString(m.renderWindow())
 	}
 
 	// Scroll indicator and tail status
 	b.WriteString("\n")
 	var statusParts []string
+	if m.searching {
+		statusParts = append(statusParts, m.searchInput.View())
+	} else if m.searchQuery != "" {
+		statusParts = append(statusParts, styles.Primary.Render(i18n.Tf("search.active", map[string]any{"Query": m.searchQuery})))
+	}
+	if m.searchQuery != "" {
+		statusParts = append(statusParts, m.matchStatus())
+	}
 	statusParts = append(statusParts, i18n.Tf("logs.line_count", map[string]interface{}{"Count": m.lineCount}))
 	if m.autoTail {
 		statusParts = append(statusParts, "["+i18n.T("logs.following")+"]")
 	}
-	if m.viewport.ScrollPercent() < 1.0 {
-		statusParts = append(statusParts, i18n.Tf("logs.scroll_percent", map[string]interface{}{"Percent": int(m.viewport.ScrollPercent() * 100)}))
+	if m.scrollPercent() < 1.0 {
+		statusParts = append(statusParts, i18n.Tf("logs.scroll_percent", map[string]interface{}{"Percent": int(m.scrollPercent() * 100)}))
 	}
 	b.WriteString(styles.TextDim.Render(strings.Join(statusParts, " • ")))
 
@@ -352,8 +712,20 @@ func (m LogsModel) View() string {
 	return dialog
 }
 
+// matchStatus describes the search matches: "2/5 matches", or "5 matches"
+// before jumping to one
+func (m LogsModel) matchStatus() string {
+	if len(m.matches) == 0 {
+		return i18n.T("logs.no_matches")
+	}
+	if i := sort.SearchInts(m.matches, m.matchLine); m.matchLine >= 0 && i < len(m.matches) && m.matches[i] == m.matchLine {
+		return i18n.Tf("logs.match_position", map[string]any{"Current": i + 1, "Total": len(m.matches)})
+	}
+	return i18n.Tf("logs.match_count", map[string]any{"Total": len(m.matches)})
+}
+
 func (m LogsModel) getHelpText() string {
-	base := "j/k:scroll g/G:top/bottom f:follow"
+	base := "j/k:scroll g/G:top/bottom f:follow 1-4:level /:search n/N:next/prev"
 	if m.clipboardAvailable {
 		base += " y:copy"
 	}
@@ -363,18 +735,40 @@ func (m LogsModel) getHelpText() string {
 
 // colorizeLogEntry applies color based on log level
 func colorizeLogEntry(entry string) string {
-	upperEntry := strings.ToUpper(entry)
+	return logLevelStyle(logEntryLevel(entry)).Render(entry)
+}
 
-	switch {
-	case strings.Contains(upperEntry, "ERRO") || strings.Contains(upperEntry, "ERROR"):
-		return logErrorStyle.Render(entry)
-	case strings.Contains(upperEntry, "WARN"):
-		return logWarnStyle.Render(entry)
-	case strings.Contains(upperEntry, "INFO"):
-		return logInfoStyle.Render(entry)
-	case strings.Contains(upperEntry, "DEBU") || strings.Contains(upperEntry, "DEBUG"):
-		return logDebugStyle.Render(entry)
+// logLevelStyle returns the color of a log level
+func logLevelStyle(level logLevel) lipgloss.Style {
+	switch level {
+	case logLevelError:
+		return logErrorStyle
+	case logLevelWarn:
+		return logWarnStyle
+	case logLevelInfo:
+		return logInfoStyle
+	case logLevelDebug:
+		return logDebugStyle
 	default:
-		return styles.Text.Render(entry)
+		return styles.Text
+	}
+}
+
+// highlightLogMatches colors entry by its level with each match of re
+// rendered in the match style
+func highlightLogMatches(entry string, re *regexp.Regexp, match lipgloss.Style) string {
+	base := logLevelStyle(logEntryLevel(entry))
+	var b strings.Builder
+	last := 0
+	for _, loc := range re.FindAllStringIndex(entry, -1) {
+		if loc[0] > last {
+			b.WriteString(base.Render(entry[last:loc[0]]))
+		}
+		b.WriteString(match.Render(entry[loc[0]:loc[1]]))
+		last = loc[1]
+	}
+	if last < len(entry) {
+		b.WriteString(base.Render(entry[last:]))
 	}
+	return b.String()
 }
@@ -1,6 +1,7 @@
 package views
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -410,3 +411,230 @@ func TestLogsModelWindowResize(t *testing.T) {
 		t.Errorf("expected height 40, got %d", m.height)
 	}
 }
+
+func TestLogsModelWindowTooSmall(t *testing.T) {
+	m := NewLogsModel()
+	m.SetDimensions(10, 3)
+	if view := stripANSI(m.View()); view != "Window too small" {
+		t.Errorf("expected window too small notice, got %q", view)
+	}
+}
+
+// logLines returns n distinct log lines
+func logLines(n int) []string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("time=2026-01-01T00:00:00Z level=INFO msg=\"entry %d\"", i)
+	}
+	return lines
+}
+
+func TestLogsModelRendersVisibleWindowOnly(t *testing.T) {
+	m := NewLogsModel()
+	m.Visible = true
+	m.SetDimensions(100, 30) // 18 visible lines
+	m.setLines(logLines(1000))
+
+	if m.scrollPos != 1000-m.visibleLines {
+		t.Errorf("expected auto-tail to show the last lines, scrollPos %d", m.scrollPos)
+	}
+	if m.colorized[0] != "" {
+		t.Error("expected lines outside the window not to be colorized")
+	}
+	if m.colorized[999] == "" {
+		t.Error("expected visible lines to be colorized")
+	}
+	view := stripANSI(m.View())
+	if !strings.Contains(view, "entry 999") || strings.Contains(view, "entry 500") {
+		t.Errorf("expected only the visible window in the view:\n%s", view)
+	}
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'g', Text: "g"})
+	if m.scrollPos != 0 || m.colorized[0] == "" {
+		t.Errorf("expected g to scroll to and colorize the top, scrollPos %d", m.scrollPos)
+	}
+
+	// Appending keeps the memoized colorization
+	memo := m.colorized[0]
+	m.setLines(append(logLines(1000), "appended"))
+	if m.colorized[0] != memo {
+		t.Error("expected colorization to be kept when lines are appended")
+	}
+}
+
+func TestLogsModelSelectedTextUsesRawLines(t *testing.T) {
+	m := NewLogsModel()
+	m.SetDimensions(100, 30)
+	m.setLines(logLines(100))
+
+	if got := m.selectedText(); got != strings.Join(logLines(100), "\n") {
+		t.Error("expected the whole raw buffer without a selection")
+	}
+
+	// Selection covers lines outside the visible window
+	m.hasSelection = true
+	m.selectStart, m.selectEnd = 3, 1
+	want := strings.Join(logLines(100)[1:4], "\n")
+	if got := m.selectedText(); got != want {
+		t.Errorf("expected raw selected lines %q, got %q", want, got)
+	}
+}
+
+func BenchmarkLogsScroll(b *testing.B) {
+	for _, n := range []int{1_000, 100_000} {
+		b.Run(fmt.Sprintf("lines=%d", n), func(b *testing.B) {
+			m := NewLogsModel()
+			m.Visible = true
+			m.SetDimensions(120, 40)
+			m.setLines(logLines(n))
+			up := tea.KeyPressMsg{Code: 'k', Text: "k"}
+			down := tea.KeyPressMsg{Code: 'j', Text: "j"}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				key := up
+				if i%2 == 1 {
+					key = down
+				}
+				m, _ = m.Update(key)
+				_ = m.View()
+			}
+		})
+	}
+}
+
+func TestLogsModelLevelFilter(t *testing.T) {
+	m := NewLogsModel()
+	m.Visible = true
+	m.SetDimensions(100, 16) // 4 visible lines
+	m.setLines([]string{
+		"10:00 DEBU cache hit",
+		"10:01 INFO loaded incidents",
+		"10:02 WARN rate limited",
+		"10:03 ERRO request failed",
+		"  detail: timeout",
+		"10:04 INFO loaded alerts",
+		"10:05 DEBU cache miss",
+		"10:06 ERRO parse failed",
+	})
+
+	tests := []struct {
+		key  rune
+		want int
+	}{
+		{'1', 3}, // errors and the continuation line under one
+		{'2', 4},
+		{'3', 6},
+		{'4', 8},
+	}
+	for _, tt := range tests {
+		m, _ = m.Update(tea.KeyPressMsg{Code: tt.key, Text: string(tt.key)})
+		if m.lineCount != tt.want {
+			t.Errorf("key %c: expected %d lines shown, got %d", tt.key, tt.want, m.lineCount)
+		}
+	}
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: '1', Text: "1"})
+	view := stripANSI(m.View())
+	if !strings.Contains(view, "level: ERROR") {
+		t.Errorf("expected the active level in the title:\n%s", view)
+	}
+	if strings.Contains(view, "loaded incidents") || !strings.Contains(view, "detail: timeout") {
+		t.Errorf("expected only error entries in the view:\n%s", view)
+	}
+
+	// Changing the level keeps the top line in place when not following, or
+	// moves to the next line still shown
+	m.SetDimensions(100, 13) // 1 visible line
+	m, _ = m.Update(tea.KeyPressMsg{Code: '4', Text: "4"})
+	m.autoTail = false
+	m.scrollTo(4)
+	m, _ = m.Update(tea.KeyPressMsg{Code: '2', Text: "2"})
+	if got := m.lines[m.scrollPos]; got != "  detail: timeout" {
+		t.Errorf("expected the top line kept after narrowing, got %q", got)
+	}
+	m, _ = m.Update(tea.KeyPressMsg{Code: '4', Text: "4"})
+	m.scrollTo(5)
+	m, _ = m.Update(tea.KeyPressMsg{Code: '1', Text: "1"})
+	if got := m.lines[m.scrollPos]; got != "10:06 ERRO parse failed" {
+		t.Errorf("expected the next error line at the top, got %q", got)
+	}
+
+	// Narrowing to a level nothing matches says so
+	m.setLines([]string{"10:00 DEBU only debug"})
+	m, _ = m.Update(tea.KeyPressMsg{Code: '1', Text: "1"})
+	if view := stripANSI(m.View()); !strings.Contains(view, "No log entries at this level") {
+		t.Errorf("expected the empty-level notice:\n%s", view)
+	}
+}
+
+func TestLogsModelSearch(t *testing.T) {
+	m := NewLogsModel()
+	m.Visible = true
+	m.SetDimensions(100, 13) // 1 visible line
+	m.setLines([]string{
+		"INFO loaded incidents",
+		"ERRO failed to parse incident",
+		"INFO loaded alerts",
+		"ERRO Failed to parse alert",
+		"DEBU cache hit",
+	})
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	if !m.CapturesKey(tea.KeyPressMsg{Code: 'l', Text: "l"}) {
+		t.Error("expected the open prompt to capture keys")
+	}
+	for _, r := range "PARSE" {
+		m, _ = m.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	// Case-insensitive matches, with the first one after the window scrolled into view
+	if len(m.matches) != 2 {
+		t.Fatalf("expected 2 matches, got %v", m.matches)
+	}
+	if m.matchLine != 1 || m.scrollPos != 1 {
+		t.Errorf("expected the first match in view, matchLine %d scrollPos %d", m.matchLine, m.scrollPos)
+	}
+	if view := stripANSI(m.View()); !strings.Contains(view, "1/2 matches") {
+		t.Errorf("expected the match position in the view:\n%s", view)
+	}
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	if m.matchLine != 3 || m.scrollPos != 3 {
+		t.Errorf("expected n to move to the next match, matchLine %d scrollPos %d", m.matchLine, m.scrollPos)
+	}
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	if m.matchLine != 1 {
+		t.Errorf("expected n to wrap to the first match, got %d", m.matchLine)
+	}
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'N', Text: "N"})
+	if m.matchLine != 3 {
+		t.Errorf("expected N to wrap to the last match, got %d", m.matchLine)
+	}
+
+	// Matches are highlighted without changing the text
+	line := m.renderWindow()
+	if !strings.Contains(stripANSI(line), "ERRO Failed to parse alert") || line == colorizeLogEntry("ERRO Failed to parse alert") {
+		t.Errorf("expected the match highlighted in %q", line)
+	}
+
+	// Selection and copy still cover the lines shown
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'a', Text: "a"})
+	if got := m.selectedText(); !strings.Contains(got, "loaded alerts") {
+		t.Errorf("expected select all to cover every line, got %q", got)
+	}
+
+	// Esc clears the search before the overlay closes
+	esc := tea.KeyPressMsg{Code: tea.KeyEscape}
+	if !m.CapturesKey(esc) {
+		t.Error("expected Esc to be captured while a search is active")
+	}
+	m, _ = m.Update(esc)
+	if m.searchQuery != "" || m.matches != nil {
+		t.Errorf("expected Esc to clear the search, got %q %v", m.searchQuery, m.matches)
+	}
+	if m.CapturesKey(esc) {
+		t.Error("expected Esc to close the overlay once the search is cleared")
+	}
+}
@@ -0,0 +1,45 @@
+package views
+
+import (
+	"regexp"
+
+	"github.com/rootlyhq/rootly-tui/internal/styles"
+)
+
+// hiddenText replaces sensitive values while present mode is on
+const hiddenText = "[hidden]"
+
+var (
+	emailPattern = regexp.MustCompile(`(?i)(mailto:)?[a-z0-9._%+\-]+@[a-z0-9.\-]+\.[a-z]{2,}`)
+	urlPattern   = regexp.MustCompile(`https?://[^\s)\]>"']+`)
+)
+
+// redactSensitive masks email addresses and URLs in free text
+func redactSensitive(s string) string {
+	s = urlPattern.ReplaceAllString(s, hiddenText)
+	return emailPattern.ReplaceAllString(s, hiddenText)
+}
+
+// renderNameWithEmail renders "Name [email]", masking the email in present mode
+func renderNameWithEmail(name, email string, present bool) string {
+	if present && email != "" {
+		return name + " " + styles.TextDim.Render(hiddenText)
+	}
+	return styles.RenderNameWithEmail(name, email)
+}
+
+// renderLink renders a clickable link, or a placeholder without the target in present mode
+func renderLink(url, text string, present bool) string {
+	if present {
+		return styles.TextDim.Render(hiddenText)
+	}
+	return styles.RenderLink(url, text)
+}
+
+// renderMarkdown renders markdown, masking emails and URLs first in present mode
+func renderMarkdown(content string, width int, present bool) string {
+	if present {
+		content = redactSensitive(content)
+	}
+	return styles.RenderMarkdown(content, width)
+}
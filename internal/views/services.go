@@ -0,0 +1,525 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/evertras/bubble-table/table"
+
+	"github.com/rootlyhq/rootly-tui/internal/api"
+	"github.com/rootlyhq/rootly-tui/internal/config"
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
+)
+
+// Column keys for services table
+const (
+	serviceColKeyIndicator = "indicator"
+	serviceColKeyName      = "name"
+	serviceColKeyStatus    = "status"
+	serviceColKeyOwner     = "owner"
+)
+
+// ServicesModel is the services tab: the service catalog with a detail pane
+type ServicesModel struct {
+	services     []api.Service
+	width        int
+	height       int
+	listWidth    int
+	detailWidth  int
+	listHeight   int
+	detailHeight int
+	layout       string // "horizontal" or "vertical"
+	narrow       bool   // width below narrowWidth: panes stack whatever the layout
+	loading      bool
+	error        string
+	// Whether services were ever requested (they're loaded when the tab is first shown)
+	requested bool
+	// Pagination state
+	currentPage int
+	totalPages  int
+	totalCount  int
+	hasNext     bool
+	hasPrev     bool
+	// Loading spinner (passed from app)
+	spinnerView string
+	// Detail viewport for scrollable content
+	detailViewport      viewport.Model
+	detailViewportReady bool
+	detailFocused       bool
+	// Table for list view
+	table    table.Model
+	pageSize int
+}
+
+func NewServicesModel() ServicesModel {
+	columns := []table.Column{
+		table.NewColumn(serviceColKeyIndicator, "", 2),
+		table.NewFlexColumn(serviceColKeyName, i18n.T("services.col.name"), 2),
+		table.NewColumn(serviceColKeyStatus, i18n.T("incidents.detail.status"), 12),
+		table.NewFlexColumn(serviceColKeyOwner, i18n.T("services.detail.owner"), 1),
+	}
+
+	t := table.New(columns).
+		Focused(true).
+		Border(borderNoDividers()).
+		WithBaseStyle(lipgloss.NewStyle().Foreground(styles.ColorText)).
+		HighlightStyle(lipgloss.NewStyle()).
+		HeaderStyle(lipgloss.NewStyle().Bold(true).Foreground(styles.ColorText))
+
+	return ServicesModel{
+		services:    []api.Service{},
+		currentPage: 1,
+		table:       t,
+	}
+}
+
+func (m ServicesModel) Update(msg tea.Msg) (ServicesModel, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		// When detail is focused, handle scrolling keys
+		if m.detailFocused && m.detailViewportReady {
+			switch msg.String() {
+			case "esc", "q":
+				m.detailFocused = false
+				return m, nil
+			case "j", "down":
+				m.detailViewport.ScrollDown(3)
+				return m, nil
+			case "k", "up":
+				m.detailViewport.ScrollUp(3)
+				return m, nil
+			case "g":
+				m.detailViewport.GotoTop()
+				return m, nil
+			case "G":
+				m.detailViewport.GotoBottom()
+				return m, nil
+			}
+			m.detailViewport, cmd = m.detailViewport.Update(msg)
+			return m, cmd
+		}
+
+		// Handle navigation keys ourselves to prevent table's wrap-around behavior
+		cursor := m.table.GetHighlightedRowIndex()
+		switch msg.String() {
+		case "j", "down":
+			if cursor < len(m.services)-1 {
+				m.moveCursor(cursor + 1)
+			}
+			return m, nil
+		case "k", "up":
+			if cursor > 0 {
+				m.moveCursor(cursor - 1)
+			}
+			return m, nil
+		case "g":
+			m.moveCursor(0)
+			return m, nil
+		case "G":
+			if len(m.services) > 0 {
+				m.moveCursor(len(m.services) - 1)
+			}
+			return m, nil
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.updateDimensions()
+		m.updateViewportContent()
+	}
+
+	// Forward mouse messages to viewport for scrolling
+	if m.detailViewportReady && m.detailFocused {
+		m.detailViewport, cmd = m.detailViewport.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// moveCursor selects the service at row and shows its detail
+func (m *ServicesModel) moveCursor(row int) {
+	m.table = m.table.WithHighlightedRow(row).WithRows(m.tableRows(row))
+	m.updateViewportContent()
+}
+
+// tableRows renders the table rows with the indicator on the cursor row
+func (m ServicesModel) tableRows(cursor int) []table.Row {
+	rows := make([]table.Row, len(m.services))
+	for i, svc := range m.services {
+		indicator := ""
+		if i == cursor {
+			indicator = alertRowIndicator
+		}
+		status := svc.Status
+		if status == "" {
+			status = "-"
+		}
+		owner := svc.Owner
+		if owner == "" {
+			owner = "-"
+		}
+		rows[i] = table.NewRow(table.RowData{
+			serviceColKeyIndicator: indicator,
+			serviceColKeyName:      svc.Name,
+			serviceColKeyStatus:    table.NewStyledCell(status, statusStyle(svc.Status)),
+			serviceColKeyOwner:     table.NewStyledCell(owner, styles.TextDim),
+		})
+	}
+	return rows
+}
+
+// updateViewportContent shows the selected service in the detail viewport
+func (m *ServicesModel) updateViewportContent() {
+	if !m.detailViewportReady {
+		return
+	}
+	svc := m.SelectedService()
+	if svc == nil {
+		return
+	}
+	m.detailViewport.SetContent(m.generateDetailContent(svc))
+	m.detailViewport.GotoTop()
+}
+
+func (m *ServicesModel) updateDimensions() {
+	if m.width <= 0 {
+		return
+	}
+	if m.layout == "" {
+		m.layout = config.LayoutHorizontal
+	}
+
+	m.narrow = isNarrow(m.width)
+
+	var tableWidth, tableHeight, viewportWidth, viewportHeight int
+	if m.stacked() {
+		totalContentHeight := max(m.height-2, 10)
+		m.listWidth = m.width - 2
+		m.detailWidth = m.width - 2
+		m.listHeight = (totalContentHeight * 45) / 100
+		m.detailHeight = totalContentHeight - m.listHeight
+
+		tableWidth = m.listWidth - 4
+		tableHeight = max(m.listHeight-5, 3)
+		viewportWidth = m.detailWidth - 4
+		viewportHeight = m.detailHeight - 4
+	} else {
+		totalContentHeight := max(m.height-8, 5)
+		m.listWidth = (m.width - 6) / 2
+		m.detailWidth = m.width - m.listWidth - 6
+		m.listHeight = totalContentHeight
+		m.detailHeight = totalContentHeight
+
+		tableWidth = m.listWidth - 4
+		tableHeight = max(totalContentHeight-6, 3)
+		viewportWidth = m.detailWidth - 4
+		viewportHeight = totalContentHeight - 4
+	}
+
+	// Ensure minimum dimensions (tiny terminals can drive these to zero or below)
+	m.listWidth = max(m.listWidth, 1)
+	m.detailWidth = max(m.detailWidth, 1)
+	tableWidth = max(tableWidth, 10)
+	viewportHeight = max(viewportHeight, 1)
+	viewportWidth = max(viewportWidth, 20)
+
+	pageSize := min(max(tableHeight-2, 3), m.apiPageSize())
+	m.table = m.table.WithTargetWidth(tableWidth).WithMinimumHeight(tableHeight).WithPageSize(pageSize)
+
+	if !m.detailViewportReady {
+		m.detailViewport = viewport.New(viewport.WithWidth(viewportWidth), viewport.WithHeight(viewportHeight))
+		m.detailViewportReady = true
+	} else {
+		m.detailViewport.SetWidth(viewportWidth)
+		m.detailViewport.SetHeight(viewportHeight)
+	}
+}
+
+// SetServices replaces the listed services with a loaded page
+func (m *ServicesModel) SetServices(services []api.Service, pagination api.PaginationInfo) {
+	m.services = services
+	m.loading = false
+	m.error = ""
+	m.currentPage = pagination.CurrentPage
+	m.totalPages = pagination.TotalPages
+	m.totalCount = pagination.TotalCount
+	m.hasNext = pagination.HasNext
+	m.hasPrev = pagination.HasPrev
+
+	cursor := m.table.GetHighlightedRowIndex()
+	if cursor >= len(services) {
+		cursor = max(len(services)-1, 0)
+	}
+	m.table = m.table.WithRows(m.tableRows(cursor)).WithHighlightedRow(cursor)
+	m.updateViewportContent()
+}
+
+// SetLoading shows the loading state; it also marks the services as requested
+func (m *ServicesModel) SetLoading(loading bool) {
+	m.loading = loading
+	if loading {
+		m.requested = true
+	}
+}
+
+// Requested reports whether services were ever loaded (or are loading)
+func (m ServicesModel) Requested() bool {
+	return m.requested
+}
+
+func (m *ServicesModel) SetSpinner(spinner string) {
+	m.spinnerView = spinner
+}
+
+func (m *ServicesModel) SetError(err string) {
+	m.error = err
+	m.loading = false
+}
+
+// SetAPIPageSize sets the page size used by list requests so the table never pages within it
+func (m *ServicesModel) SetAPIPageSize(size int) {
+	m.pageSize = size
+	m.updateDimensions()
+}
+
+// apiPageSize returns the page size used by list requests
+func (m ServicesModel) apiPageSize() int {
+	if m.pageSize > 0 {
+		return m.pageSize
+	}
+	return api.DefaultPageSize
+}
+
+func (m *ServicesModel) SetDimensions(width, height int) {
+	m.width = width
+	m.height = height
+	m.updateDimensions()
+}
+
+// SetLayout sets the layout direction (horizontal or vertical)
+func (m *ServicesModel) SetLayout(layout string) {
+	m.layout = layout
+	m.updateDimensions()
+}
+
+// SetDetailFocused sets focus on the detail pane for scrolling
+func (m *ServicesModel) SetDetailFocused(focused bool) {
+	m.detailFocused = focused
+}
+
+// IsDetailFocused returns whether the detail pane has focus
+func (m ServicesModel) IsDetailFocused() bool {
+	return m.detailFocused
+}
+
+// Pagination methods
+func (m ServicesModel) CurrentPage() int {
+	return m.currentPage
+}
+
+func (m ServicesModel) HasNextPage() bool {
+	return m.hasNext
+}
+
+func (m ServicesModel) HasPrevPage() bool {
+	return m.hasPrev
+}
+
+func (m ServicesModel) TotalPages() int {
+	return m.totalPages
+}
+
+func (m ServicesModel) TotalCount() int {
+	return m.totalCount
+}
+
+func (m *ServicesModel) NextPage() {
+	if m.hasNext && (m.totalPages == 0 || m.currentPage < m.totalPages) {
+		m.currentPage++
+		m.table = m.table.WithHighlightedRow(0)
+	}
+}
+
+func (m *ServicesModel) PrevPage() {
+	if m.hasPrev && m.currentPage > 1 {
+		m.currentPage--
+		m.table = m.table.WithHighlightedRow(0)
+	}
+}
+
+// SetPage moves to a page (at least 1) for the caller to load
+func (m *ServicesModel) SetPage(page int) {
+	m.currentPage = max(page, 1)
+	m.table = m.table.WithHighlightedRow(0)
+}
+
+// SelectedService returns the service under the cursor, or nil
+func (m ServicesModel) SelectedService() *api.Service {
+	if i := m.table.GetHighlightedRowIndex(); i >= 0 && i < len(m.services) {
+		return &m.services[i]
+	}
+	return nil
+}
+
+func (m ServicesModel) View() string {
+	if windowTooSmall(m.width, m.height) {
+		return renderWindowTooSmall()
+	}
+
+	if m.loading {
+		loadingMsg := fmt.Sprintf("%s %s", m.spinnerView, i18n.Tf("incidents.loading_page", map[string]any{"Page": m.currentPage}))
+		listContent := styles.TextBold.Render(i18n.T("services.title")) + "\n\n" + styles.TextDim.Render(loadingMsg)
+		listView := styles.ListContainer.Width(m.listWidth).Height(m.listHeight).Render(listContent)
+		detailView := styles.DetailContainer.Width(m.detailWidth).Height(m.detailHeight).Render(renderDetailSkeleton(m.detailWidth))
+		return m.joinPanes(listView, detailView)
+	}
+
+	if m.error != "" {
+		return styles.Error.Render(i18n.T("common.error") + ": " + m.error)
+	}
+
+	if len(m.services) == 0 {
+		return styles.TextDim.Render(i18n.T("services.none_found"))
+	}
+
+	return m.joinPanes(m.renderList(), m.renderDetail())
+}
+
+// stacked reports whether the list sits above the detail pane: with the
+// vertical layout, or on a narrow terminal where side by side doesn't fit
+func (m ServicesModel) stacked() bool {
+	return m.layout == config.LayoutVertical || m.narrow
+}
+
+// joinPanes joins the list and detail panes based on the current layout
+func (m ServicesModel) joinPanes(listView, detailView string) string {
+	if m.stacked() {
+		return lipgloss.JoinVertical(lipgloss.Left, listView, detailView)
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, listView, "  ", detailView)
+}
+
+func (m ServicesModel) renderList() string {
+	var b strings.Builder
+	b.WriteString(styles.TextBold.Render(i18n.T("services.title")))
+	b.WriteString("\n\n")
+	b.WriteString(m.table.View())
+	b.WriteString("\n")
+
+	// Page navigation footer
+	if m.hasPrev {
+		b.WriteString(styles.TextDim.Render("← ["))
+	} else {
+		b.WriteString(styles.TextDim.Render("  "))
+	}
+	fmt.Fprintf(&b, " %s %d ", i18n.T("common.page"), m.currentPage)
+	if m.hasNext {
+		b.WriteString(styles.TextDim.Render("] →"))
+	}
+	if len(m.services) > 0 {
+		b.WriteString(styles.TextDim.Render(fmt.Sprintf("  (%d-%d)", m.table.GetHighlightedRowIndex()+1, len(m.services))))
+	}
+
+	return styles.ListContainer.Width(m.listWidth).Height(m.listHeight).Render(b.String())
+}
+
+func (m ServicesModel) renderDetail() string {
+	svc := m.SelectedService()
+	if svc == nil {
+		return styles.DetailContainer.Width(m.detailWidth).Height(m.detailHeight).Render(
+			styles.TextDim.Render(i18n.T("services.select_prompt")),
+		)
+	}
+	if !m.detailViewportReady {
+		return styles.DetailContainer.Width(m.detailWidth).Height(m.detailHeight).Render(m.generateDetailContent(svc))
+	}
+
+	content := m.detailViewport.View()
+	if m.detailViewport.TotalLineCount() > m.detailViewport.VisibleLineCount() {
+		scrollPercent := int(m.detailViewport.ScrollPercent() * 100)
+		if m.detailFocused {
+			content += "\n" + styles.Primary.Render(fmt.Sprintf("─── %d%% (j/k scroll, Esc to exit) ───", scrollPercent))
+		} else {
+			content += "\n" + styles.TextDim.Render(fmt.Sprintf("─── %d%% (Enter to scroll) ───", scrollPercent))
+		}
+	}
+
+	containerStyle := styles.DetailContainer
+	if m.detailFocused {
+		containerStyle = styles.DetailContainerFocused
+	}
+	return containerStyle.Width(m.detailWidth).Height(m.detailHeight).Render(content)
+}
+
+func (m ServicesModel) generateDetailContent(svc *api.Service) string {
+	var b strings.Builder
+	b.WriteString(styles.DetailTitle.Render(svc.Name))
+	b.WriteString("\n\n")
+
+	if svc.Status != "" {
+		b.WriteString(m.renderDetailRow(i18n.T("incidents.detail.status"), styles.RenderStatus(svc.Status)))
+	}
+	if svc.Owner != "" {
+		b.WriteString(m.renderDetailRow(i18n.T("services.detail.owner"), styles.DetailValue.Render(svc.Owner)))
+	}
+	if svc.Slug != "" {
+		b.WriteString(m.renderDetailRow(i18n.T("services.detail.slug"), styles.DetailValue.Render(svc.Slug)))
+	}
+	if !svc.UpdatedAt.IsZero() {
+		b.WriteString(m.renderDetailRow(i18n.T("services.detail.updated"), styles.DetailValue.Render(formatRelativeTime(svc.UpdatedAt))))
+	}
+	b.WriteString("\n")
+
+	if url := svc.URL(); url != "" {
+		b.WriteString(styles.TextBold.Render("🔗 " + i18n.T("alerts.detail.links")))
+		b.WriteString("\n")
+		b.WriteString(styles.DetailLabel.Render(i18n.T("incidents.links.rootly")+":") + " " + renderLink(url, url, false))
+		b.WriteString("\n\n")
+	}
+
+	if svc.Description != "" {
+		b.WriteString(styles.TextBold.Render("📝 " + i18n.T("incidents.detail.description")))
+		b.WriteString("\n")
+		b.WriteString(renderMarkdown(svc.Description, max(m.detailWidth-4, 40), false))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func (m ServicesModel) renderDetailRow(label, value string) string {
+	return styles.DetailLabel.Render(label+":") + " " + value + "\n"
+}
+
+// GetDetailPlainText returns the selected service as plain text for the clipboard
+func (m ServicesModel) GetDetailPlainText() string {
+	svc := m.SelectedService()
+	if svc == nil {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(svc.Name + "\n\n")
+	for _, row := range [][2]string{
+		{i18n.T("incidents.detail.status"), svc.Status},
+		{i18n.T("services.detail.owner"), svc.Owner},
+		{i18n.T("services.detail.slug"), svc.Slug},
+		{i18n.T("incidents.links.rootly"), svc.URL()},
+	} {
+		if row[1] != "" {
+			b.WriteString(row[0] + ": " + row[1] + "\n")
+		}
+	}
+	if svc.Description != "" {
+		b.WriteString("\n" + svc.Description + "\n")
+	}
+	return b.String()
+}
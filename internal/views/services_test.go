@@ -0,0 +1,82 @@
+package views
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/api"
+)
+
+func testServices() []api.Service {
+	return []api.Service{
+		{ID: "svc_1", Name: "API", Slug: "api", Status: "operational", Owner: "Platform", Description: "Public REST API"},
+		{ID: "svc_2", Name: "Checkout"},
+	}
+}
+
+func TestServicesModelSetServices(t *testing.T) {
+	m := NewServicesModel()
+	if m.Requested() {
+		t.Error("expected services not to be requested initially")
+	}
+
+	m.SetLoading(true)
+	if !m.Requested() {
+		t.Error("expected SetLoading to mark services as requested")
+	}
+
+	m.SetServices(testServices(), api.PaginationInfo{CurrentPage: 2, HasNext: true, HasPrev: true, TotalPages: 3})
+	if m.loading {
+		t.Error("expected loading to be false after SetServices")
+	}
+	if m.CurrentPage() != 2 || !m.HasNextPage() || !m.HasPrevPage() || m.TotalPages() != 3 {
+		t.Errorf("unexpected pagination: page %d of %d", m.CurrentPage(), m.TotalPages())
+	}
+	if svc := m.SelectedService(); svc == nil || svc.ID != "svc_1" {
+		t.Errorf("expected the first service to be selected, got %+v", svc)
+	}
+}
+
+func TestServicesModelNavigation(t *testing.T) {
+	m := NewServicesModel()
+	m.SetDimensions(100, 30)
+	m.SetServices(testServices(), api.PaginationInfo{CurrentPage: 1})
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	if svc := m.SelectedService(); svc == nil || svc.ID != "svc_2" {
+		t.Errorf("expected svc_2 after 'j', got %+v", svc)
+	}
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'g', Text: "g"})
+	if svc := m.SelectedService(); svc == nil || svc.ID != "svc_1" {
+		t.Errorf("expected svc_1 after 'g', got %+v", svc)
+	}
+}
+
+func TestServicesModelView(t *testing.T) {
+	m := NewServicesModel()
+	m.SetDimensions(120, 30)
+
+	if view := m.View(); !strings.Contains(view, "No services found") {
+		t.Error("expected 'No services found' in empty view")
+	}
+
+	m.SetError("API error")
+	if view := m.View(); !strings.Contains(view, "API error") {
+		t.Error("expected error message in view")
+	}
+
+	m.SetServices(testServices(), api.PaginationInfo{CurrentPage: 1})
+	view := stripANSI(m.View())
+	for _, want := range []string{"Services", "API", "Checkout", "Platform"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in view", want)
+		}
+	}
+
+	text := m.GetDetailPlainText()
+	if !strings.Contains(text, "https://rootly.com/account/services/api") || !strings.Contains(text, "Public REST API") {
+		t.Errorf("unexpected detail text %q", text)
+	}
+}
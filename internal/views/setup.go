@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os/exec"
 	"runtime"
+	"slices"
 	"strings"
 	"time"
 
@@ -57,6 +58,8 @@ const (
 	ConfigFieldTimezone ConfigField = iota
 	ConfigFieldLanguage
 	ConfigFieldLayout
+	ConfigFieldPageSize
+	ConfigFieldTheme
 	ConfigFieldButton
 )
 
@@ -74,6 +77,8 @@ const (
 	FieldTimezone
 	FieldLanguage
 	FieldLayout
+	FieldPageSize
+	FieldTheme
 	FieldButtons
 )
 
@@ -94,16 +99,23 @@ type OAuthLogoutResultMsg struct {
 
 type SetupModel struct {
 	// Connection panel
-	authMethod AuthMethod
-	endpoint   textinput.Model
-	apiKey     textinput.Model
-	connFocus  ConnectionField
-	connButton int // 0 = Test/Login, 1 = Save
-	testing    bool
-	testResult string
-	testError  string
-	connSaved  bool
-	connSaving bool
+	authMethod    AuthMethod
+	endpoint      textinput.Model
+	endpointError string // Shown under the endpoint when it isn't a hostname or URL
+	apiKey        textinput.Model
+	connFocus     ConnectionField
+	connButton    int // 0 = Test/Login, 1 = Save
+	testing       bool
+	testResult    string
+	testError     string
+	testHint      string
+	connSaved     bool
+	connSaving    bool
+
+	// The running config's key or endpoint came from the environment, so
+	// it isn't shown here; left blank, the saved one is kept
+	apiKeyFromEnv   bool
+	endpointFromEnv bool
 
 	// OAuth state
 	oauthLoggingIn bool
@@ -117,6 +129,10 @@ type SetupModel struct {
 	languageIndex int
 	layouts       []string
 	layoutIndex   int
+	pageSizes     []int
+	pageSizeIndex int
+	themes        []string
+	themeIndex    int
 	configFocus   ConfigField
 	configSaved   bool
 	configSaving  bool
@@ -133,11 +149,14 @@ type SetupModel struct {
 	originalTimezoneIndex int
 	originalLanguageIndex int
 	originalLayoutIndex   int
+	originalPageSizeIndex int
+	originalThemeIndex    int
 }
 
 type APIKeyValidatedMsg struct {
 	Valid bool
 	Error string
+	Hint  string // Guidance for fixing the failure, if known
 }
 
 type ConfigSavedMsg struct {
@@ -178,14 +197,21 @@ func NewSetupModelWithConfig(cfg *config.Config) SetupModel {
 	tzIndex := 0
 	langIndex := 0
 	layoutIndex := 0
+	themeIndex := 0
+	pageSize := config.DefaultPageSize
 	authMethod := AuthMethodOAuth // Default to OAuth
 
 	// Check if we already have OAuth tokens
 	oauthLoggedIn := cfg != nil && cfg.HasOAuthTokens()
 
 	if cfg != nil && cfg.IsValid() {
-		endpointInput.SetValue(cfg.Endpoint)
-		apiKeyInput.SetValue(cfg.APIKey)
+		// Environment values aren't pre-filled, so saving can't write them
+		if !cfg.EndpointFromEnv {
+			endpointInput.SetValue(cfg.Endpoint)
+		}
+		if !cfg.APIKeyFromEnv {
+			apiKeyInput.SetValue(cfg.APIKey)
+		}
 
 		if cfg.UseOAuth {
 			authMethod = AuthMethodOAuth
@@ -208,6 +234,9 @@ func NewSetupModelWithConfig(cfg *config.Config) SetupModel {
 				break
 			}
 		}
+
+		themeIndex = themeOptionIndex(cfg.Theme)
+		pageSize = cfg.ListPageSize()
 	} else {
 		endpointInput.SetValue(config.DefaultEndpoint)
 
@@ -223,6 +252,8 @@ func NewSetupModelWithConfig(cfg *config.Config) SetupModel {
 		langIndex = i18n.LanguageIndex(string(detectedLang))
 	}
 
+	pageSizes, pageSizeIndex := pageSizeOptions(pageSize)
+
 	s := spinner.New()
 	s.Spinner = spinner.Dot
 	s.Style = styles.Spinner
@@ -235,6 +266,8 @@ func NewSetupModelWithConfig(cfg *config.Config) SetupModel {
 		endpoint:              endpointInput,
 		apiKey:                apiKeyInput,
 		connFocus:             ConnFieldAuthMethod,
+		apiKeyFromEnv:         cfg != nil && cfg.APIKeyFromEnv,
+		endpointFromEnv:       cfg != nil && cfg.EndpointFromEnv,
 		connButton:            0,
 		oauthLoggedIn:         oauthLoggedIn,
 		isFirstRun:            firstRun,
@@ -245,15 +278,44 @@ func NewSetupModelWithConfig(cfg *config.Config) SetupModel {
 		languageIndex:         langIndex,
 		layouts:               layouts,
 		layoutIndex:           layoutIndex,
+		pageSizes:             pageSizes,
+		pageSizeIndex:         pageSizeIndex,
+		themes:                styles.ThemeNames,
+		themeIndex:            themeIndex,
 		configFocus:           ConfigFieldTimezone,
 		activePanel:           PanelConnection,
 		spinner:               s,
 		originalTimezoneIndex: tzIndex,
 		originalLanguageIndex: langIndex,
 		originalLayoutIndex:   layoutIndex,
+		originalPageSizeIndex: pageSizeIndex,
+		originalThemeIndex:    themeIndex,
 	}
 }
 
+// themeOptionIndex returns the setup option index of a configured theme;
+// "rootly" is the dark theme, and unknown themes select auto
+func themeOptionIndex(theme string) int {
+	theme = strings.ToLower(strings.TrimSpace(theme))
+	if theme == styles.ThemeRootly {
+		theme = styles.ThemeDark
+	}
+	return max(slices.Index(styles.ThemeNames, theme), 0)
+}
+
+// pageSizeOptions returns the page sizes offered in the setup screen and the
+// index of current, which is added to the options when it isn't one of them
+func pageSizeOptions(current int) ([]int, int) {
+	options := slices.Clone(config.PageSizeOptions)
+	index := slices.Index(options, current)
+	if index < 0 {
+		options = append(options, current)
+		slices.Sort(options)
+		index = slices.Index(options, current)
+	}
+	return options, index
+}
+
 func (m SetupModel) Init() tea.Cmd {
 	if m.isFirstRun {
 		return tea.Batch(textinput.Blink, m.welcome.Init())
@@ -300,6 +362,7 @@ func (m SetupModel) Update(msg tea.Msg) (SetupModel, tea.Cmd) {
 			m.oauthLoggedIn = false
 			m.testResult = ""
 			m.testError = ""
+			m.testHint = ""
 			m.connSaved = false
 			m.connButton = 0
 		}
@@ -327,8 +390,12 @@ func (m SetupModel) Update(msg tea.Msg) (SetupModel, tea.Cmd) {
 	if m.activePanel == PanelConnection {
 		switch m.connFocus {
 		case ConnFieldEndpoint:
+			before := m.endpoint.Value()
 			m.endpoint, cmd = m.endpoint.Update(msg)
 			cmds = append(cmds, cmd)
+			if m.endpoint.Value() != before {
+				m.endpointError = ""
+			}
 		case ConnFieldAPIKey:
 			m.apiKey, cmd = m.apiKey.Update(msg)
 			cmds = append(cmds, cmd)
@@ -385,6 +452,9 @@ func (m SetupModel) handleKeyTab() SetupModel {
 		return m // No tab switching during first-run wizard
 	}
 	if m.activePanel == PanelConnection {
+		if m.connFocus == ConnFieldEndpoint {
+			m.validateEndpoint()
+		}
 		m.activePanel = PanelConfig
 		m.endpoint.Blur()
 		m.apiKey.Blur()
@@ -469,6 +539,14 @@ func (m *SetupModel) handleConfigLeft() {
 		if m.layoutIndex > 0 {
 			m.layoutIndex--
 		}
+	case ConfigFieldPageSize:
+		if m.pageSizeIndex > 0 {
+			m.pageSizeIndex--
+		}
+	case ConfigFieldTheme:
+		if m.themeIndex > 0 {
+			m.themeIndex--
+		}
 	}
 }
 
@@ -494,6 +572,7 @@ func (m SetupModel) handleKeyRight() SetupModel {
 func (m *SetupModel) resetAuthState() {
 	m.testResult = ""
 	m.testError = ""
+	m.testHint = ""
 	m.oauthError = ""
 	m.connSaved = false
 	m.connButton = 0
@@ -514,6 +593,14 @@ func (m *SetupModel) handleConfigRight() {
 		if m.layoutIndex < len(m.layouts)-1 {
 			m.layoutIndex++
 		}
+	case ConfigFieldPageSize:
+		if m.pageSizeIndex < len(m.pageSizes)-1 {
+			m.pageSizeIndex++
+		}
+	case ConfigFieldTheme:
+		if m.themeIndex < len(m.themes)-1 {
+			m.themeIndex++
+		}
 	}
 }
 
@@ -537,12 +624,17 @@ func (m SetupModel) maxConnButton() int {
 func (m SetupModel) handleConnectionEnter() (SetupModel, tea.Cmd) {
 	if m.connFocus == ConnFieldButtons {
 		if m.connButton == 0 {
+			// Catch a malformed endpoint here instead of from a failed request
+			if !m.validateEndpoint() {
+				return m, nil
+			}
 			if m.authMethod == AuthMethodOAuth {
 				// Start OAuth login flow
 				m.oauthLoggingIn = true
 				m.oauthError = ""
 				m.testResult = ""
 				m.testError = ""
+				m.testHint = ""
 				m.connSaved = false
 				return m, tea.Batch(m.spinner.Tick, m.doOAuthLogin())
 			}
@@ -550,6 +642,7 @@ func (m SetupModel) handleConnectionEnter() (SetupModel, tea.Cmd) {
 			m.testing = true
 			m.testResult = ""
 			m.testError = ""
+			m.testHint = ""
 			m.connSaved = false
 			return m, tea.Batch(m.spinner.Tick, m.doTestConnection())
 		}
@@ -595,6 +688,9 @@ func (m SetupModel) handleConfigEnter() (SetupModel, tea.Cmd) {
 }
 
 func (m *SetupModel) updateConnectionFocus() {
+	if m.endpoint.Focused() && m.connFocus != ConnFieldEndpoint {
+		m.validateEndpoint()
+	}
 	m.endpoint.Blur()
 	m.apiKey.Blur()
 
@@ -608,6 +704,26 @@ func (m *SetupModel) updateConnectionFocus() {
 	}
 }
 
+// validateEndpoint sets or clears the inline endpoint error, reporting whether
+// the endpoint is usable
+func (m *SetupModel) validateEndpoint() bool {
+	if err := api.ValidateEndpoint(m.endpoint.Value()); err != nil {
+		debug.Logger.Debug("Invalid endpoint", "error", err)
+		m.endpointError = i18n.T("setup.endpoint_invalid")
+		return false
+	}
+	m.endpointError = ""
+	return true
+}
+
+// endpointValue returns the entered endpoint, or the default when it's blank
+func (m SetupModel) endpointValue() string {
+	if endpoint := strings.TrimSpace(m.endpoint.Value()); endpoint != "" {
+		return endpoint
+	}
+	return config.DefaultEndpoint
+}
+
 func (m SetupModel) doOAuthLogout() tea.Cmd {
 	return func() tea.Msg {
 		if err := oauth.ClearTokens(); err != nil {
@@ -621,7 +737,7 @@ func (m SetupModel) doOAuthLogout() tea.Cmd {
 func (m SetupModel) doTestConnection() tea.Cmd {
 	return func() tea.Msg {
 		cfg := &config.Config{
-			Endpoint: m.endpoint.Value(),
+			Endpoint: m.endpointValue(),
 			APIKey:   m.apiKey.Value(),
 		}
 
@@ -631,16 +747,38 @@ func (m SetupModel) doTestConnection() tea.Cmd {
 		}
 
 		ctx := context.Background()
-		if err := client.ValidateAPIKey(ctx); err != nil {
-			return APIKeyValidatedMsg{Valid: false, Error: err.Error()}
+		result := client.Diagnose(ctx)
+		if !result.OK() {
+			errMsg, hint := diagnoseFeedback(result)
+			return APIKeyValidatedMsg{Valid: false, Error: errMsg, Hint: hint}
 		}
 
 		return APIKeyValidatedMsg{Valid: true}
 	}
 }
 
+// diagnoseFeedback turns a failed connectivity check into an error and a fix-it hint
+func diagnoseFeedback(result api.DiagnoseResult) (errMsg, hint string) {
+	data := map[string]any{"Host": result.Host}
+	switch result.Status {
+	case api.DiagnoseDNS:
+		return i18n.T("setup.diag.dns"), i18n.Tf("setup.diag.dns_hint", data)
+	case api.DiagnoseConnect:
+		return i18n.T("setup.diag.connect"), i18n.Tf("setup.diag.connect_hint", data)
+	case api.DiagnoseTLS:
+		return i18n.T("setup.diag.tls"), i18n.T("setup.diag.tls_hint")
+	case api.DiagnoseAuth:
+		return i18n.T("setup.diag.auth"), i18n.T("setup.diag.auth_hint")
+	default:
+		if result.Err != nil {
+			return result.Err.Error(), ""
+		}
+		return i18n.T("common.error"), ""
+	}
+}
+
 func (m SetupModel) doOAuthLogin() tea.Cmd {
-	endpointVal := m.endpoint.Value()
+	endpointVal := m.endpointValue()
 	return func() tea.Msg {
 		apiBaseURL := oauth.DeriveAPIBaseURL(endpointVal)
 		authBaseURL := oauth.DeriveAuthBaseURL(endpointVal)
@@ -800,10 +938,14 @@ func (m SetupModel) doSaveConnection() tea.Cmd {
 	if m.layoutIndex >= 0 && m.layoutIndex < len(m.layouts) {
 		layout = m.layouts[m.layoutIndex]
 	}
+	pageSize := m.selectedPageSize()
+	theme := m.selectedTheme()
 
 	useOAuth := m.authMethod == AuthMethodOAuth
 	apiKeyVal := m.apiKey.Value()
-	endpointVal := m.endpoint.Value()
+	endpointVal := m.endpointValue()
+	keepAPIKey := m.apiKeyFromEnv && apiKeyVal == ""
+	keepEndpoint := m.endpointFromEnv && strings.TrimSpace(m.endpoint.Value()) == ""
 
 	return func() tea.Msg {
 		// Load existing config to preserve OAuth tokens
@@ -812,11 +954,17 @@ func (m SetupModel) doSaveConnection() tea.Cmd {
 			cfg = &config.Config{}
 		}
 
-		cfg.Endpoint = endpointVal
-		cfg.APIKey = apiKeyVal
+		if !keepEndpoint || cfg.Endpoint == "" {
+			cfg.Endpoint = endpointVal
+		}
+		if !keepAPIKey {
+			cfg.APIKey = apiKeyVal
+		}
 		cfg.Timezone = timezone
 		cfg.Language = language
 		cfg.Layout = layout
+		cfg.PageSize = pageSize
+		cfg.Theme = theme
 		cfg.UseOAuth = useOAuth
 
 		if useOAuth {
@@ -844,22 +992,24 @@ func (m SetupModel) doSavePreferences() tea.Cmd {
 	if m.layoutIndex >= 0 && m.layoutIndex < len(m.layouts) {
 		layout = m.layouts[m.layoutIndex]
 	}
+	pageSize := m.selectedPageSize()
+	theme := m.selectedTheme()
 
 	return func() tea.Msg {
-		// Load existing config to preserve connection settings
+		// Load existing config to preserve connection settings; without one
+		// only the preferences are saved, credentials are left to the
+		// connection panel
 		existingCfg, err := config.Load()
 		if err != nil {
-			// If no existing config, use current values
-			existingCfg = &config.Config{
-				Endpoint: m.endpoint.Value(),
-				APIKey:   m.apiKey.Value(),
-			}
+			existingCfg = &config.Config{}
 		}
 
 		// Update only preferences, preserve everything else (including OAuth tokens)
 		existingCfg.Timezone = timezone
 		existingCfg.Language = language
 		existingCfg.Layout = layout
+		existingCfg.PageSize = pageSize
+		existingCfg.Theme = theme
 		cfg := existingCfg
 
 		if err := config.Save(cfg); err != nil {
@@ -875,9 +1025,11 @@ func (m *SetupModel) HandleValidationResult(msg APIKeyValidatedMsg) {
 	if msg.Valid {
 		m.testResult = testResultSuccess
 		m.testError = ""
+		m.testHint = ""
 	} else {
 		m.testResult = testResultError
 		m.testError = msg.Error
+		m.testHint = msg.Hint
 	}
 }
 
@@ -894,6 +1046,8 @@ func (m *SetupModel) HandlePreferencesSaved(msg PreferencesSavedMsg) {
 		m.originalTimezoneIndex = m.timezoneIndex
 		m.originalLanguageIndex = m.languageIndex
 		m.originalLayoutIndex = m.layoutIndex
+		m.originalPageSizeIndex = m.pageSizeIndex
+		m.originalThemeIndex = m.themeIndex
 	}
 }
 
@@ -927,6 +1081,9 @@ func (m SetupModel) IsConfigSaved() bool {
 }
 
 func (m SetupModel) View() string {
+	if windowTooSmall(m.width, m.height) {
+		return renderWindowTooSmall()
+	}
 	if m.isFirstRun {
 		return m.renderFirstRunView()
 	}
@@ -992,7 +1149,11 @@ func (m SetupModel) renderFirstRunPanel(panelWidth int) string {
 	} else {
 		b.WriteString(styles.InputField.Render(m.endpoint.View()))
 	}
-	b.WriteString("\n\n")
+	b.WriteString("\n")
+	if m.endpointError != "" {
+		b.WriteString(styles.Error.Render(m.endpointError) + "\n")
+	}
+	b.WriteString("\n")
 
 	// API key field or OAuth hint
 	if m.authMethod == AuthMethodAPIKey {
@@ -1017,7 +1178,11 @@ func (m SetupModel) renderFirstRunPanel(panelWidth int) string {
 		if len(errMsg) > 40 {
 			errMsg = errMsg[:37] + "..."
 		}
-		b.WriteString(styles.Error.Render("Error: "+errMsg) + "\n\n")
+		b.WriteString(styles.Error.Render("Error: "+errMsg) + "\n")
+		if m.testHint != "" {
+			b.WriteString(styles.TextDim.Render(m.testHint) + "\n")
+		}
+		b.WriteString("\n")
 	} else if m.connSaving {
 		b.WriteString(m.spinner.View() + " Saving...\n\n")
 	}
@@ -1125,7 +1290,11 @@ func (m SetupModel) renderConnectionPanel() string {
 	} else {
 		b.WriteString(styles.InputField.Render(m.endpoint.View()))
 	}
-	b.WriteString("\n\n")
+	b.WriteString("\n")
+	if m.endpointError != "" {
+		b.WriteString(styles.Error.Render(m.endpointError) + "\n")
+	}
+	b.WriteString("\n")
 
 	if m.authMethod == AuthMethodAPIKey {
 		// API Key field (only for API key auth)
@@ -1166,7 +1335,12 @@ func (m SetupModel) renderConnectionPanel() string {
 			errMsg = errMsg[:37] + "..."
 		}
 		b.WriteString(styles.Error.Render(errMsg))
-		b.WriteString("\n\n")
+		b.WriteString("\n")
+		if m.testHint != "" {
+			b.WriteString(styles.TextDim.Render(m.testHint))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
 	} else {
 		b.WriteString("\n\n")
 	}
@@ -1286,6 +1460,30 @@ func (m SetupModel) renderConfigPanel() string {
 	}
 	b.WriteString("\n\n")
 
+	// Page size selector
+	pageSizeLabel := styles.InputLabel.Render(i18n.T("setup.page_size"))
+	b.WriteString(pageSizeLabel)
+	b.WriteString("\n")
+	pageSizeDisplay := fmt.Sprintf("◀ %s ▶", i18n.Tf("setup.page_size_value", map[string]any{"Count": m.selectedPageSize()}))
+	if m.activePanel == PanelConfig && m.configFocus == ConfigFieldPageSize {
+		b.WriteString(styles.InputFieldFocused.Render(pageSizeDisplay))
+	} else {
+		b.WriteString(styles.InputField.Render(pageSizeDisplay))
+	}
+	b.WriteString("\n\n")
+
+	// Theme selector
+	themeLabel := styles.InputLabel.Render(i18n.T("setup.theme"))
+	b.WriteString(themeLabel)
+	b.WriteString("\n")
+	themeDisplay := fmt.Sprintf("◀ %s ▶", themeDisplayName(m.selectedTheme()))
+	if m.activePanel == PanelConfig && m.configFocus == ConfigFieldTheme {
+		b.WriteString(styles.InputFieldFocused.Render(themeDisplay))
+	} else {
+		b.WriteString(styles.InputField.Render(themeDisplay))
+	}
+	b.WriteString("\n\n")
+
 	// Spacer to match connection panel height (test result area equivalent)
 	b.WriteString("\n\n")
 
@@ -1312,6 +1510,42 @@ func (m SetupModel) renderConfigPanel() string {
 	return b.String()
 }
 
+// selectedPageSize returns the page size chosen in the config panel
+func (m SetupModel) selectedPageSize() int {
+	if m.pageSizeIndex >= 0 && m.pageSizeIndex < len(m.pageSizes) {
+		return m.pageSizes[m.pageSizeIndex]
+	}
+	return config.DefaultPageSize
+}
+
+// selectedTheme returns the theme chosen in the config panel, "" for auto
+func (m SetupModel) selectedTheme() string {
+	if m.themeIndex > 0 && m.themeIndex < len(m.themes) {
+		return m.themes[m.themeIndex]
+	}
+	return ""
+}
+
+// themeDisplayName returns a human-readable name for a theme value
+func themeDisplayName(theme string) string {
+	switch theme {
+	case "", styles.ThemeAuto:
+		return i18n.T("setup.theme_auto")
+	case styles.ThemeDark:
+		return i18n.T("setup.theme_dark")
+	case styles.ThemeLight:
+		return i18n.T("setup.theme_light")
+	case styles.ThemeSolarizedDark:
+		return "Solarized dark"
+	case styles.ThemeNord:
+		return "Nord"
+	case styles.ThemeMono:
+		return "Mono"
+	default:
+		return theme
+	}
+}
+
 // layoutDisplayName returns a human-readable name for a layout value
 func layoutDisplayName(layout string) string {
 	switch layout {
@@ -1346,6 +1580,10 @@ func (m SetupModel) FocusIndex() SetupField {
 			return FieldLanguage
 		case ConfigFieldLayout:
 			return FieldLayout
+		case ConfigFieldPageSize:
+			return FieldPageSize
+		case ConfigFieldTheme:
+			return FieldTheme
 		case ConfigFieldButton:
 			return FieldButtons
 		}
@@ -1372,6 +1610,14 @@ func (m SetupModel) LayoutIndex() int {
 	return m.layoutIndex
 }
 
+func (m SetupModel) PageSize() int {
+	return m.selectedPageSize()
+}
+
+func (m SetupModel) Theme() string {
+	return m.selectedTheme()
+}
+
 func (m SetupModel) ActivePanel() Panel {
 	return m.activePanel
 }
@@ -1,11 +1,17 @@
 package views
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
+
+	"github.com/rootlyhq/rootly-tui/internal/api"
+	"github.com/rootlyhq/rootly-tui/internal/config"
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
 )
 
 // Note: TestMain in help_test.go sets i18n.LangEnglish for all tests in this package
@@ -139,6 +145,18 @@ func TestSetupModelConfigPanelNavigation(t *testing.T) {
 		t.Errorf("expected focus on layout after down, got %v", m.FocusIndex())
 	}
 
+	// Down moves to page size
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	if m.FocusIndex() != FieldPageSize {
+		t.Errorf("expected focus on page size after down, got %v", m.FocusIndex())
+	}
+
+	// Down moves to theme
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	if m.FocusIndex() != FieldTheme {
+		t.Errorf("expected focus on theme after down, got %v", m.FocusIndex())
+	}
+
 	// Down moves to button
 	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
 	if m.FocusIndex() != FieldButtons {
@@ -229,7 +247,19 @@ func TestSetupModelEnterMovesToNextInConfigPanel(t *testing.T) {
 		t.Errorf("expected focus on layout after enter, got %v", m.FocusIndex())
 	}
 
-	// Enter on layout moves to button
+	// Enter on layout moves to page size
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.FocusIndex() != FieldPageSize {
+		t.Errorf("expected focus on page size after enter, got %v", m.FocusIndex())
+	}
+
+	// Enter on page size moves to theme
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.FocusIndex() != FieldTheme {
+		t.Errorf("expected focus on theme after enter, got %v", m.FocusIndex())
+	}
+
+	// Enter on theme moves to button
 	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
 	if m.FocusIndex() != FieldButtons {
 		t.Errorf("expected focus on button after enter, got %v", m.FocusIndex())
@@ -384,6 +414,86 @@ func TestSetupModelViewWithTestResult(t *testing.T) {
 	}
 }
 
+func TestDiagnoseFeedback(t *testing.T) {
+	tests := []struct {
+		status    api.DiagnoseStatus
+		wantError string
+		wantHint  string
+	}{
+		{api.DiagnoseDNS, "Host not found", "api.example.invalid"},
+		{api.DiagnoseConnect, "Connection refused", "proxy"},
+		{api.DiagnoseTLS, "TLS handshake failed", "http://"},
+		{api.DiagnoseAuth, "Invalid API key", "API Keys"},
+		{api.DiagnoseHTTP, "API returned status 500", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			errMsg, hint := diagnoseFeedback(api.DiagnoseResult{
+				Status: tt.status,
+				Host:   "api.example.invalid",
+				Err:    errors.New("API returned status 500"),
+			})
+			if errMsg != tt.wantError {
+				t.Errorf("expected error %q, got %q", tt.wantError, errMsg)
+			}
+			if !strings.Contains(hint, tt.wantHint) {
+				t.Errorf("expected hint to contain %q, got %q", tt.wantHint, hint)
+			}
+		})
+	}
+}
+
+func TestSetupModelViewShowsDiagnoseHint(t *testing.T) {
+	m := newFullSetupModel()
+	m.SetDimensions(150, 50)
+
+	m.HandleValidationResult(APIKeyValidatedMsg{Valid: false, Error: "Host not found", Hint: "Check the endpoint (bad.host)"})
+	view := stripANSI(m.View())
+	if !strings.Contains(view, "Host not found") {
+		t.Error("expected view to contain the diagnosis")
+	}
+	if !strings.Contains(view, "Check the endpoint (bad.host)") {
+		t.Error("expected view to contain the hint")
+	}
+}
+
+func TestSetupModelEndpointValidation(t *testing.T) {
+	m := newFullSetupModel()
+	m.SetDimensions(150, 50)
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyDown}) // endpoint
+	m.endpoint.SetValue("not a url")
+
+	// Leaving the field flags it
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	if m.endpointError == "" {
+		t.Fatal("expected an inline error after leaving an invalid endpoint")
+	}
+	if view := stripANSI(m.View()); !strings.Contains(view, i18n.T("setup.endpoint_invalid")) {
+		t.Error("expected the view to show the endpoint error")
+	}
+
+	// Test Connection doesn't reach the API
+	m.connFocus = ConnFieldButtons
+	m.connButton = 0
+	m, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd != nil || m.IsTesting() {
+		t.Error("expected Test Connection to stop at the invalid endpoint")
+	}
+
+	// Editing clears the error, and a blank endpoint means the default
+	m.connFocus = ConnFieldEndpoint
+	m.updateConnectionFocus()
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyBackspace})
+	if m.endpointError != "" {
+		t.Error("expected editing to clear the error")
+	}
+	m.endpoint.SetValue("")
+	if !m.validateEndpoint() || m.endpointValue() != config.DefaultEndpoint {
+		t.Errorf("expected a blank endpoint to mean %s, got %q", config.DefaultEndpoint, m.endpointValue())
+	}
+}
+
 func TestSetupModelViewWhileTesting(t *testing.T) {
 	m := newFullSetupModel()
 	m.SetDimensions(150, 50)
@@ -505,6 +615,84 @@ func TestSetupModelLayoutNavigation(t *testing.T) {
 	}
 }
 
+func TestSetupModelPageSizeNavigation(t *testing.T) {
+	m := newFullSetupModel()
+
+	// Switch to config panel and navigate to page size
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	for range 3 {
+		m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	}
+	if m.FocusIndex() != FieldPageSize {
+		t.Fatalf("expected focus on page size, got %v", m.FocusIndex())
+	}
+
+	if m.PageSize() != config.DefaultPageSize {
+		t.Errorf("expected initial page size %d, got %d", config.DefaultPageSize, m.PageSize())
+	}
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyRight})
+	if m.PageSize() != 50 {
+		t.Errorf("expected page size 50 after right, got %d", m.PageSize())
+	}
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyLeft})
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyLeft})
+	if m.PageSize() != 10 {
+		t.Errorf("expected page size 10 after left, got %d", m.PageSize())
+	}
+}
+
+func TestSetupModelPageSizeFromConfig(t *testing.T) {
+	cfg := &config.Config{APIKey: "key", Endpoint: "api.rootly.com", PageSize: 40}
+	m := NewSetupModelWithConfig(cfg)
+	if m.PageSize() != 40 {
+		t.Errorf("expected a page size outside the options to be kept, got %d", m.PageSize())
+	}
+
+	cfg.PageSize = 1000
+	m = NewSetupModelWithConfig(cfg)
+	if m.PageSize() != config.MaxPageSize {
+		t.Errorf("expected page size capped at %d, got %d", config.MaxPageSize, m.PageSize())
+	}
+}
+
+func TestSetupModelThemeNavigation(t *testing.T) {
+	m := newFullSetupModel()
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	for range 4 {
+		m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	}
+	if m.FocusIndex() != FieldTheme {
+		t.Fatalf("expected focus on theme, got %v", m.FocusIndex())
+	}
+	if m.Theme() != "" {
+		t.Errorf("expected auto theme by default, got %q", m.Theme())
+	}
+	if !strings.Contains(stripANSI(m.View()), "Auto (match terminal)") {
+		t.Error("expected the auto theme in the config panel")
+	}
+	for range 3 {
+		m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyRight})
+	}
+	if m.Theme() != styles.ThemeSolarizedDark {
+		t.Errorf("expected solarized-dark after three rights, got %q", m.Theme())
+	}
+}
+
+func TestSetupModelThemeFromConfig(t *testing.T) {
+	for _, tt := range []struct{ theme, want string }{
+		{"nord", styles.ThemeNord},
+		{"rootly", styles.ThemeDark},
+		{"Mono", styles.ThemeMono},
+		{"unknown", ""},
+	} {
+		m := NewSetupModelWithConfig(&config.Config{APIKey: "key", Endpoint: "api.rootly.com", Theme: tt.theme})
+		if m.Theme() != tt.want {
+			t.Errorf("theme %q: expected %q selected, got %q", tt.theme, tt.want, m.Theme())
+		}
+	}
+}
+
 func TestSetupModelEnterOnTestButton(t *testing.T) {
 	m := newFullSetupModel()
 	m.authMethod = AuthMethodAPIKey
@@ -593,6 +781,49 @@ func TestSetupModelPreferencesSaved(t *testing.T) {
 	}
 }
 
+func TestSetupModelKeepsEnvironmentCredentialsOut(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if err := config.Save(&config.Config{APIKey: "file-key", Endpoint: "file.rootly.com"}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+
+	cfg := &config.Config{APIKey: "env-key", Endpoint: "env.rootly.com", APIKeyFromEnv: true, EndpointFromEnv: true}
+	m := NewSetupModelWithConfig(cfg)
+	if m.apiKey.Value() != "" || m.endpoint.Value() != "" {
+		t.Fatalf("expected environment values not to be pre-filled, got key %q endpoint %q", m.apiKey.Value(), m.endpoint.Value())
+	}
+
+	// Saving the connection with the fields left blank keeps the file's values
+	m.authMethod = AuthMethodAPIKey
+	if msg := m.doSaveConnection()(); !msg.(ConnectionSavedMsg).Success {
+		t.Fatalf("expected the connection to be saved, got %+v", msg)
+	}
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if saved.APIKey != "file-key" || saved.Endpoint != "file.rootly.com" {
+		t.Errorf("expected the file's credentials to be kept, got key %q endpoint %q", saved.APIKey, saved.Endpoint)
+	}
+}
+
+func TestSetupModelSavePreferencesWithoutConfig(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	m := newFullSetupModel()
+	m.apiKey.SetValue("typed-key")
+	if msg := m.doSavePreferences()(); !msg.(PreferencesSavedMsg).Success {
+		t.Fatalf("expected the preferences to be saved, got %+v", msg)
+	}
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if saved.APIKey != "" {
+		t.Errorf("expected no credentials to be saved with the preferences, got key %q", saved.APIKey)
+	}
+}
+
 func TestSetupModelJKNavigation(t *testing.T) {
 	m := newFullSetupModel()
 	m.authMethod = AuthMethodAPIKey
@@ -664,3 +895,11 @@ func TestSetupModelHLNavigation(t *testing.T) {
 		t.Errorf("expected timezone index to decrease after 'h'")
 	}
 }
+
+func TestSetupModelWindowTooSmall(t *testing.T) {
+	m := NewSetupModel()
+	m.SetDimensions(10, 3)
+	if view := stripANSI(m.View()); view != "Window too small" {
+		t.Errorf("expected window too small notice, got %q", view)
+	}
+}
@@ -0,0 +1,156 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rootlyhq/rootly-tui/internal/i18n"
+	"github.com/rootlyhq/rootly-tui/internal/styles"
+)
+
+// SummaryDays is how many days the summary overlay covers
+const SummaryDays = 7
+
+// sparklineGlyphs are the bar heights used by the sparkline, lowest first
+var sparklineGlyphs = []rune("▁▂▃▅▇")
+
+// DayCount is the number of incidents created on a given day
+type DayCount struct {
+	Day   time.Time
+	Count int
+}
+
+// SummaryModel is an overlay showing incidents created per day over the last week
+type SummaryModel struct {
+	Visible bool
+	loading bool
+	error   string
+	days    []DayCount
+}
+
+func NewSummaryModel() SummaryModel {
+	return SummaryModel{}
+}
+
+func (m *SummaryModel) Toggle() {
+	m.Visible = !m.Visible
+}
+
+func (m *SummaryModel) SetLoading(loading bool) {
+	m.loading = loading
+}
+
+func (m *SummaryModel) SetError(err string) {
+	m.error = err
+	m.loading = false
+}
+
+// SetCreatedTimes buckets incident creation times into the last SummaryDays days
+func (m *SummaryModel) SetCreatedTimes(times []time.Time, now time.Time, loc *time.Location) {
+	m.days = BucketByDay(times, now, loc, SummaryDays)
+	m.error = ""
+	m.loading = false
+}
+
+// BucketByDay counts times per calendar day in loc, for the given number of days ending today.
+// Times outside the range are ignored. The result is ordered oldest day first.
+func BucketByDay(times []time.Time, now time.Time, loc *time.Location, days int) []DayCount {
+	if loc == nil {
+		loc = time.Local
+	}
+	if days < 1 {
+		return nil
+	}
+
+	now = now.In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	// AddDate steps calendar days, so buckets stay aligned across DST changes
+	buckets := make([]DayCount, days)
+	for i := range buckets {
+		buckets[i].Day = today.AddDate(0, 0, i-days+1)
+	}
+
+	for _, t := range times {
+		t = t.In(loc)
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		for i := range buckets {
+			if buckets[i].Day.Equal(day) {
+				buckets[i].Count++
+				break
+			}
+		}
+	}
+	return buckets
+}
+
+// sparklineGlyph picks the bar for count relative to the busiest day.
+// Zero is always the lowest bar and any non-zero count is at least one step above it.
+func sparklineGlyph(count, maxCount int) rune {
+	if count <= 0 || maxCount <= 0 {
+		return sparklineGlyphs[0]
+	}
+	steps := len(sparklineGlyphs) - 1
+	level := (count*steps + maxCount - 1) / maxCount // Round up
+	if level > steps {
+		level = steps
+	}
+	return sparklineGlyphs[level]
+}
+
+// Sparkline renders one glyph per count, scaled to the largest count
+func Sparkline(counts []int) string {
+	maxCount := 0
+	for _, c := range counts {
+		if c > maxCount {
+			maxCount = c
+		}
+	}
+	var b strings.Builder
+	for _, c := range counts {
+		b.WriteRune(sparklineGlyph(c, maxCount))
+	}
+	return b.String()
+}
+
+func (m SummaryModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(styles.DialogTitle.Render(i18n.Tf("summary.title", map[string]any{"Days": SummaryDays})))
+	b.WriteString("\n\n")
+
+	switch {
+	case m.loading:
+		b.WriteString(styles.TextDim.Render(i18n.T("common.loading")))
+	case m.error != "":
+		b.WriteString(styles.Error.Render(i18n.T("common.error") + ": " + m.error))
+	default:
+		counts := make([]int, len(m.days))
+		total := 0
+		for i, d := range m.days {
+			counts[i] = d.Count
+			total += d.Count
+		}
+
+		// One column per day: bar, count, weekday
+		const colWidth = 5
+		var bars, numbers, labels strings.Builder
+		for i, glyph := range []rune(Sparkline(counts)) {
+			bars.WriteString(fmt.Sprintf("%-*s", colWidth, string(glyph)))
+			numbers.WriteString(fmt.Sprintf("%-*d", colWidth, counts[i]))
+			labels.WriteString(fmt.Sprintf("%-*s", colWidth, m.days[i].Day.Format("Mon")))
+		}
+		b.WriteString(styles.Primary.Render(bars.String()))
+		b.WriteString("\n")
+		b.WriteString(styles.Text.Render(numbers.String()))
+		b.WriteString("\n")
+		b.WriteString(styles.TextDim.Render(labels.String()))
+		b.WriteString("\n\n")
+		b.WriteString(styles.TextBold.Render(i18n.Tf("summary.total", map[string]any{"Count": total})))
+	}
+
+	b.WriteString("\n\n")
+	b.WriteString(styles.TextDim.Render(i18n.T("summary.press_to_close")))
+
+	return styles.Dialog.Render(b.String())
+}
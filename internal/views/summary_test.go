@@ -0,0 +1,82 @@
+package views
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBucketByDay(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, loc)
+
+	times := []time.Time{
+		time.Date(2026, 3, 10, 8, 0, 0, 0, loc),                // today
+		time.Date(2026, 3, 10, 3, 0, 0, 0, time.UTC),           // 23:00 on Mar 9 in New York
+		time.Date(2026, 3, 4, 0, 30, 0, 0, loc),                // oldest day
+		time.Date(2026, 3, 3, 23, 59, 0, 0, loc),               // before the range
+		time.Date(2026, 3, 8, 12, 0, 0, 0, loc),                // DST change day
+		time.Date(2026, 3, 8, 13, 0, 0, 0, loc).Add(time.Hour), // same day
+	}
+
+	days := BucketByDay(times, now, loc, 7)
+	if len(days) != 7 {
+		t.Fatalf("expected 7 buckets, got %d", len(days))
+	}
+
+	expected := []int{1, 0, 0, 0, 2, 1, 1} // Mar 4 .. Mar 10
+	for i, d := range days {
+		if d.Count != expected[i] {
+			t.Errorf("bucket %d (%s): expected %d, got %d", i, d.Day.Format("Jan 2"), expected[i], d.Count)
+		}
+		if want := 4 + i; d.Day.Day() != want {
+			t.Errorf("bucket %d: expected Mar %d, got %s", i, want, d.Day.Format("Jan 2"))
+		}
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		name     string
+		counts   []int
+		expected string
+	}{
+		{"all zero", []int{0, 0, 0}, "▁▁▁"},
+		{"scaled to max", []int{0, 1, 2, 3, 4}, "▁▂▃▅▇"},
+		{"small counts stay visible", []int{1, 0, 100}, "▂▁▇"},
+		{"single day", []int{5}, "▇"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sparkline(tt.counts); got != tt.expected {
+				t.Errorf("Sparkline(%v) = %q, expected %q", tt.counts, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSummaryModelView(t *testing.T) {
+	m := NewSummaryModel()
+	m.SetLoading(true)
+	if !strings.Contains(stripANSI(m.View()), "Loading") {
+		t.Error("expected loading state")
+	}
+
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	m.SetCreatedTimes([]time.Time{now, now.Add(-time.Hour), now.AddDate(0, 0, -2)}, now, time.UTC)
+
+	view := stripANSI(m.View())
+	if !strings.Contains(view, "Total: 3") {
+		t.Errorf("expected total in view, got:\n%s", view)
+	}
+	if !strings.Contains(view, "Tue") {
+		t.Errorf("expected weekday labels in view, got:\n%s", view)
+	}
+	if !strings.Contains(view, "▇") {
+		t.Errorf("expected sparkline in view, got:\n%s", view)
+	}
+}